@@ -0,0 +1,50 @@
+# Read Tanzu Mission Control custom policy template test : evaluate the template against example manifests
+data "tanzu-mission-control_custom_policy_template_test" "required_labels" {
+  template_manifest = tanzu-mission-control_custom_policy_template.required_labels.spec[0].template_manifest
+
+  parameters = jsonencode({
+    labels = ["owner", "team"]
+  })
+
+  example {
+    name   = "labeled-deployment"
+    expect = "allow"
+
+    manifest = <<YAML
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+  labels:
+    owner: platform
+    team: web
+YAML
+  }
+
+  example {
+    name   = "unlabeled-deployment"
+    expect = "deny"
+
+    manifest = <<YAML
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+YAML
+  }
+
+  data_inventory_objects = [
+    <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: default
+YAML
+  ]
+}
+
+output "required_labels_violations" {
+  value = data.tanzu-mission-control_custom_policy_template_test.required_labels.results[1].violations
+}
@@ -0,0 +1,22 @@
+# Read Tanzu Mission Control effective IAM access : fetch the subjects holding a role on a namespace
+data "tanzu-mission-control_effective_iam_access" "namespace_access" {
+  target {
+    namespace {
+      name                    = "tfns"
+      cluster_name            = "tf-attach-test"
+      management_cluster_name = "attached"
+      provisioner_name        = "attached"
+    }
+  }
+}
+
+# Assert that only the platform team administers the namespace
+check "namespace_admins" {
+  assert {
+    condition = alltrue([
+      for subject in data.tanzu-mission-control_effective_iam_access.namespace_access.subjects :
+      subject.name == "platform-team" if anytrue([for role in subject.roles : role.role == "namespace.admin"])
+    ])
+    error_message = "Only the platform-team group may hold the namespace.admin role on tfns."
+  }
+}
@@ -0,0 +1,26 @@
+# Read Tanzu Mission Control effective policies : fetch the policies inherited by a namespace
+data "tanzu-mission-control_effective_policies" "namespace_policies" {
+  target {
+    namespace {
+      name                    = "tfns"
+      cluster_name            = "tf-attach-test"
+      management_cluster_name = "attached"
+      provisioner_name        = "attached"
+    }
+  }
+}
+
+# Read Tanzu Mission Control effective policies : fetch the policies inherited by a cluster
+data "tanzu-mission-control_effective_policies" "cluster_policies" {
+  target {
+    cluster {
+      name                    = "tf-attach-test"
+      management_cluster_name = "attached"
+      provisioner_name        = "attached"
+    }
+  }
+}
+
+output "namespace_policy_conflicts" {
+  value = data.tanzu-mission-control_effective_policies.namespace_policies.conflicts
+}
@@ -0,0 +1,37 @@
+# Read Tanzu Mission Control helm chart search : fetch the newest chart version matching the constraints
+data "tanzu-mission-control_helm_chart_search" "nginx" {
+  name_contains = "nginx"
+
+  repository_name = "bitnami"
+
+  version_constraints = ">=15.0.0 <17.0.0"
+
+  kube_version = "1.27.5"
+}
+
+resource "tanzu-mission-control_helm_release" "nginx" {
+  name = "nginx"
+
+  namespace_name = "nginx"
+
+  scope {
+    cluster {
+      name                    = "tf-attach-test"
+      management_cluster_name = "attached"
+      provisioner_name        = "attached"
+    }
+  }
+
+  feature_ref = tanzu-mission-control_helm_feature.create_cl_helm_feature.scope[0].cluster[0].name
+
+  spec {
+    chart_ref {
+      helm_repository {
+        repository_name      = data.tanzu-mission-control_helm_chart_search.nginx.best_match[0].repository_name
+        repository_namespace = "tanzu-helm-resources"
+        chart_name           = data.tanzu-mission-control_helm_chart_search.nginx.best_match[0].chart_metadata_name
+        version              = data.tanzu-mission-control_helm_chart_search.nginx.best_match[0].version
+      }
+    }
+  }
+}
@@ -0,0 +1,18 @@
+data "tanzu-mission-control_kubeconfig" "demo" {
+  management_cluster_name = "MGMT_CLS_NAME"
+  provisioner_name        = "PROVISIONER_NAME"
+  name                    = "CLS_NAME"
+  cli_type                = "TANZU_CLI"
+}
+
+provider "kubernetes" {
+  host                   = data.tanzu-mission-control_kubeconfig.demo.host
+  cluster_ca_certificate = data.tanzu-mission-control_kubeconfig.demo.cluster_ca_certificate
+
+  exec {
+    api_version = data.tanzu-mission-control_kubeconfig.demo.exec[0].api_version
+    command     = data.tanzu-mission-control_kubeconfig.demo.exec[0].command
+    args        = data.tanzu-mission-control_kubeconfig.demo.exec[0].args
+    env         = data.tanzu-mission-control_kubeconfig.demo.exec[0].env
+  }
+}
@@ -0,0 +1,35 @@
+# Read Tanzu Mission Control Tanzu Kubernetes Release versions : fetch the latest 1.28 patch version
+data "tanzu-mission-control_kubernetes_versions" "tkr_versions" {
+  tanzu_kubernetes_release {
+    management_cluster_name = "MGMT_CLS_NAME"
+    provisioner_name        = "PROVISIONER_NAME"
+    cluster_class           = "CLUSTER_CLASS_NAME"
+  }
+
+  latest_patch_of = "1.28"
+}
+
+# Read Tanzu Mission Control EKS versions : fetch the default version
+data "tanzu-mission-control_kubernetes_versions" "eks_versions" {
+  eks {
+    credential_name = "AWS_CREDENTIAL_NAME"
+    region          = "us-west-2"
+  }
+}
+
+# Read Tanzu Mission Control AKS versions : fetch the default version
+data "tanzu-mission-control_kubernetes_versions" "aks_versions" {
+  aks {
+    credential_name = "AZURE_CREDENTIAL_NAME"
+    subscription_id = "SUBSCRIPTION_ID"
+    location        = "eastus"
+  }
+}
+
+output "tkr_version" {
+  value = data.tanzu-mission-control_kubernetes_versions.tkr_versions.latest_patch_version
+}
+
+output "eks_default_version" {
+  value = data.tanzu-mission-control_kubernetes_versions.eks_versions.default_version
+}
@@ -0,0 +1,22 @@
+# Read Tanzu Mission Control package versions : evaluate version constraints against the versions of a cluster package
+data "tanzu-mission-control_package_versions" "read_cert_manager_versions" {
+  metadata_name   = "cert-manager.tanzu.vmware.com" # Required
+  constraints     = ">=1.7.0 <2.0.0"                # Default: all released versions
+  current_version = "1.7.2+vmware.1-tkg.1"          # Used to compute available_upgrades
+
+  scope {
+    cluster {
+      name                    = "testcluster" # Required
+      provisioner_name        = "attached"    # Default: attached
+      management_cluster_name = "attached"    # Default: attached
+    }
+  }
+}
+
+output "cert_manager_resolved_version" {
+  value = data.tanzu-mission-control_package_versions.read_cert_manager_versions.resolved_version
+}
+
+output "cert_manager_available_upgrades" {
+  value = data.tanzu-mission-control_package_versions.read_cert_manager_versions.available_upgrades
+}
@@ -0,0 +1,35 @@
+# Read Tanzu Mission Control policy insights : fetch the enforced security policy violations of a cluster group
+data "tanzu-mission-control_policy_insights" "cluster_group_violations" {
+  scope {
+    cluster_group {
+      name = "default"
+    }
+  }
+
+  policy_type        = "security"
+  enforcement_action = "deny"
+  last_seen_after    = "2024-01-01T00:00:00Z"
+}
+
+# Read Tanzu Mission Control policy insights : fetch the violations of a namespace
+data "tanzu-mission-control_policy_insights" "namespace_violations" {
+  scope {
+    namespace {
+      name                    = "tfns"
+      cluster_name            = "tf-attach-test"
+      management_cluster_name = "attached"
+      provisioner_name        = "attached"
+    }
+  }
+
+  resource_kind = "Pod"
+}
+
+# Read Tanzu Mission Control policy insights : fetch the violations of the whole organization
+data "tanzu-mission-control_policy_insights" "organization_violations" {
+  policy_name = "baseline-*"
+}
+
+output "enforced_violations" {
+  value = length(data.tanzu-mission-control_policy_insights.cluster_group_violations.violations)
+}
@@ -20,6 +20,9 @@ resource "tanzu-mission-control_cluster" "attach_cluster_with_kubeconfig_path" {
   }
 
   ready_wait_timeout = "15m" # Default: waits until 3 min for the cluster to become ready
+
+  detach_policy  = "graceful_then_force" # Default: graceful_then_force
+  detach_timeout = "5m"                  # Default: 5m
 }
 
 # Create Tanzu Mission Control attach cluster with k8s cluster kubeconfig provided
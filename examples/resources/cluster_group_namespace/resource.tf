@@ -0,0 +1,15 @@
+# Create Tanzu Mission Control namespace on every member cluster of a cluster group
+resource "tanzu-mission-control_cluster_group_namespace" "namespace" {
+  name               = "tf-namespace"     # Required
+  cluster_group_name = "tf-cluster-group" # Required
+
+  meta {
+    description = "Create namespace through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    workspace_name = "default" # Default: default
+    attach         = false     # Default: false
+  }
+}
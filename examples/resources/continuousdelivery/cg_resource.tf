@@ -0,0 +1,29 @@
+# Enable continuous delivery on all the clusters of a cluster group.
+resource "tanzu-mission-control_continuous_delivery" "cg_continuous_delivery" {
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+
+  disable_on_destroy = false # Default: false
+}
+
+# GitOps resources of the same scope should depend on the continuous delivery resource.
+resource "tanzu-mission-control_git_repository" "cg_git_repository" {
+  name = "tf-git-repository-name" # Required
+
+  namespace_name = "tf-namespace" # Required
+
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+
+  spec {
+    url = "testGitRepositoryURL" # Required
+  }
+
+  depends_on = [tanzu-mission-control_continuous_delivery.cg_continuous_delivery]
+}
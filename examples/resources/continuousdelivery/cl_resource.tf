@@ -0,0 +1,12 @@
+# Enable continuous delivery on a cluster and disable it when the resource is destroyed.
+resource "tanzu-mission-control_continuous_delivery" "cl_continuous_delivery" {
+  scope {
+    cluster {
+      name                    = "testcluster" # Required
+      provisioner_name        = "attached"    # Default: attached
+      management_cluster_name = "attached"    # Default: attached
+    }
+  }
+
+  disable_on_destroy = true # Default: false
+}
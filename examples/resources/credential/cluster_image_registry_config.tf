@@ -25,3 +25,20 @@ resource "tanzu-mission-control_credential" "img_reg_cred" {
     }
   }
 }
+
+# Create IMAGE_REGISTRY credential using the image_registry block
+resource "tanzu-mission-control_credential" "typed_img_reg_cred" {
+  name = "typed-img-reg-cred"
+
+  spec {
+    data {
+      image_registry {
+        url       = "harbor.example.com"
+        namespace = "tmc"
+        username  = "username"
+        password  = "password"
+        ca_cert   = "-----BEGIN CERTIFICATE-----\n Encoded string for encryption of data\n ----END CERTIFICATE----"
+      }
+    }
+  }
+}
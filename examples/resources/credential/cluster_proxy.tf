@@ -59,3 +59,24 @@ resource "tanzu-mission-control_credential" "transparent_proxy_cred" {
     }
   }
 }
+
+# Create explicit cluster proxy credential using the proxy_config block
+resource "tanzu-mission-control_credential" "typed_proxy_cred" {
+  name = "typed_proxy_cred"
+
+  spec {
+    data {
+      proxy_config {
+        proxy_type     = "explicit"
+        http_proxy     = "http://proxy.example.com:3128"
+        https_proxy    = "http://proxy.example.com:3128"
+        http_username  = "username"
+        http_password  = "password"
+        https_username = "username"
+        https_password = "password"
+        no_proxy       = ["noproxy.example.com", "10.0.0.0/8"]
+        proxy_ca       = "-----BEGIN CERTIFICATE-----\n Encoded string for encryption of data\n ----END CERTIFICATE----" # chain of certificate is supported in CRT format
+      }
+    }
+  }
+}
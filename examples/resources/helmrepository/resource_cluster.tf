@@ -0,0 +1,28 @@
+# Create Tanzu Mission Control helm repository with attached set as default value.
+resource "tanzu-mission-control_helm_repository" "cluster_helm_repository" {
+  name = "tf-helm-repository-name" # Required
+
+  namespace_name = "tf-namespace" # Required
+
+  scope {
+    cluster {
+      name                    = "testcluster" # Required
+      provisioner_name        = "attached"    # Default: attached
+      management_cluster_name = "attached"    # Default: attached
+    }
+  }
+
+  meta {
+    description = "Create helm repository through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    url              = "https://charts.bitnami.com/bitnami" # Required
+    secret_ref       = "testUsernamePasswordSourceSecret"
+    cert_secret_ref  = "testTLSSourceSecret"
+    pass_credentials = false
+    interval         = "10m" # Default: 5m
+    suspend          = false
+  }
+}
@@ -0,0 +1,32 @@
+# Create Tanzu Mission Control helm repository on all the clusters of a cluster group.
+resource "tanzu-mission-control_continuous_delivery" "cluster_group_continuous_delivery" {
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+}
+
+resource "tanzu-mission-control_helm_repository" "cluster_group_helm_repository" {
+  name = "tf-helm-repository-name" # Required
+
+  namespace_name = "tf-namespace" # Required
+
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+
+  meta {
+    description = "Create helm repository through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    url      = "https://charts.bitnami.com/bitnami" # Required
+    interval = "10m"                                # Default: 5m
+  }
+
+  depends_on = [tanzu-mission-control_continuous_delivery.cluster_group_continuous_delivery]
+}
@@ -0,0 +1,18 @@
+# Create Tanzu Mission Control helm repository backed by an OCI registry.
+resource "tanzu-mission-control_helm_repository" "oci_helm_repository" {
+  name = "tf-oci-helm-repository-name" # Required
+
+  namespace_name = "tf-namespace" # Required
+
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+
+  spec {
+    url        = "oci://ghcr.io/stefanprodan/charts" # Required
+    type       = "OCI"                               # Default: DEFAULT
+    secret_ref = "testUsernamePasswordSourceSecret"
+  }
+}
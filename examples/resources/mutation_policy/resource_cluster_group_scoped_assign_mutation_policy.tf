@@ -0,0 +1,38 @@
+resource "tanzu-mission-control_mutation_policy" "cluster_group_assign_mutation_policy" {
+  name = "tf-mutation-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      assign {
+        target_kubernetes_resources {
+          api_groups = [
+            "",
+          ]
+          kinds = [
+            "Pod",
+          ]
+        }
+        scope    = "Namespaced"
+        location = "spec.tolerations"
+        value = jsonencode([
+          {
+            key      = "dedicated"
+            operator = "Equal"
+            value    = "batch"
+            effect   = "NoSchedule"
+          },
+        ])
+        path_tests {
+          sub_path  = "spec.tolerations"
+          condition = "MustNotExist"
+        }
+      }
+    }
+  }
+}
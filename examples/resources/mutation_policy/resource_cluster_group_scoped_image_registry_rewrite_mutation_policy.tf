@@ -0,0 +1,33 @@
+resource "tanzu-mission-control_mutation_policy" "cluster_group_image_registry_rewrite_mutation_policy" {
+  name = "tf-mutation-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      image_registry_rewrite {
+        target_kubernetes_resources {
+          api_groups = [
+            "",
+          ]
+          kinds = [
+            "Pod",
+          ]
+        }
+        scope = "Namespaced"
+        rules {
+          source_registry = "docker.io"
+          target_registry = "registry.example.com/docker.io"
+        }
+        rules {
+          source_registry = "quay.io"
+          target_registry = "registry.example.com/quay.io"
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,24 @@
+# Create Tanzu Mission Control package install on all the clusters of a cluster group.
+resource "tanzu-mission-control_package_install" "cluster_group_package_install" {
+  name = "test-pakage-install-name" # Required
+
+  namespace = "test-namespace-name" # Required
+
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+
+  spec {
+    package_ref {
+      package_metadata_name = "cert-manager.tanzu.vmware.com" # Required
+
+      version_selection {
+        constraints = "1.7.2+vmware.1-tkg.1" # Required
+      }
+    }
+
+    path_to_inline_values = "./inline_values.yaml" #<inline-values-file-path>
+  }
+}
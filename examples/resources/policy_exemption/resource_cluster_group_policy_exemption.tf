@@ -0,0 +1,19 @@
+/*
+Cluster group scoped Tanzu Mission Control policy exemption.
+The namespaces labelled as vmware-system namespaces are excluded from the namespace quota policies of the cluster group.
+*/
+resource "tanzu-mission-control_policy_exemption" "cluster_group_scoped_policy_exemption" {
+  name = "tf-vmware-system-namespaces"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test-cg"
+    }
+  }
+
+  policy_kinds = ["namespace_quota"]
+
+  namespace_labels = {
+    "vmware-system" = "true"
+  }
+}
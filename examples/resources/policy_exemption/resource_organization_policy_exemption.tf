@@ -0,0 +1,21 @@
+/*
+Organization scoped Tanzu Mission Control policy exemption.
+The namespaces are excluded from the namespace selector of every security, custom and mutation policy of the organization.
+*/
+resource "tanzu-mission-control_policy_exemption" "organization_scoped_policy_exemption" {
+  name = "tf-system-namespaces"
+
+  scope {
+    organization {
+      organization = "dummy-id"
+    }
+  }
+
+  policy_kinds = ["security", "custom", "mutation"]
+
+  namespaces = ["kube-system", "kube-public", "team-x"]
+
+  namespace_labels = {
+    "policy-exempt" = "true"
+  }
+}
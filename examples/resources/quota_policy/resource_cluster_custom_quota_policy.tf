@@ -34,6 +34,20 @@ resource "tanzu-mission-control_namespace_quota_policy" "cluster_scoped_custom_q
         }
         resource_counts = {
           pods : 2
+          services : 10
+          configmaps : 20
+          "services.loadbalancers" : 1
+        }
+        extended_resources = {
+          "nvidia.com/gpu" : "2"
+        }
+        limit_range {
+          default_requests_cpu    = "100m"
+          default_requests_memory = "128Mi"
+          default_limits_cpu      = "500m"
+          default_limits_memory   = "512Mi"
+          max_limits_cpu          = "2"
+          max_limits_memory       = "2Gi"
         }
       }
     }
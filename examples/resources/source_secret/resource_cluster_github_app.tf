@@ -0,0 +1,28 @@
+# Create Tanzu Mission Control source secret with GitHub App credential.
+resource "tanzu-mission-control_repository_credential" "cluster_source_secret_github_app" {
+  name = "tf-secret" # Required
+
+  scope {
+    cluster {
+      name                    = "testcluster" # Required
+      provisioner_name        = "attached"    # Default: attached
+      management_cluster_name = "attached"    # Default: attached
+    }
+  }
+
+  meta {
+    description = "Create namespace through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    data {
+      github_app {
+        app_id          = "123456"                           # Required
+        installation_id = "7891011"                          # Required
+        private_key     = file("github-app-private-key.pem") # Required
+        base_url        = "https://github.example.com/api/v3"
+      }
+    }
+  }
+}
@@ -0,0 +1,23 @@
+# Create Tanzu Mission Control source secret with bearer token credential.
+resource "tanzu-mission-control_repository_credential" "cluster_group_source_secret_bearer_token" {
+  name = "tf-secret" # Required
+
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+
+  meta {
+    description = "Create namespace through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    data {
+      bearer_token {
+        token = "testtoken" # Required
+      }
+    }
+  }
+}
@@ -0,0 +1,27 @@
+# Create Tanzu Mission Control source secret with TLS credential, a custom CA and a client certificate.
+resource "tanzu-mission-control_repository_credential" "cluster_source_secret_tls" {
+  name = "tf-secret" # Required
+
+  scope {
+    cluster {
+      name                    = "testcluster" # Required
+      provisioner_name        = "attached"    # Default: attached
+      management_cluster_name = "attached"    # Default: attached
+    }
+  }
+
+  meta {
+    description = "Create namespace through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    data {
+      tls {
+        ca   = file("ca.crt")
+        cert = file("client.crt") # Required with key
+        key  = file("client.key") # Required with cert
+      }
+    }
+  }
+}
@@ -0,0 +1,21 @@
+# Create Tanzu Mission Control package repository on all the clusters of a cluster group.
+resource "tanzu-mission-control_package_repository" "cluster_group_pkg_repository" {
+  name = "tf-pkg-repository-name" # Required
+
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+
+  meta {
+    description = "Create package repository through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    imgpkg_bundle {
+      image = "testImage" # Required
+    }
+  }
+}
@@ -22,6 +22,8 @@ const (
 	queryParamKeyCredentialName    = "fullName.credentialName" //nolint:gosec
 	queryParamKeySubscriptionID    = "fullName.subscriptionId"
 	queryParamKeyResourceGroupName = "fullName.resourceGroupName"
+	queryParamKeyLocation          = "location"
+	kubernetesVersionsAPIKind      = ":kubernetesversions"
 )
 
 // New creates a new aks cluster resource service API client.
@@ -44,6 +46,8 @@ type ClientService interface {
 
 	AksClusterResourceServiceGetByID(id string) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterGetAksClusterResponse, error)
 
+	AksClusterResourceServiceGetKubernetesVersions(fn *aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName, location string) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterGetKubernetesVersionsResponse, error)
+
 	AksClusterResourceServiceUpdate(request *aksmodel.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterRequest) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterResponse, error)
 
 	AksClusterResourceServiceDelete(fn *aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName, force string) error
@@ -84,6 +88,29 @@ func (c *Client) AksClusterResourceServiceGet(fn *aksmodel.VmwareTanzuManageV1al
 	return clusterResponse, err
 }
 
+// AksClusterResourceServiceGetKubernetesVersions gets the kubernetes versions supported by AKS for the credential and subscription of the full name in a location.
+func (c *Client) AksClusterResourceServiceGetKubernetesVersions(fn *aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName, location string) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterGetKubernetesVersionsResponse, error) {
+	queryParams := url.Values{}
+	if fn.CredentialName != "" {
+		queryParams.Add(queryParamKeyCredentialName, fn.CredentialName)
+	}
+
+	if fn.SubscriptionID != "" {
+		queryParams.Add(queryParamKeySubscriptionID, fn.SubscriptionID)
+	}
+
+	if location != "" {
+		queryParams.Add(queryParamKeyLocation, location)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup + kubernetesVersionsAPIKind).AppendQueryParams(queryParams).String()
+	versionsResponse := &aksmodel.VmwareTanzuManageV1alpha1AksclusterGetKubernetesVersionsResponse{}
+
+	err := c.Get(requestURL, versionsResponse)
+
+	return versionsResponse, err
+}
+
 // AksClusterResourceServiceGetByID gets an aks cluster by ID used to import existing clusters to terraform state.
 func (c *Client) AksClusterResourceServiceGetByID(id string) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterGetAksClusterResponse, error) {
 	queryParams := url.Values{
@@ -17,6 +17,10 @@ const (
 	queryParamKeyForce                 = "force"
 	queryParamKeyManagementClusterName = "fullName.managementClusterName"
 	queryParamKeyProvisionerName       = "fullName.provisionerName"
+	queryParamKeySearchClusterGroup    = "searchScope.clusterGroupName"
+	queryParamKeySearchManagement      = "searchScope.managementClusterName"
+	queryParamKeySearchProvisioner     = "searchScope.provisionerName"
+	queryParamKeySearchName            = "searchScope.name"
 )
 
 // New creates a new cluster resource service API client.
@@ -39,6 +43,8 @@ type ClientService interface {
 
 	ManageV1alpha1ClusterResourceServiceGet(fn *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) (*clustermodel.VmwareTanzuManageV1alpha1ClusterGetClusterResponse, error)
 
+	ManageV1alpha1ClusterResourceServiceList(searchScope *clustermodel.VmwareTanzuManageV1alpha1ClusterSearchScope) (*clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersResponse, error)
+
 	ManageV1alpha1ClusterResourceServiceUpdate(request *clustermodel.VmwareTanzuManageV1alpha1ClusterRequest) (*clustermodel.VmwareTanzuManageV1alpha1ClusterResponse, error)
 }
 
@@ -112,3 +118,34 @@ func (c *Client) ManageV1alpha1ClusterResourceServiceGet(
 
 	return clusterResponse, err
 }
+
+/*
+ManageV1alpha1ClusterResourceServiceList lists the clusters matching the search scope.
+*/
+func (c *Client) ManageV1alpha1ClusterResourceServiceList(
+	searchScope *clustermodel.VmwareTanzuManageV1alpha1ClusterSearchScope,
+) (*clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersResponse, error) {
+	queryParams := url.Values{}
+
+	if searchScope.ClusterGroupName != "" {
+		queryParams.Add(queryParamKeySearchClusterGroup, searchScope.ClusterGroupName)
+	}
+
+	if searchScope.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeySearchManagement, searchScope.ManagementClusterName)
+	}
+
+	if searchScope.ProvisionerName != "" {
+		queryParams.Add(queryParamKeySearchProvisioner, searchScope.ProvisionerName)
+	}
+
+	if searchScope.Name != "" {
+		queryParams.Add(queryParamKeySearchName, searchScope.Name)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup).AppendQueryParams(queryParams).String()
+	clustersResponse := &clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersResponse{}
+	err := c.Get(requestURL, clustersResponse)
+
+	return clustersResponse, err
+}
@@ -35,9 +35,49 @@ type Client struct {
 
 // ClientService is the interface for VmwareTanzuManageV1alpha1ClusterFluxcdHelmChartsResourceService Client methods.
 type ClientService interface {
+	VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceCreate(request *helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest) (*helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceDelete(fn *helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryFullName) error
+
 	VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceGet(fn *helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryFullName) (*helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryGetResponse, error)
 
 	VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceList(request *helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySearchScope) (*helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryListResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceUpdate(request *helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest) (*helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceCreate creates a Flux CD helm repository scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceCreate(request *helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest) (*helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Repository.FullName.ClusterName, apiSubGroup, request.Repository.FullName.NamespaceName, apiKind).String()
+	helmRepositoryResponse := &helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse{}
+	err := p.Create(requestURL, request, helmRepositoryResponse)
+
+	return helmRepositoryResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceDelete deletes a Flux CD helm repository scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceDelete(fn *helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryFullName) error {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiSubGroup, fn.NamespaceName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
 }
 
 /*
@@ -85,3 +125,14 @@ func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceSer
 
 	return helmchartResponse, err
 }
+
+/*
+VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceUpdate updates overwrite a Flux CD helm repository scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceUpdate(request *helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest) (*helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Repository.FullName.ClusterName, apiSubGroup, request.Repository.FullName.NamespaceName, apiKind, request.Repository.FullName.Name).String()
+	helmRepositoryResponse := &helmchartsorgmodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse{}
+	err := p.Update(requestURL, request, helmRepositoryResponse)
+
+	return helmRepositoryResponse, err
+}
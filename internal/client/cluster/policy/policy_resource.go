@@ -17,6 +17,8 @@ const (
 	apiKind                            = "policies"
 	queryParamKeyManagementClusterName = "fullName.managementClusterName"
 	queryParamKeyProvisionerName       = "fullName.provisionerName"
+	queryParamKeySearchManagement      = "searchScope.managementClusterName"
+	queryParamKeySearchProvisioner     = "searchScope.provisionerName"
 )
 
 // New creates a new cluster policy resource service API client.
@@ -39,6 +41,8 @@ type ClientService interface {
 
 	ManageV1alpha1ClusterPolicyResourceServiceGet(fn *policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyFullName) (*policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyGetPolicyResponse, error)
 
+	ManageV1alpha1ClusterPolicyResourceServiceList(fn *policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyFullName) (*policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyListPoliciesResponse, error)
+
 	ManageV1alpha1ClusterPolicyResourceServiceUpdate(request *policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyPolicyRequest) (*policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyPolicyResponse, error)
 }
 
@@ -93,6 +97,27 @@ func (p *Client) ManageV1alpha1ClusterPolicyResourceServiceGet(fn *policycluster
 	return policyClusterResponse, err
 }
 
+/*
+ManageV1alpha1ClusterPolicyResourceServiceList lists the policies scoped to a cluster resource.
+*/
+func (p *Client) ManageV1alpha1ClusterPolicyResourceServiceList(fn *policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyFullName) (*policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyListPoliciesResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeySearchManagement, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeySearchProvisioner, fn.ProvisionerName)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind).AppendQueryParams(queryParams).String()
+	policyClusterResponse := &policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyListPoliciesResponse{}
+	err := p.Get(requestURL, policyClusterResponse)
+
+	return policyClusterResponse, err
+}
+
 /*
 ManageV1alpha1ClusterPolicyResourceServiceUpdate updates overwrite a policy scoped to a cluster resource.
 */
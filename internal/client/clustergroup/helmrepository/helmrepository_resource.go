@@ -0,0 +1,106 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepositoryclustergroupclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
+)
+
+const (
+	apiVersionAndGroup         = "v1alpha1/clustergroups"
+	apiSubGroup                = "namespace"
+	apiKind                    = "fluxcd/helm/repositories"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new cluster group Flux CD helm repository resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster group Flux CD helm repository resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceCreate(request *helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest) (*helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceDelete(fn *helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName) error
+
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceGet(fn *helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName) (*helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryGetResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceUpdate(request *helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest) (*helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceCreate creates a Flux CD helm repository scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceCreate(request *helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest) (*helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Repository.FullName.ClusterGroupName, apiSubGroup, apiKind).String()
+	fluxCDHelmRepositoryClusterGroupResponse := &helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse{}
+	err := p.Create(requestURL, request, fluxCDHelmRepositoryClusterGroupResponse)
+
+	return fluxCDHelmRepositoryClusterGroupResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceDelete deletes a Flux CD helm repository scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceDelete(fn *helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName) error {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceGet gets a Flux CD helm repository scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceGet(fn *helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName) (*helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryGetResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	fluxCDHelmRepositoryClusterGroupResponse := &helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryGetResponse{}
+	err := p.Get(requestURL, fluxCDHelmRepositoryClusterGroupResponse)
+
+	return fluxCDHelmRepositoryClusterGroupResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceUpdate updates overwrite a Flux CD helm repository scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceUpdate(request *helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest) (*helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Repository.FullName.ClusterGroupName, apiSubGroup, apiKind, request.Repository.FullName.Name).String()
+	fluxCDHelmRepositoryClusterGroupResponse := &helmrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse{}
+	err := p.Update(requestURL, request, fluxCDHelmRepositoryClusterGroupResponse)
+
+	return fluxCDHelmRepositoryClusterGroupResponse, err
+}
@@ -35,6 +35,8 @@ type ClientService interface {
 
 	ManageV1alpha1ClustergroupPolicyResourceServiceGet(fn *policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyFullName) (*policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyGetPolicyResponse, error)
 
+	ManageV1alpha1ClustergroupPolicyResourceServiceList(fn *policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyFullName) (*policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyListPoliciesResponse, error)
+
 	ManageV1alpha1ClustergroupPolicyResourceServiceUpdate(request *policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyPolicyRequest) (*policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyPolicyResponse, error)
 }
 
@@ -69,6 +71,17 @@ func (p *Client) ManageV1alpha1ClustergroupPolicyResourceServiceGet(fn *policycl
 	return policyClusterGroupResponse, err
 }
 
+/*
+ManageV1alpha1ClustergroupPolicyResourceServiceList lists the policies scoped to a cluster group resource.
+*/
+func (p *Client) ManageV1alpha1ClustergroupPolicyResourceServiceList(fn *policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyFullName) (*policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyListPoliciesResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiKind).String()
+	policyClusterGroupResponse := &policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyListPoliciesResponse{}
+	err := p.Get(requestURL, policyClusterGroupResponse)
+
+	return policyClusterGroupResponse, err
+}
+
 /*
 ManageV1alpha1ClustergroupPolicyResourceServiceUpdate updates overwrite a policy scoped to a cluster group resource.
 */
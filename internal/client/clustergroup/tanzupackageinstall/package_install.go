@@ -0,0 +1,100 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package pkginstallclustergroupclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	pkginstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall/clustergroup"
+)
+
+const (
+	apiVersionAndGroup         = "v1alpha1/clustergroups"
+	apiSubGroup                = "namespace"
+	apiKind                    = "tanzupackage/installs"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new cluster group package install resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster group package install resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	InstallResourceServiceCreate(request *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest) (*pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse, error)
+
+	InstallResourceServiceDelete(fn *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) error
+
+	InstallResourceServiceGet(fn *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) (*pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse, error)
+
+	InstallResourceServiceUpdate(request *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest) (*pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse, error)
+}
+
+/*
+InstallResourceServiceCreate creates a package install scoped to a cluster group.
+*/
+func (c *Client) InstallResourceServiceCreate(request *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest) (*pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Install.FullName.ClusterGroupName, apiSubGroup, apiKind).String()
+	response := &pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse{}
+	err := c.Create(requestURL, request, response)
+
+	return response, err
+}
+
+/*
+InstallResourceServiceDelete deletes a package install scoped to a cluster group.
+*/
+func (c *Client) InstallResourceServiceDelete(fn *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+InstallResourceServiceGet gets a package install scoped to a cluster group.
+*/
+func (c *Client) InstallResourceServiceGet(fn *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) (*pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+	response := &pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
+
+/*
+InstallResourceServiceUpdate updates overwrite a package install scoped to a cluster group.
+*/
+func (c *Client) InstallResourceServiceUpdate(request *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest) (*pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Install.FullName.ClusterGroupName, apiSubGroup, apiKind, request.Install.FullName.Name).String()
+	response := &pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse{}
+	err := c.Update(requestURL, request, response)
+
+	return response, err
+}
+
+func fullNameQueryParams(fn *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) url.Values {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	return queryParams
+}
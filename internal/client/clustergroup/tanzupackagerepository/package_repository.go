@@ -0,0 +1,100 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package pkgrepositoryclustergroupclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	pkgrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackagerepository/clustergroup"
+)
+
+const (
+	apiVersionAndGroup         = "v1alpha1/clustergroups"
+	apiSubGroup                = "namespace"
+	apiKind                    = "tanzupackage/repositories"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new cluster group package repository resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster group package repository resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	RepositoryResourceServiceCreate(request *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryRequest) (*pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryResponse, error)
+
+	RepositoryResourceServiceDelete(fn *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName) error
+
+	RepositoryResourceServiceGet(fn *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName) (*pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryGetResponse, error)
+
+	RepositoryResourceServiceUpdate(request *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryRequest) (*pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryResponse, error)
+}
+
+/*
+RepositoryResourceServiceCreate creates a package repository scoped to a cluster group.
+*/
+func (c *Client) RepositoryResourceServiceCreate(request *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryRequest) (*pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Repository.FullName.ClusterGroupName, apiSubGroup, apiKind).String()
+	response := &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryResponse{}
+	err := c.Create(requestURL, request, response)
+
+	return response, err
+}
+
+/*
+RepositoryResourceServiceDelete deletes a package repository scoped to a cluster group.
+*/
+func (c *Client) RepositoryResourceServiceDelete(fn *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+RepositoryResourceServiceGet gets a package repository scoped to a cluster group.
+*/
+func (c *Client) RepositoryResourceServiceGet(fn *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName) (*pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryGetResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+	response := &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryGetResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
+
+/*
+RepositoryResourceServiceUpdate updates overwrite a package repository scoped to a cluster group.
+*/
+func (c *Client) RepositoryResourceServiceUpdate(request *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryRequest) (*pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Repository.FullName.ClusterGroupName, apiSubGroup, apiKind, request.Repository.FullName.Name).String()
+	response := &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryResponse{}
+	err := c.Update(requestURL, request, response)
+
+	return response, err
+}
+
+func fullNameQueryParams(fn *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName) url.Values {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	return queryParams
+}
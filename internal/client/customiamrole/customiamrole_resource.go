@@ -35,6 +35,8 @@ type ClientService interface {
 	CustomIAMRoleResourceServiceDelete(fn *customiamrolemodels.VmwareTanzuManageV1alpha1IamRoleFullName) error
 
 	CustomIAMRoleResourceServiceGet(fn *customiamrolemodels.VmwareTanzuManageV1alpha1IamRoleFullName) (*customiamrolemodels.VmwareTanzuManageV1alpha1IamRoleData, error)
+
+	CustomIAMRoleResourceServiceList() (*customiamrolemodels.VmwareTanzuManageV1alpha1IamRoleListRolesResponse, error)
 }
 
 /*
@@ -48,6 +50,17 @@ func (c *Client) CustomIAMRoleResourceServiceGet(fullName *customiamrolemodels.V
 	return resp, err
 }
 
+/*
+CustomIAMRoleResourceServiceList lists the iam roles, the inbuilt roles included.
+*/
+func (c *Client) CustomIAMRoleResourceServiceList() (*customiamrolemodels.VmwareTanzuManageV1alpha1IamRoleListRolesResponse, error) {
+	requestURL := helper.ConstructRequestURL(iamRoleAPIVersionAndGroup).String()
+	resp := &customiamrolemodels.VmwareTanzuManageV1alpha1IamRoleListRolesResponse{}
+	err := c.Get(requestURL, resp)
+
+	return resp, err
+}
+
 /*
 CustomIAMRoleResourceServiceCreate creates a custom iam role.
 */
@@ -22,6 +22,7 @@ const (
 	queryParamKeyForce          = "force"
 	queryParamKeyCredentialName = "fullName.credentialName" //nolint:gosec
 	queryParamKeyRegion         = "fullName.region"
+	kubernetesVersionsAPIKind   = ":kubernetesversions"
 )
 
 // New creates a new eks cluster resource service API client.
@@ -46,6 +47,8 @@ type ClientService interface {
 
 	EksClusterResourceServiceGetByID(id string) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetEksClusterResponse, error)
 
+	EksClusterResourceServiceGetKubernetesVersions(fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetKubernetesVersionsResponse, error)
+
 	EksClusterResourceServiceUpdate(request *eksmodel.VmwareTanzuManageV1alpha1EksclusterCreateUpdateEksClusterRequest) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterCreateUpdateEksClusterResponse, error)
 }
 
@@ -100,6 +103,27 @@ func (c *Client) EksClusterResourceServiceGet(fn *eksmodel.VmwareTanzuManageV1al
 	return clusterResponse, err
 }
 
+/*
+EksClusterResourceServiceGetKubernetesVersions gets the kubernetes versions supported by EKS for the credential and region of the full name.
+*/
+func (c *Client) EksClusterResourceServiceGetKubernetesVersions(fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetKubernetesVersionsResponse, error) {
+	queryParams := url.Values{}
+	if fn.CredentialName != "" {
+		queryParams.Add(queryParamKeyCredentialName, fn.CredentialName)
+	}
+
+	if fn.Region != "" {
+		queryParams.Add(queryParamKeyRegion, fn.Region)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup + kubernetesVersionsAPIKind).AppendQueryParams(queryParams).String()
+	versionsResponse := &eksmodel.VmwareTanzuManageV1alpha1EksclusterGetKubernetesVersionsResponse{}
+
+	err := c.Get(requestURL, versionsResponse)
+
+	return versionsResponse, err
+}
+
 /*
 EksClusterResourceServiceGetByID gets an eks cluster by its ID.
 */
@@ -32,12 +32,15 @@ import (
 	gitrepositoryclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/gitrepository"
 	helmfeatureclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/helmfeature"
 	helmreleaseclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/helmrelease"
+	helmrepositoryclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/helmrepository"
 	iamclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/iam_policy"
 	secretclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/kubernetessecret"
 	secretexportclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/kubernetessecret/secretexport"
 	kustomizationclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/kustomization"
 	policyclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/policy"
 	sourcesecretclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/sourcesecret"
+	pkginstallclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/tanzupackageinstall"
+	pkgrepositoryclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/tanzupackagerepository"
 	credentialclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/credential"
 	customiamroleclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/customiamrole"
 	custompolicytemplateclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/custompolicytemplate"
@@ -55,10 +58,12 @@ import (
 	iamorganizationclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/organization/iam_policy"
 	policyorganizationclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/organization/policy"
 	permissiontemplateclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/permissiontemplate"
+	policyinsightsclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/policyinsights"
 	provisionerclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/provisioner"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/proxy"
 	recipeclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/recipe"
 	tanzukubernetesclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzukubernetescluster"
+	tanzukubernetesreleaseclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzukubernetesrelease"
 	tanzupackageclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzupackage"
 	pkginstallclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzupackageinstall"
 	pkgrepositoryclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzupackagerepository"
@@ -132,11 +137,14 @@ func newHTTPClient(httpClient *transport.Client) *TanzuMissionControl {
 		ClusterTanzuPackageService:                    tanzupackageclusterclient.New(httpClient),
 		TanzupackageResourceService:                   packageclusterclient.New(httpClient),
 		PackageInstallResourceService:                 pkginstallclusterclient.New(httpClient),
+		ClusterGroupPackageRepositoryService:          pkgrepositoryclustergroupclient.New(httpClient),
+		ClusterGroupPackageInstallResourceService:     pkginstallclustergroupclient.New(httpClient),
 		ClusterHelmReleaseResourceService:             helmreleaseclusterclient.New(httpClient),
 		ClusterGroupHelmReleaseResourceService:        helmreleaseclustergroupclient.New(httpClient),
 		ClusterHelmResourceService:                    helmfeatureclusterclient.New(httpClient),
 		ClusterGroupHelmResourceService:               helmfeatureclustergroupclient.New(httpClient),
 		ClusterHelmRepositoryResourceService:          helmrepositoryclusterclient.New(httpClient),
+		ClusterGroupHelmRepositoryResourceService:     helmrepositoryclustergroupclient.New(httpClient),
 		OrganizationHelmChartsResourceService:         helmchartsorgclient.New(httpClient),
 		ClusterGroupSecretResourceService:             secretclustergroupclient.New(httpClient),
 		ClusterGroupSecretExportResourceService:       secretexportclustergroupclient.New(httpClient),
@@ -151,10 +159,12 @@ func newHTTPClient(httpClient *transport.Client) *TanzuMissionControl {
 		ProvisionerResourceService:                    provisionerclient.New(httpClient),
 		CustomPolicyTemplateResourceService:           custompolicytemplateclient.New(httpClient),
 		RecipeResourceService:                         recipeclient.New(httpClient),
+		PolicyInsightsResourceService:                 policyinsightsclient.New(httpClient),
 		CustomIAMRoleResourceService:                  customiamroleclient.New(httpClient),
 		PermissionTemplateService:                     permissiontemplateclient.New(httpClient),
 		ClusterGroupDataProtectionService:             dataprotectionclustergroupclient.New(httpClient),
 		ClusterGroupBackupScheduleService:             clustergroupbackupscheduleclient.New(httpClient),
+		TanzuKubernetesReleaseResourceService:         tanzukubernetesreleaseclient.New(httpClient),
 	}
 }
 
@@ -196,11 +206,14 @@ type TanzuMissionControl struct {
 	ClusterTanzuPackageService                    tanzupackageclusterclient.ClientService
 	TanzupackageResourceService                   packageclusterclient.ClientService
 	PackageInstallResourceService                 pkginstallclusterclient.ClientService
+	ClusterGroupPackageRepositoryService          pkgrepositoryclustergroupclient.ClientService
+	ClusterGroupPackageInstallResourceService     pkginstallclustergroupclient.ClientService
 	ClusterGroupHelmReleaseResourceService        helmreleaseclustergroupclient.ClientService
 	ClusterHelmReleaseResourceService             helmreleaseclusterclient.ClientService
 	ClusterHelmResourceService                    helmfeatureclusterclient.ClientService
 	ClusterGroupHelmResourceService               helmfeatureclustergroupclient.ClientService
 	ClusterHelmRepositoryResourceService          helmrepositoryclusterclient.ClientService
+	ClusterGroupHelmRepositoryResourceService     helmrepositoryclustergroupclient.ClientService
 	OrganizationHelmChartsResourceService         helmchartsorgclient.ClientService
 	ClusterGroupSecretResourceService             secretclustergroupclient.ClientService
 	ClusterGroupSecretExportResourceService       secretexportclustergroupclient.ClientService
@@ -215,8 +228,10 @@ type TanzuMissionControl struct {
 	InspectionsResourceService                    inspectionsclient.ClientService
 	CustomPolicyTemplateResourceService           custompolicytemplateclient.ClientService
 	RecipeResourceService                         recipeclient.ClientService
+	PolicyInsightsResourceService                 policyinsightsclient.ClientService
 	CustomIAMRoleResourceService                  customiamroleclient.ClientService
 	PermissionTemplateService                     permissiontemplateclient.ClientService
 	ClusterGroupDataProtectionService             dataprotectionclustergroupclient.ClientService
 	ClusterGroupBackupScheduleService             clustergroupbackupscheduleclient.ClientService
+	TanzuKubernetesReleaseResourceService         tanzukubernetesreleaseclient.ClientService
 }
@@ -17,6 +17,7 @@ const (
 	apiKubeconfigPath                          = "kubeconfig"
 	queryParamKeyFullNameManagementClusterName = "full_name.managementClusterName"
 	queryParamKeyFullNameProvisionerName       = "full_name.provisionerName"
+	queryParamKeyCli                           = "cli"
 )
 
 // New creates a new kubeconfig service API client.
@@ -34,12 +35,21 @@ type Client struct {
 // ClientService is the interface for Client methods.
 type ClientService interface {
 	KubeconfigServiceGet(fn *models.VmwareTanzuManageV1alpha1ClusterFullName) (*models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error)
+
+	KubeconfigServiceGetForCLI(fn *models.VmwareTanzuManageV1alpha1ClusterFullName, cli models.VmwareTanzuManageV1alpha1ClusterKubeconfigCliType) (*models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error)
 }
 
 /*
-KubeconfigServiceGet gets cluster kubeconfig.
+KubeconfigServiceGet gets cluster kubeconfig for the Tanzu CLI.
 */
 func (c *Client) KubeconfigServiceGet(fn *models.VmwareTanzuManageV1alpha1ClusterFullName) (*models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error) {
+	return c.KubeconfigServiceGetForCLI(fn, models.VmwareTanzuManageV1alpha1ClusterKubeconfigCliTypeTANZUCLI)
+}
+
+/*
+KubeconfigServiceGetForCLI gets cluster kubeconfig generated for the given CLI type.
+*/
+func (c *Client) KubeconfigServiceGetForCLI(fn *models.VmwareTanzuManageV1alpha1ClusterFullName, cli models.VmwareTanzuManageV1alpha1ClusterKubeconfigCliType) (*models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error) {
 	queryParams := url.Values{}
 	if fn.ManagementClusterName != "" {
 		queryParams.Add(queryParamKeyFullNameManagementClusterName, fn.ManagementClusterName)
@@ -49,7 +59,7 @@ func (c *Client) KubeconfigServiceGet(fn *models.VmwareTanzuManageV1alpha1Cluste
 		queryParams.Add(queryParamKeyFullNameProvisionerName, fn.ProvisionerName)
 	}
 
-	queryParams.Add("cli", string(models.VmwareTanzuManageV1alpha1ClusterKubeconfigCliTypeTANZUCLI))
+	queryParams.Add(queryParamKeyCli, string(cli))
 
 	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name, apiKubeconfigPath).AppendQueryParams(queryParams).String()
 	clusterResponse := &models.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse{}
@@ -15,6 +15,7 @@ import (
 const (
 	apiVersionGroupAndKind = "v1alpha1/organization/policies"
 	queryParamKeyOrgID     = "fullName.orgId"
+	queryParamKeySearchOrg = "searchScope.orgId"
 )
 
 // New creates a new organization policy resource service API client.
@@ -37,6 +38,8 @@ type ClientService interface {
 
 	ManageV1alpha1OrganizationPolicyResourceServiceGet(fn *policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyFullName) (*policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyGetPolicyResponse, error)
 
+	ManageV1alpha1OrganizationPolicyResourceServiceList(fn *policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyFullName) (*policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyListPoliciesResponse, error)
+
 	ManageV1alpha1OrganizationPolicyResourceServiceUpdate(request *policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyPolicyRequest) (*policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyPolicyResponse, error)
 }
 
@@ -82,6 +85,23 @@ func (p *Client) ManageV1alpha1OrganizationPolicyResourceServiceGet(fn *policyor
 	return policyOrganizationResponse, err
 }
 
+/*
+ManageV1alpha1OrganizationPolicyResourceServiceList lists the policies scoped to an organization resource.
+*/
+func (p *Client) ManageV1alpha1OrganizationPolicyResourceServiceList(fn *policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyFullName) (*policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyListPoliciesResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeySearchOrg, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionGroupAndKind).AppendQueryParams(queryParams).String()
+	policyOrganizationResponse := &policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyListPoliciesResponse{}
+	err := p.Get(requestURL, policyOrganizationResponse)
+
+	return policyOrganizationResponse, err
+}
+
 /*
 ManageV1alpha1OrganizationPolicyResourceServiceUpdate updates overwrite a policy scoped to an organization resource.
 */
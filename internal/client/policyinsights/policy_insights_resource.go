@@ -0,0 +1,81 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyinsightsclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyinsightsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/insights"
+)
+
+const (
+	// URL Paths.
+	apiVersionAndGroup = "v1alpha1/policy/insights"
+	issuesPath         = "issues"
+
+	// Query Params.
+	queryParamKeySearchClusterGroup      = "searchScope.clusterGroupName"
+	queryParamKeySearchWorkspace         = "searchScope.workspaceName"
+	queryParamKeySearchCluster           = "searchScope.clusterName"
+	queryParamKeySearchManagement        = "searchScope.managementClusterName"
+	queryParamKeySearchProvisioner       = "searchScope.provisionerName"
+	queryParamKeySearchNamespace         = "searchScope.namespaceName"
+	queryParamKeySearchPolicyName        = "searchScope.policyName"
+	queryParamKeySearchPolicyType        = "searchScope.policyType"
+	queryParamKeySearchEnforcementAction = "searchScope.enforcementAction"
+	queryParamKeyIncludeTotalCount       = "includeTotalCount"
+)
+
+// New creates a new policy insights resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for policy insights resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	PolicyInsightsResourceServiceListIssues(searchScope *policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsSearchScope) (*policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsListIssuesResponse, error)
+}
+
+/*
+PolicyInsightsResourceServiceListIssues lists the policy issues matching the search scope.
+*/
+func (c *Client) PolicyInsightsResourceServiceListIssues(
+	searchScope *policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsSearchScope,
+) (*policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsListIssuesResponse, error) {
+	queryParams := url.Values{
+		queryParamKeyIncludeTotalCount: []string{"true"},
+	}
+
+	for key, value := range map[string]string{
+		queryParamKeySearchClusterGroup:      searchScope.ClusterGroupName,
+		queryParamKeySearchWorkspace:         searchScope.WorkspaceName,
+		queryParamKeySearchCluster:           searchScope.ClusterName,
+		queryParamKeySearchManagement:        searchScope.ManagementClusterName,
+		queryParamKeySearchProvisioner:       searchScope.ProvisionerName,
+		queryParamKeySearchNamespace:         searchScope.NamespaceName,
+		queryParamKeySearchPolicyName:        searchScope.PolicyName,
+		queryParamKeySearchPolicyType:        searchScope.PolicyType,
+		queryParamKeySearchEnforcementAction: searchScope.EnforcementAction,
+	} {
+		if value != "" {
+			queryParams.Add(key, value)
+		}
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, issuesPath).AppendQueryParams(queryParams).String()
+	resp := &policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsListIssuesResponse{}
+	err := c.Get(requestURL, resp)
+
+	return resp, err
+}
@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzukubernetesreleaseclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	tanzukubernetesreleasemodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetesrelease"
+)
+
+const (
+	apiVersionAndGroup      = "/v1alpha1/managementclusters"
+	provisioners            = "provisioners"
+	tanzuKubernetesReleases = "tanzukubernetesreleases"
+	nameQueryParamKey       = "searchScope.name"
+)
+
+// New creates a new tanzu kubernetes release resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for tanzu kubernetes release resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	TanzuKubernetesReleaseResourceServiceList(fn *tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseFullName) (*tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseListData, error)
+}
+
+/*
+TanzuKubernetesReleaseResourceServiceList lists tanzu kubernetes releases available to a provisioner.
+*/
+func (c *Client) TanzuKubernetesReleaseResourceServiceList(fn *tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseFullName) (*tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseListData, error) {
+	response := &tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseListData{}
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ManagementClusterName, provisioners, fn.ProvisionerName, tanzuKubernetesReleases)
+
+	if fn.Name != "" {
+		queryParams := url.Values{
+			nameQueryParamKey: {fn.Name},
+		}
+
+		requestURL = requestURL.AppendQueryParams(queryParams)
+	}
+
+	err := c.Get(requestURL.String(), response)
+
+	return response, err
+}
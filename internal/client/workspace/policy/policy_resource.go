@@ -35,6 +35,8 @@ type ClientService interface {
 
 	ManageV1alpha1WorkspacePolicyResourceServiceGet(fn *policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyFullName) (*policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyGetPolicyResponse, error)
 
+	ManageV1alpha1WorkspacePolicyResourceServiceList(fn *policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyFullName) (*policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyListPoliciesResponse, error)
+
 	ManageV1alpha1WorkspacePolicyResourceServiceUpdate(request *policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyPolicyRequest) (*policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyPolicyResponse, error)
 }
 
@@ -69,6 +71,17 @@ func (p *Client) ManageV1alpha1WorkspacePolicyResourceServiceGet(fn *policyworks
 	return policyWorkspaceResponse, err
 }
 
+/*
+ManageV1alpha1WorkspacePolicyResourceServiceList lists the policies scoped to a workspace resource.
+*/
+func (p *Client) ManageV1alpha1WorkspacePolicyResourceServiceList(fn *policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyFullName) (*policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyListPoliciesResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.WorkspaceName, apiKind).String()
+	policyWorkspaceResponse := &policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyListPoliciesResponse{}
+	err := p.Get(requestURL, policyWorkspaceResponse)
+
+	return policyWorkspaceResponse, err
+}
+
 /*
 ManageV1alpha1WorkspacePolicyResourceServiceUpdate updates overwrite a policy scoped to a workspace resource.
 */
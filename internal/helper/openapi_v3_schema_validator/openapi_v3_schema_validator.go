@@ -5,6 +5,7 @@
 package openapiv3schemavalidator
 
 import (
+	"fmt"
 	"regexp"
 
 	"github.com/pkg/errors"
@@ -41,14 +42,32 @@ const (
 
 type OpenAPIV3SchemaValidator struct {
 	Schema map[string]interface{}
+
+	// AllowUnknownFields allows keys which aren't in the schema at the root of the validated object.
+	AllowUnknownFields bool
+
+	// SchemaName is the name of the schema in the errors of unexpected keys, the cluster class schema when not set.
+	// When set, the errors of nested keys hold their full path and nested objects accepting unknown keys are honoured,
+	// otherwise the errors name the nested keys alone like the cluster class validation always did.
+	SchemaName string
+}
+
+// schemaWalker validates the values of nested keys against their schemas.
+type schemaWalker struct {
+	qualifiedPaths bool
+}
+
+func (validator *OpenAPIV3SchemaValidator) walker() *schemaWalker {
+	return &schemaWalker{qualifiedPaths: validator.SchemaName != ""}
 }
 
 func (validator *OpenAPIV3SchemaValidator) ValidateRequiredFields(objectValues map[string]interface{}) (errs []error) {
 	errs = make([]error, 0, len(validator.Schema))
+	walker := validator.walker()
 
 	for k, v := range validator.Schema {
 		objectValue := objectValues[k]
-		errs = append(errs, validateRequiredFields(false, k, objectValue, v.(map[string]interface{}))...)
+		errs = append(errs, walker.validateRequiredFields(false, k, objectValue, v.(map[string]interface{}))...)
 	}
 
 	return errs
@@ -56,14 +75,21 @@ func (validator *OpenAPIV3SchemaValidator) ValidateRequiredFields(objectValues m
 
 func (validator *OpenAPIV3SchemaValidator) ValidateFormat(objectValues map[string]interface{}) (errs []error) {
 	errs = make([]error, 0)
+	walker := validator.walker()
 
 	for k, v := range objectValues {
 		fieldSchema, fieldExists := validator.Schema[k]
 
 		if !fieldExists {
-			errs = append(errs, errors.Errorf("Key '%s' is not expected in cluster class schema.", k))
+			switch {
+			case validator.AllowUnknownFields:
+			case validator.SchemaName != "":
+				errs = append(errs, errors.Errorf("Key '%s' is not expected in the %s schema.", k, validator.SchemaName))
+			default:
+				errs = append(errs, errors.Errorf("Key '%s' is not expected in cluster class schema.", k))
+			}
 		} else {
-			vErrs := validateSchemaFormat(k, v, fieldSchema.(map[string]interface{}))
+			vErrs := walker.validateSchemaFormat(k, v, fieldSchema.(map[string]interface{}))
 
 			for _, e := range vErrs {
 				errs = append(errs, errors.Wrapf(e, "Value validation failed for key '%s'", k))
@@ -74,7 +100,7 @@ func (validator *OpenAPIV3SchemaValidator) ValidateFormat(objectValues map[strin
 	return errs
 }
 
-func validateRequiredFields(isParentRequired bool, parentKey string, variableValue interface{}, variableSchema map[string]interface{}) (errs []error) {
+func (walker *schemaWalker) validateRequiredFields(isParentRequired bool, parentKey string, variableValue interface{}, variableSchema map[string]interface{}) (errs []error) {
 	errs = make([]error, 0)
 	requiredValue := variableSchema[string(RequiredKey)]
 	isRequired, isRequiredBool := requiredValue.(bool)
@@ -119,7 +145,7 @@ func validateRequiredFields(isParentRequired bool, parentKey string, variableVal
 					errs = append(errs, errors.Errorf("Key '%s' is required in object '%s' but not provided!", k, parentKey))
 				}
 
-				for _, e := range validateRequiredFields(isRequired || isParentRequired, k, subKeyValue, v.(map[string]interface{})) {
+				for _, e := range walker.validateRequiredFields(isRequired || isParentRequired, walker.joinPath(parentKey, k), subKeyValue, v.(map[string]interface{})) {
 					errs = append(errs, errors.Wrapf(e, "Object '%s' field validation failed", parentKey))
 				}
 			}
@@ -129,14 +155,62 @@ func validateRequiredFields(isParentRequired bool, parentKey string, variableVal
 	return errs
 }
 
-func validateSchemaFormat(parentKey string, variableValue interface{}, variableSchema map[string]interface{}) (errs []error) {
-	varType := variableSchema[string(TypeKey)].(string)
+// ApplyDefaults returns a copy of the object values with the default values of the schema set for the keys which aren't provided.
+func (validator *OpenAPIV3SchemaValidator) ApplyDefaults(objectValues map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(objectValues))
+
+	for k, v := range objectValues {
+		result[k] = v
+	}
+
+	for k, v := range validator.Schema {
+		fieldSchema, _ := v.(map[string]interface{})
+
+		if value, ok := applyDefaults(result[k], fieldSchema); ok {
+			result[k] = value
+		}
+	}
+
+	return result
+}
+
+func applyDefaults(variableValue interface{}, variableSchema map[string]interface{}) (interface{}, bool) {
+	if variableValue == nil {
+		if defaultValue, ok := variableSchema[string(DefaultKey)]; ok {
+			variableValue = defaultValue
+		}
+	}
+
+	properties, _ := variableSchema[string(PropertiesKey)].(map[string]interface{})
+
+	if variableSchema[string(TypeKey)] != string(ObjectType) || len(properties) == 0 {
+		return variableValue, variableValue != nil
+	}
+
+	variableValueMap, ok := variableValue.(map[string]interface{})
+	if variableValue != nil && !ok {
+		return variableValue, true
+	}
+
+	validator := &OpenAPIV3SchemaValidator{Schema: properties}
+	result := validator.ApplyDefaults(variableValueMap)
+
+	// Objects without any value or default are left out.
+	if variableValue == nil && len(result) == 0 {
+		return nil, false
+	}
+
+	return result, true
+}
+
+func (walker *schemaWalker) validateSchemaFormat(parentKey string, variableValue interface{}, variableSchema map[string]interface{}) (errs []error) {
+	varType, _ := variableSchema[string(TypeKey)].(string)
 
 	switch varType {
 	case string(ObjectType):
-		errs = validateObjectFormat(parentKey, variableValue, variableSchema)
+		errs = walker.validateObjectFormat(parentKey, variableValue, variableSchema)
 	case string(ArrayType):
-		errs = validateArrayFormat(parentKey, variableValue, variableSchema)
+		errs = walker.validateArrayFormat(parentKey, variableValue, variableSchema)
 	case string(StringType):
 		errs = validateStringFormat(parentKey, variableValue, variableSchema)
 	case string(BooleanType):
@@ -148,7 +222,7 @@ func validateSchemaFormat(parentKey string, variableValue interface{}, variableS
 	return errs
 }
 
-func validateObjectFormat(parentKey string, variableValue interface{}, variableSchema map[string]interface{}) (errs []error) {
+func (walker *schemaWalker) validateObjectFormat(parentKey string, variableValue interface{}, variableSchema map[string]interface{}) (errs []error) {
 	errs = make([]error, 0)
 
 	if variableValueMap, ok := variableValue.(map[string]interface{}); !ok {
@@ -160,15 +234,18 @@ func validateObjectFormat(parentKey string, variableValue interface{}, variableS
 			for k, v := range variableValueMap {
 				kSchema, kSchemaExist := objSchema.(map[string]interface{})[k]
 
-				if !kSchemaExist {
+				switch {
+				case kSchemaExist:
+					errs = append(errs, walker.validateSchemaFormat(walker.joinPath(parentKey, k), v, kSchema.(map[string]interface{}))...)
+				case !walker.qualifiedPaths:
 					errs = append(errs, errors.Errorf("Key '%s' is not expected in key %s.", k, parentKey))
-				} else {
-					errs = append(errs, validateSchemaFormat(k, v, kSchema.(map[string]interface{}))...)
+				case !AllowsUnknownFields(variableSchema):
+					errs = append(errs, errors.Errorf("Key '%s' is not expected in the schema.", walker.joinPath(parentKey, k)))
 				}
 			}
-		} else {
+		} else if additionalPropertiesSchema, ok := variableSchema[string(AdditionalPropertiesKey)].(map[string]interface{}); ok {
 			for k, v := range variableValueMap {
-				errs = append(errs, validateSchemaFormat(k, v, variableSchema[string(AdditionalPropertiesKey)].(map[string]interface{}))...)
+				errs = append(errs, walker.validateSchemaFormat(walker.joinPath(parentKey, k), v, additionalPropertiesSchema)...)
 			}
 		}
 	}
@@ -176,16 +253,47 @@ func validateObjectFormat(parentKey string, variableValue interface{}, variableS
 	return errs
 }
 
-func validateArrayFormat(parentKey string, variableValue interface{}, variableSchema map[string]interface{}) (errs []error) {
+// joinPath returns the path of a key of an object, the keys of nested objects and the indexes of arrays are part of the path of a value.
+// The key is returned alone when the paths aren't qualified.
+func (walker *schemaWalker) joinPath(parentPath string, key string) string {
+	if !walker.qualifiedPaths || parentPath == "" {
+		return key
+	}
+
+	return fmt.Sprintf("%s.%s", parentPath, key)
+}
+
+// AllowsUnknownFields checks whether an object schema accepts keys which aren't in its properties.
+func AllowsUnknownFields(objectSchema map[string]interface{}) bool {
+	if preserveUnknownFields, _ := objectSchema[string(PreserveUnknownFieldKey)].(bool); preserveUnknownFields {
+		return true
+	}
+
+	additionalProperties, ok := objectSchema[string(AdditionalPropertiesKey)]
+
+	if allowed, isBool := additionalProperties.(bool); isBool {
+		return allowed
+	}
+
+	return ok && additionalProperties != nil
+}
+
+func (walker *schemaWalker) validateArrayFormat(parentKey string, variableValue interface{}, variableSchema map[string]interface{}) (errs []error) {
 	errs = make([]error, 0)
 
 	if variableValueArray, ok := variableValue.([]interface{}); !ok {
 		errs = append(errs, errors.Errorf("Key '%s' should be an array, type provided: %T", parentKey, variableValue))
 
 		return errs
-	} else {
-		for _, it := range variableValueArray {
-			errs = append(errs, validateSchemaFormat(parentKey, it, variableSchema[string(ItemsKey)].(map[string]interface{}))...)
+	} else if itemsSchema, ok := variableSchema[string(ItemsKey)].(map[string]interface{}); ok {
+		for i, it := range variableValueArray {
+			itemKey := parentKey
+
+			if walker.qualifiedPaths {
+				itemKey = fmt.Sprintf("%s[%d]", parentKey, i)
+			}
+
+			errs = append(errs, walker.validateSchemaFormat(itemKey, it, itemsSchema)...)
 		}
 	}
 
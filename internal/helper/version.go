@@ -0,0 +1,325 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"strconv"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+	"github.com/pkg/errors"
+)
+
+const (
+	orSeparator = "||"
+	wildcard    = "*"
+	caret       = "^"
+	tilde       = "~"
+)
+
+var (
+	operators     = []string{">=", "<=", "!=", "==", ">", "<", "="}
+	helmOperators = append([]string{caret, tilde}, operators...)
+)
+
+type constraintTerm struct {
+	operator string
+	version  *goversion.Version
+}
+
+// Constraints is a parsed package version constraint in the format used by kapp-controller, e.g. ">=1.2.0 <2.0.0 || 3.0.0".
+// Ranges separated by "||" are alternatives, the terms of a range (separated by spaces or commas) must all be satisfied.
+type Constraints struct {
+	ranges      [][]constraintTerm
+	prereleases bool
+}
+
+// ParseConstraints parses the constraints of a package version selection, empty constraints and "*" match any version.
+func ParseConstraints(constraints string) (*Constraints, error) {
+	return parseConstraints(constraints, operators)
+}
+
+// ParseHelmConstraints parses version constraints in the format used by helm, which adds the caret and tilde ranges
+// to the format of ParseConstraints, e.g. "^1.20.0-0" matches the versions from 1.20.0-0 up to 2.0.0 and "~1.2" the 1.2 versions.
+func ParseHelmConstraints(constraints string) (*Constraints, error) {
+	return parseConstraints(constraints, helmOperators)
+}
+
+func parseConstraints(constraints string, allowedOperators []string) (*Constraints, error) {
+	parsed := &Constraints{}
+
+	for _, rangeValue := range strings.Split(constraints, orSeparator) {
+		terms, err := parseRange(rangeValue, allowedOperators)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid version constraints %q", constraints)
+		}
+
+		for _, term := range terms {
+			if term.version.Prerelease() != "" {
+				parsed.prereleases = true
+			}
+		}
+
+		parsed.ranges = append(parsed.ranges, terms)
+	}
+
+	return parsed, nil
+}
+
+func parseRange(rangeValue string, allowedOperators []string) ([]constraintTerm, error) {
+	tokens := strings.Fields(strings.ReplaceAll(rangeValue, ",", " "))
+	terms := make([]constraintTerm, 0, len(tokens))
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		operator := ""
+
+		for _, op := range allowedOperators {
+			if strings.HasPrefix(token, op) {
+				operator = op
+				token = strings.TrimPrefix(token, op)
+
+				break
+			}
+		}
+
+		// The operator may be separated from the version by a space, e.g. ">= 1.2.0".
+		if token == "" {
+			if i+1 >= len(tokens) {
+				return nil, errors.Errorf("operator %q is missing a version", operator)
+			}
+
+			i++
+			token = tokens[i]
+		}
+
+		if token == wildcard {
+			continue
+		}
+
+		if operator == caret || operator == tilde {
+			rangeTerms, err := parseCaretOrTilde(operator, token)
+			if err != nil {
+				return nil, err
+			}
+
+			terms = append(terms, rangeTerms...)
+
+			continue
+		}
+
+		wildcardTerms, ok, err := parseWildcard(operator, token)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			terms = append(terms, wildcardTerms...)
+			continue
+		}
+
+		version, err := goversion.NewVersion(token)
+		if err != nil {
+			return nil, err
+		}
+
+		if operator == "" {
+			operator = "="
+		}
+
+		terms = append(terms, constraintTerm{operator: operator, version: version})
+	}
+
+	return terms, nil
+}
+
+// parseWildcard expands versions such as "1.2.x" or "1.*" into the range of versions they match.
+func parseWildcard(operator, token string) ([]constraintTerm, bool, error) {
+	segments := strings.Split(strings.TrimPrefix(token, "v"), ".")
+	fixed := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		if segment == "x" || segment == "X" || segment == wildcard {
+			break
+		}
+
+		fixed = append(fixed, segment)
+	}
+
+	if len(fixed) == len(segments) {
+		return nil, false, nil
+	}
+
+	if operator != "" && operator != "=" && operator != "==" {
+		return nil, false, errors.Errorf("operator %q can't be used with the wildcard version %q", operator, token)
+	}
+
+	if len(fixed) == 0 {
+		return nil, true, nil
+	}
+
+	lower, err := goversion.NewVersion(strings.Join(fixed, "."))
+	if err != nil {
+		return nil, false, err
+	}
+
+	upperSegments := lower.Segments()[:len(fixed)]
+	upperSegments[len(upperSegments)-1]++
+
+	upper, err := goversion.NewVersion(joinSegments(upperSegments))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return []constraintTerm{{operator: ">=", version: lower}, {operator: "<", version: upper}}, true, nil
+}
+
+// parseCaretOrTilde expands a caret or tilde range into the range of versions it matches.
+// A caret range allows the changes which don't modify the first non-zero component, e.g. "^1.2.3" is ">=1.2.3 <2.0.0" and "^0.2.3" is ">=0.2.3 <0.3.0".
+// A tilde range allows the patch changes when the minor version is given, e.g. "~1.2.3" is ">=1.2.3 <1.3.0", and the minor changes otherwise.
+func parseCaretOrTilde(operator, token string) ([]constraintTerm, error) {
+	value := strings.TrimPrefix(token, "v")
+	core := strings.SplitN(strings.SplitN(value, "+", 2)[0], "-", 2)[0]
+	segments := strings.Split(core, ".")
+	components := 0
+
+	for _, segment := range segments {
+		if segment == "x" || segment == "X" || segment == wildcard {
+			break
+		}
+
+		components++
+	}
+
+	// The wildcard segments of the range, e.g. "^1.x", match any value.
+	if components < len(segments) {
+		if components == 0 {
+			return nil, nil
+		}
+
+		value = strings.Join(segments[:components], ".")
+	}
+
+	lower, err := goversion.NewVersion(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// The segments of a version are padded to major, minor and patch.
+	major, minor, patch := lower.Segments()[0], lower.Segments()[1], lower.Segments()[2]
+
+	var upperSegments []int
+
+	switch {
+	case operator == tilde && components > 1:
+		upperSegments = []int{major, minor + 1, 0}
+	case operator == tilde, major > 0, components == 1:
+		upperSegments = []int{major + 1, 0, 0}
+	case minor > 0, components == 2:
+		upperSegments = []int{0, minor + 1, 0}
+	default:
+		upperSegments = []int{0, 0, patch + 1}
+	}
+
+	upper, err := goversion.NewVersion(joinSegments(upperSegments))
+	if err != nil {
+		return nil, err
+	}
+
+	return []constraintTerm{{operator: ">=", version: lower}, {operator: "<", version: upper}}, nil
+}
+
+func joinSegments(segments []int) string {
+	values := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		values = append(values, strconv.Itoa(segment))
+	}
+
+	return strings.Join(values, ".")
+}
+
+// Check returns true when the version satisfies the constraints.
+// Pre-release versions are only matched when the constraints reference a pre-release version.
+func (c *Constraints) Check(version string) bool {
+	v, err := goversion.NewVersion(version)
+	if err != nil {
+		return false
+	}
+
+	if v.Prerelease() != "" && !c.prereleases {
+		return false
+	}
+
+	for _, terms := range c.ranges {
+		if checkRange(terms, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkRange(terms []constraintTerm, v *goversion.Version) bool {
+	for _, term := range terms {
+		if !term.check(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t constraintTerm) check(v *goversion.Version) bool {
+	result := v.Compare(t.version)
+
+	switch t.operator {
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case "!=":
+		return !t.matchesExactly(v, result)
+	default:
+		return t.matchesExactly(v, result)
+	}
+}
+
+// matchesExactly compares the build metadata (e.g. +vmware.1-tkg.1) as well when the constraint specifies it.
+func (t constraintTerm) matchesExactly(v *goversion.Version, result int) bool {
+	return result == 0 && (t.version.Metadata() == "" || t.version.Metadata() == v.Metadata())
+}
+
+// IsExact checks whether the constraints select a single version, e.g. "1.9.5+vmware.1-tkg.1".
+func (c *Constraints) IsExact() bool {
+	if len(c.ranges) != 1 || len(c.ranges[0]) != 1 {
+		return false
+	}
+
+	term := c.ranges[0][0]
+
+	return term.operator == "=" || term.operator == "=="
+}
+
+// CompareVersions compares two versions, build metadata (e.g. +vmware.1-tkg.1) is compared lexically when the versions are otherwise equal.
+// Versions which can't be parsed are compared lexically.
+func CompareVersions(a, b string) int {
+	versionA, errA := goversion.NewVersion(a)
+	versionB, errB := goversion.NewVersion(b)
+
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+
+	if result := versionA.Compare(versionB); result != 0 {
+		return result
+	}
+
+	return strings.Compare(versionA.Metadata(), versionB.Metadata())
+}
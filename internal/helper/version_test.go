@@ -0,0 +1,100 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConstraints(t *testing.T) {
+	cases := []struct {
+		name        string
+		constraints string
+		expectError bool
+	}{
+		{name: "exact version", constraints: "1.9.5+vmware.1-tkg.1"},
+		{name: "range", constraints: ">=1.7.0 <2.0.0"},
+		{name: "operator separated from the version", constraints: ">= 1.7.0, < 2.0.0"},
+		{name: "alternatives", constraints: "1.1.0 || >=2.0.0"},
+		{name: "wildcard", constraints: "1.7.x"},
+		{name: "any version", constraints: "*"},
+		{name: "invalid version", constraints: ">=latest", expectError: true},
+		{name: "operator without version", constraints: ">=", expectError: true},
+		{name: "operator with wildcard", constraints: ">1.x", expectError: true},
+		{name: "caret range", constraints: "^1.2.3", expectError: true},
+		{name: "tilde range", constraints: "~1.2", expectError: true},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseConstraints(test.constraints)
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseHelmConstraints(t *testing.T) {
+	cases := []struct {
+		constraints string
+		matching    []string
+		excluded    []string
+	}{
+		{constraints: "^1.2.3", matching: []string{"1.2.3", "1.9.0"}, excluded: []string{"1.2.2", "2.0.0"}},
+		{constraints: "^0.4.1", matching: []string{"0.4.1", "0.4.9"}, excluded: []string{"0.5.0"}},
+		{constraints: "^0.0.3", matching: []string{"0.0.3"}, excluded: []string{"0.0.4"}},
+		{constraints: "^0", matching: []string{"0.9.0"}, excluded: []string{"1.0.0"}},
+		{constraints: "^1.x", matching: []string{"1.0.0", "1.9.0"}, excluded: []string{"2.0.0"}},
+		{constraints: "^1.23.0-0", matching: []string{"1.23.0-gke.1", "1.30.1"}, excluded: []string{"1.22.9", "2.0.0"}},
+		{constraints: "~1.25", matching: []string{"1.25.0", "1.25.9"}, excluded: []string{"1.26.0"}},
+		{constraints: "~1.2.3", matching: []string{"1.2.9"}, excluded: []string{"1.2.2", "1.3.0"}},
+		{constraints: "~1", matching: []string{"1.9.0"}, excluded: []string{"2.0.0"}},
+		{constraints: ">=1.19.0-0, <1.30.0", matching: []string{"1.29.5"}, excluded: []string{"1.30.0"}},
+		{constraints: "~1.25 || ^2.1", matching: []string{"1.25.3", "2.5.0"}, excluded: []string{"1.26.0", "3.0.0"}},
+	}
+
+	for _, test := range cases {
+		t.Run(test.constraints, func(t *testing.T) {
+			constraints, err := ParseHelmConstraints(test.constraints)
+			require.NoError(t, err)
+
+			for _, version := range test.matching {
+				require.True(t, constraints.Check(version), version)
+			}
+
+			for _, version := range test.excluded {
+				require.False(t, constraints.Check(version), version)
+			}
+		})
+	}
+
+	_, err := ParseHelmConstraints("^latest")
+	require.Error(t, err)
+}
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		name     string
+		a        string
+		b        string
+		expected int
+	}{
+		{name: "equal versions", a: "1.28.3", b: "v1.28.3", expected: 0},
+		{name: "newer patch version", a: "1.28.10", b: "1.28.9", expected: 1},
+		{name: "older minor version", a: "1.27.10", b: "1.28.1", expected: -1},
+		{name: "newer build metadata", a: "v1.28.3+vmware.1-tkg.2", b: "v1.28.3+vmware.1-tkg.1", expected: 1},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, CompareVersions(test.a, test.b))
+		})
+	}
+}
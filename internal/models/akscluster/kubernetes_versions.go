@@ -0,0 +1,75 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1AksclusterKubernetesVersion Kubernetes version supported by the cloud provider.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.akscluster.KubernetesVersion
+type VmwareTanzuManageV1alpha1AksclusterKubernetesVersion struct {
+
+	// Whether the version is the default version used by the cloud provider.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// Versions the version can be upgraded to.
+	Upgrades []string `json:"upgrades"`
+
+	// Kubernetes version.
+	Version string `json:"version,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1AksclusterKubernetesVersion) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1AksclusterKubernetesVersion) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1AksclusterKubernetesVersion
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1AksclusterGetKubernetesVersionsResponse Response from getting the Kubernetes versions supported by the cloud provider.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.akscluster.GetKubernetesVersionsResponse
+type VmwareTanzuManageV1alpha1AksclusterGetKubernetesVersionsResponse struct {
+
+	// List of Kubernetes versions.
+	KubernetesVersions []*VmwareTanzuManageV1alpha1AksclusterKubernetesVersion `json:"kubernetesVersions"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1AksclusterGetKubernetesVersionsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1AksclusterGetKubernetesVersionsResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1AksclusterGetKubernetesVersionsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package clustermodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1ClusterSearchScope Scope of a cluster search.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.SearchScope
+type VmwareTanzuManageV1alpha1ClusterSearchScope struct {
+
+	// Scope search to the specified cluster_group_name; supports globbing; default (*).
+	ClusterGroupName string `json:"clusterGroupName,omitempty"`
+
+	// Scope search to the specified management_cluster_name; supports globbing; default (*).
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Scope search to the specified name; supports globbing; default (*).
+	Name string `json:"name,omitempty"`
+
+	// Scope search to the specified provisioner_name; supports globbing; default (*).
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterListClustersResponse Response from listing Clusters.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.ListClustersResponse
+type VmwareTanzuManageV1alpha1ClusterListClustersResponse struct {
+
+	// List of clusters.
+	Clusters []*VmwareTanzuManageV1alpha1ClusterCluster `json:"clusters"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterListClustersResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterListClustersResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterListClustersResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package customiamrolemodels
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1IamRoleListRolesResponse Response from listing Roles.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.iam.role.ListRolesResponse
+type VmwareTanzuManageV1alpha1IamRoleListRolesResponse struct {
+
+	// List of roles.
+	Roles []*VmwareTanzuManageV1alpha1IamRole `json:"roles"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleListRolesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1IamRoleListRolesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1IamRoleListRolesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
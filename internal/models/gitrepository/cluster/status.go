@@ -17,6 +17,9 @@ type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdGitrepositoryStatus struct {
 
 	// The conditions attached to this Repository object.
 	Conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition `json:"conditions,omitempty"`
+
+	// Generation value at the time this status was updated.
+	ObservedGeneration string `json:"observedGeneration,omitempty"`
 }
 
 // MarshalBinary interface implementation.
@@ -24,6 +24,12 @@ type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec struct {
 	// Interval at which to reconcile the Helm release.
 	Interval string `json:"interval,omitempty"`
 
+	// Revision of the release to roll back to, the release is pinned to it until unset.
+	RollbackToRevision int32 `json:"rollbackToRevision,omitempty"`
+
+	// Suspend the reconciliation of the Helm release.
+	Suspend bool `json:"suspend,omitempty"`
+
 	// Name of target namespace.
 	TargetNamespace string `json:"targetNamespace,omitempty"`
 }
@@ -5,6 +5,7 @@
 package helmreleaseclustermodel
 
 import (
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
 	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
@@ -20,6 +21,12 @@ type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseStatus struct {
 
 	// Kuberenetes RBAC resources and service account created on the cluster by TMC for Helm Release.
 	GeneratedResources *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseGeneratedResources `json:"generatedResources,omitempty"`
+
+	// Release history of the Helm Release, latest revision first.
+	History []*VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSnapshot `json:"history,omitempty"`
+
+	// Generation value at the time this status was updated.
+	ObservedGeneration string `json:"observedGeneration,omitempty"`
 }
 
 // MarshalBinary interface implementation.
@@ -78,3 +85,46 @@ func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseGeneratedReso
 
 	return nil
 }
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSnapshot Revision of the Helm Release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.fluxcd.helm.release.Snapshot
+type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSnapshot struct {
+
+	// App version of the chart of the revision.
+	AppVersion string `json:"appVersion,omitempty"`
+
+	// Chart version of the revision.
+	ChartVersion string `json:"chartVersion,omitempty"`
+
+	// Time at which the revision was deployed.
+	// Format: date-time
+	DeployedTime strfmt.DateTime `json:"deployedTime,omitempty"`
+
+	// Revision number of the release.
+	Revision int32 `json:"revision,omitempty"`
+
+	// Status of the revision, e.g. deployed, superseded or failed.
+	Status string `json:"status,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSnapshot) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSnapshot) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSnapshot
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,46 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepositoryclustergroupmodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName Full name of the cluster group helm repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.fluxcd.helm.repository.FullName
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName struct {
+
+	// Name of Cluster Group.
+	ClusterGroupName string `json:"clusterGroupName,omitempty"`
+
+	// Name of the helm repository.
+	Name string `json:"name,omitempty"`
+
+	// Name of Namespace.
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepositoryclustergroupmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository Represents Helm Repository on a cluster group.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.fluxcd.helm.repository.Repository
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository struct {
+
+	// Full name for the helm repository.
+	FullName *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName `json:"fullName,omitempty"`
+
+	// Metadata for the helm repository object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the helm repository.
+	Spec *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec `json:"spec,omitempty"`
+
+	// Status for the helm repository.
+	Status *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepositoryclustergroupmodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest Request to create or update a cluster group Repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.fluxcd.helm.repository.CreateRepositoryRequest
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest struct {
+
+	// Repository to create or update.
+	Repository *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository `json:"repository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse Response from creating or updating a cluster group Repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.fluxcd.helm.repository.CreateRepositoryResponse
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse struct {
+
+	// Repository created or updated.
+	Repository *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository `json:"repository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,37 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepositoryclustergroupmodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryGetResponse Response from getting a cluster group Repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.fluxcd.helm.repository.GetRepositoryResponse
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryGetResponse struct {
+
+	// Repository returned.
+	Repository *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository `json:"repository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryGetResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryGetResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryGetResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepositoryclustergroupmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	helmrepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository"
+)
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec Spec for the cluster group helm repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.fluxcd.helm.repository.Spec
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec struct {
+
+	// Spec of helm repository as defined at atomic level.
+	AtomicSpec *helmrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec `json:"atomicSpec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
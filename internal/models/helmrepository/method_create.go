@@ -0,0 +1,67 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepositoryclustermodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest Request to create or update a Repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.fluxcd.helm.repository.CreateRepositoryRequest
+type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest struct {
+
+	// Repository to create or update.
+	Repository *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepository `json:"repository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse Response from creating or updating a Repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.fluxcd.helm.repository.CreateRepositoryResponse
+type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse struct {
+
+	// Repository created or updated.
+	Repository *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepository `json:"repository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
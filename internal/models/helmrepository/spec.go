@@ -13,6 +13,24 @@ type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec struct {
 
 	// URL of helm repository.
 	URL string `json:"url,omitempty"`
+
+	// Type of the helm repository, either an HTTP/S helm repository index or an OCI registry.
+	Type *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType `json:"type,omitempty"`
+
+	// Name of the source secret holding the basic-auth credentials of the repository.
+	SecretRef string `json:"secretRef,omitempty"`
+
+	// Name of the source secret holding the TLS client certificate, key and CA certificate of the repository.
+	CertSecretRef string `json:"certSecretRef,omitempty"`
+
+	// PassCredentials allows the credentials to be passed to a host other than the one of the repository URL.
+	PassCredentials bool `json:"passCredentials,omitempty"`
+
+	// Interval at which to check the repository for updates.
+	Interval string `json:"interval,omitempty"`
+
+	// Suspend tells the controller to suspend the reconciliation of the repository.
+	Suspend bool `json:"suspend,omitempty"`
 }
 
 // MarshalBinary interface implementation.
@@ -35,3 +53,29 @@ func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec) Unma
 
 	return nil
 }
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType Type of the helm repository.
+//
+//   - DEFAULT: HTTP/S helm repository serving an index.yaml.
+//   - OCI: OCI registry hosting helm charts as OCI artifacts.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.fluxcd.helm.repository.Type
+type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType string
+
+func NewVmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType(value VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType) *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType.
+func (m VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType) Pointer() *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType {
+	return &m
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeDEFAULT captures enum value "DEFAULT".
+	VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeDEFAULT VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType = "DEFAULT"
+
+	// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeOCI captures enum value "OCI".
+	VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeOCI VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType = "OCI"
+)
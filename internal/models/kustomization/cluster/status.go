@@ -17,6 +17,9 @@ type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdKustomizationStatus struct {
 
 	// The conditions attached to this Kustomization object.
 	Conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition `json:"conditions,omitempty"`
+
+	// Generation value at the time this status was updated.
+	ObservedGeneration string `json:"observedGeneration,omitempty"`
 }
 
 // MarshalBinary interface implementation.
@@ -0,0 +1,114 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyinsightsmodel
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1PolicyInsightsIssue A policy violation reported by a cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.insights.Issue
+type VmwareTanzuManageV1alpha1PolicyInsightsIssue struct {
+
+	// Name of the cluster group of the cluster.
+	ClusterGroupName string `json:"clusterGroupName,omitempty"`
+
+	// Name of the cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Enforcement action of the policy, one of deny, dryrun or warn.
+	EnforcementAction string `json:"enforcementAction,omitempty"`
+
+	// Time the violation was first seen.
+	// Format: date-time
+	FirstSeen strfmt.DateTime `json:"firstSeen,omitempty"`
+
+	// Time the violation was last seen.
+	// Format: date-time
+	LastSeen strfmt.DateTime `json:"lastSeen,omitempty"`
+
+	// Name of the management cluster of the cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Message of the violation.
+	Message string `json:"message,omitempty"`
+
+	// Name of the namespace of the violating resource.
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// Name of the policy.
+	PolicyName string `json:"policyName,omitempty"`
+
+	// Type of the policy, e.g. security-policy.
+	PolicyType string `json:"policyType,omitempty"`
+
+	// Name of the provisioner of the cluster.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+
+	// The violating resource.
+	Resource *VmwareTanzuManageV1alpha1PolicyInsightsResource `json:"resource,omitempty"`
+
+	// Name of the workspace of the namespace.
+	WorkspaceName string `json:"workspaceName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightsIssue) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightsIssue) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyInsightsIssue
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyInsightsResource A Kubernetes resource violating a policy.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.insights.Resource
+type VmwareTanzuManageV1alpha1PolicyInsightsResource struct {
+
+	// API version of the resource.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind of the resource.
+	Kind string `json:"kind,omitempty"`
+
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightsResource) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightsResource) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyInsightsResource
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,96 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyinsightsmodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1PolicyInsightsSearchScope Scope of a policy issues search.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.insights.SearchScope
+type VmwareTanzuManageV1alpha1PolicyInsightsSearchScope struct {
+
+	// Scope search to the specified cluster_group_name.
+	ClusterGroupName string `json:"clusterGroupName,omitempty"`
+
+	// Scope search to the specified cluster_name.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Scope search to the specified enforcement_action.
+	EnforcementAction string `json:"enforcementAction,omitempty"`
+
+	// Scope search to the specified management_cluster_name.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Scope search to the specified namespace_name.
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// Scope search to the specified policy_name; supports globbing.
+	PolicyName string `json:"policyName,omitempty"`
+
+	// Scope search to the specified policy_type.
+	PolicyType string `json:"policyType,omitempty"`
+
+	// Scope search to the specified provisioner_name.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+
+	// Scope search to the specified workspace_name.
+	WorkspaceName string `json:"workspaceName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightsSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightsSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyInsightsSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyInsightsListIssuesResponse Response from listing policy issues.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.insights.ListIssuesResponse
+type VmwareTanzuManageV1alpha1PolicyInsightsListIssuesResponse struct {
+
+	// List of policy issues.
+	Issues []*VmwareTanzuManageV1alpha1PolicyInsightsIssue `json:"issues"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightsListIssuesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyInsightsListIssuesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyInsightsListIssuesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,40 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyorganizationmodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1OrganizationPolicyListPoliciesResponse Response from listing Policies.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.organization.policy.ListPoliciesResponse
+type VmwareTanzuManageV1alpha1OrganizationPolicyListPoliciesResponse struct {
+
+	// List of policies.
+	Policies []*VmwareTanzuManageV1alpha1OrganizationPolicyPolicy `json:"policies"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1OrganizationPolicyListPoliciesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1OrganizationPolicyListPoliciesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1OrganizationPolicyListPoliciesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
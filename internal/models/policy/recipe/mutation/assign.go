@@ -0,0 +1,77 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyrecipemutationmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign The input schema for assign mutation policy recipe version v1.
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign struct {
+	// Location Path of the field to be mutated, e.g. 'spec.containers[name:*].imagePullPolicy'
+	Location string `json:"location"`
+
+	// PathTests Conditions on the sub paths of the location which must be satisfied for the mutation to be applied
+	PathTests []*VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1AssignPathTest `json:"pathTests,omitempty"`
+
+	// Scope Filter the defined target Kubernetes resources by 'Cluster' or 'Namespace' scope. Defaults to '*' (no filter)
+	Scope *policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope `json:"scope,omitempty"`
+
+	// TargetKubernetesResources List of Kubernetes API resources on which the policy will be enforced, identified using apiGroups and kinds. Use 'kubectl api-resources' to view the list of available API resources
+	TargetKubernetesResources []*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources `json:"targetKubernetesResources"`
+
+	// Value Value assigned to the field at the location
+	Value interface{} `json:"value"`
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1AssignPathTest Condition on a sub path of the location of an assign mutation.
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1AssignPathTest struct {
+	// Condition 'MustExist' or 'MustNotExist'
+	Condition string `json:"condition"`
+
+	// SubPath Prefix of the location to be tested
+	SubPath string `json:"subPath"`
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1AssignPathTest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1AssignPathTest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1AssignPathTest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyrecipemutationmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite The input schema for image registry rewrite mutation policy recipe version v1.
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite struct {
+	// Rules Registries rewritten in the images of the containers, init containers and ephemeral containers
+	Rules []*VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewriteRule `json:"rules"`
+
+	// Scope Filter the defined target Kubernetes resources by 'Cluster' or 'Namespace' scope. Defaults to '*' (no filter)
+	Scope *policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope `json:"scope,omitempty"`
+
+	// TargetKubernetesResources List of Kubernetes API resources on which the policy will be enforced, identified using apiGroups and kinds. Use 'kubectl api-resources' to view the list of available API resources
+	TargetKubernetesResources []*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources `json:"targetKubernetesResources"`
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewriteRule Registry of the images to be rewritten and its replacement.
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewriteRule struct {
+	// SourceRegistry Registry of the images to be rewritten, e.g. 'docker.io'
+	SourceRegistry string `json:"sourceRegistry"`
+
+	// TargetRegistry Registry replacing the source registry, e.g. 'registry.example.com/docker.io'
+	TargetRegistry string `json:"targetRegistry"`
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewriteRule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewriteRule) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewriteRule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -15,6 +15,12 @@ import "github.com/go-openapi/swag"
 // swagger:model VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom
 type VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom struct {
 
+	// Across all pods in a non-terminal state, the sum of requests of each extended resource, e.g. nvidia.com/gpu, cannot exceed this value.
+	ExtendedResources map[string]string `json:"extendedResources,omitempty"`
+
+	// Default requests and limits of the containers of the namespace, and maximum limits of a container.
+	LimitRange *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange `json:"limitRange,omitempty"`
+
 	// The sum of CPU limits across all pods in a non-terminal state cannot exceed this value.
 	LimitsCPU string `json:"limitsCpu,omitempty"`
 
@@ -66,3 +72,53 @@ func (m *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom) UnmarshalBinary
 
 	return nil
 }
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange Limit range of namespace quota policy custom recipe version v1
+//
+// # The default requests and limits applied to the containers which don't set them, and the maximum limits of a container
+//
+// swagger:model VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange
+type VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange struct {
+
+	// The CPU limit of a container which doesn't set it.
+	DefaultLimitsCPU string `json:"defaultLimitsCpu,omitempty"`
+
+	// The memory limit of a container which doesn't set it.
+	// Pattern: ^[0-9]+(E|P|T|G|M|K|Ei|Pi|Ti|Gi|Mi|Ki)?$
+	DefaultLimitsMemory string `json:"defaultLimitsMemory,omitempty"`
+
+	// The CPU request of a container which doesn't set it.
+	DefaultRequestsCPU string `json:"defaultRequestsCpu,omitempty"`
+
+	// The memory request of a container which doesn't set it.
+	// Pattern: ^[0-9]+(E|P|T|G|M|K|Ei|Pi|Ti|Gi|Mi|Ki)?$
+	DefaultRequestsMemory string `json:"defaultRequestsMemory,omitempty"`
+
+	// The CPU limit of a container cannot exceed this value.
+	MaxLimitsCPU string `json:"maxLimitsCpu,omitempty"`
+
+	// The memory limit of a container cannot exceed this value.
+	// Pattern: ^[0-9]+(E|P|T|G|M|K|Ei|Pi|Ti|Gi|Mi|Ki)?$
+	MaxLimitsMemory string `json:"maxLimitsMemory,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
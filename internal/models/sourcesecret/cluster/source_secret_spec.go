@@ -87,6 +87,9 @@ func (m *VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec) UnmarshalBi
 //   - UNSPECIFIED: Unspecified type for forward compatibility.
 //   - USERNAME_PASSWORD: Username Password type.
 //   - SSH: SSH type.
+//   - GITHUB_APP: GitHub App type.
+//   - BEARER_TOKEN: Bearer Token type.
+//   - TLS: TLS type.
 //
 // swagger:model vmware.tanzu.manage.v1alpha1.cluster.fluxcd.sourcesecret.SourceSecretType
 type VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType string
@@ -105,6 +108,15 @@ const (
 
 	// VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeSSH captures enum value "SSH".
 	VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeSSH VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType = "SSH"
+
+	// VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeGITHUBAPP captures enum value "GITHUB_APP".
+	VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeGITHUBAPP VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType = "GITHUB_APP"
+
+	// VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeBEARERTOKEN captures enum value "BEARER_TOKEN".
+	VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeBEARERTOKEN VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType = "BEARER_TOKEN"
+
+	// VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeTLS captures enum value "TLS".
+	VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeTLS VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType = "TLS"
 )
 
 // for schema.
@@ -112,7 +124,7 @@ var vmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeEnum []interface{}
 
 func init() {
 	var res []VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType
-	if err := json.Unmarshal([]byte(`["UNSPECIFIED","USERNAME_PASSWORD","SSH"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["UNSPECIFIED","USERNAME_PASSWORD","SSH","GITHUB_APP","BEARER_TOKEN","TLS"]`), &res); err != nil {
 		panic(err)
 	}
 
@@ -22,6 +22,9 @@ type VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretStatus struct {
 
 	// Status of the service secret.
 	Status *VmwareTanzuManageV1alpha1AccountCredentialStatus `json:"status,omitempty"`
+
+	// Generation value at the time this status was updated.
+	ObservedGeneration string `json:"observedGeneration,omitempty"`
 }
 
 // MarshalBinary interface implementation.
@@ -0,0 +1,42 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzukubernetesrelease
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseListData Response from listing Tanzu Kubernetes Releases.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetesrelease.ListTanzuKubernetesReleasesResponse.
+type VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseListData struct {
+
+	// List of Tanzu Kubernetes Releases.
+	TanzuKubernetesReleases []*VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesRelease `json:"tanzukubernetesreleases"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseListData) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseListData) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseListData
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzukubernetesrelease
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseSpec Spec of the Tanzu Kubernetes Release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetesrelease.Spec
+type VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseSpec struct {
+
+	// Kubernetes version of the release.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// Version of the release, used as the cluster version.
+	Version string `json:"version,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzukubernetesrelease
+
+import (
+	"github.com/go-openapi/swag"
+
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+// VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseStatus Status of the Tanzu Kubernetes Release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetesrelease.Status
+type VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseStatus struct {
+
+	// Whether the release is compatible with the management cluster.
+	Compatible bool `json:"compatible,omitempty"`
+
+	// Conditions of the resource.
+	Conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition `json:"conditions,omitempty"`
+
+	// Versions of the releases the release can be upgraded to.
+	UpdatesAvailable []string `json:"updatesAvailable"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzukubernetesrelease
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesRelease A Tanzu Kubernetes Release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetesrelease.TanzuKubernetesRelease
+type VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesRelease struct {
+
+	// Full name for the Tanzu Kubernetes Release.
+	FullName *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseFullName `json:"fullName,omitempty"`
+
+	// Metadata for the Tanzu Kubernetes Release object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the Tanzu Kubernetes Release.
+	Spec *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseSpec `json:"spec,omitempty"`
+
+	// Status of the Tanzu Kubernetes Release.
+	Status *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesRelease) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesRelease) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesRelease
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
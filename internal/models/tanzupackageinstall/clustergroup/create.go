@@ -0,0 +1,67 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzupackageinstallclustergroupmodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest Request to create a Package Install.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.tanzupackage.install.CreateInstallRequest
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest struct {
+
+	// Package Install to create.
+	Install *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall `json:"install,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse Response from creating a Package Install.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.tanzupackage.install.CreateInstallResponse
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse struct {
+
+	// Package Install created.
+	Install *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall `json:"install,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,37 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzupackageinstallclustergroupmodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse Response from getting a Package Install.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.tanzupackage.install.GetInstallResponse
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse struct {
+
+	// Package Install returned.
+	Install *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall `json:"install,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzupackageinstallclustergroupmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall Package Install created at cluster group level.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.tanzupackage.install.Install
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall struct {
+
+	// Full name for the Package Install.
+	FullName *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName `json:"fullName,omitempty"`
+
+	// Metadata for the Package Install object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the Package Install.
+	Spec *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec `json:"spec,omitempty"`
+
+	// Status for the Package Install.
+	Status *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzupackageinstallclustergroupmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	pkginstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall"
+)
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec Spec of the Package Install.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.tanzupackage.install.Spec
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec struct {
+
+	// Spec of the Package Install as defined at atomic level.
+	AtomicSpec *pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec `json:"atomicSpec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
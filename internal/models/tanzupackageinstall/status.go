@@ -29,6 +29,9 @@ type VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus struct {
 
 	// Resolved version of the Package Install.
 	ResolvedVersion string `json:"resolvedVersion,omitempty"`
+
+	// Generation value at the time this status was updated.
+	ObservedGeneration string `json:"observedGeneration,omitempty"`
 }
 
 // MarshalBinary interface implementation.
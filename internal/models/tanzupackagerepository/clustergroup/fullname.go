@@ -0,0 +1,46 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzupackagerepositoryclustergroupmodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName Full name of the Package Repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.tanzupackage.repository.FullName
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName struct {
+
+	// Name of Cluster Group.
+	ClusterGroupName string `json:"clusterGroupName,omitempty"`
+
+	// Name of the Package Repository.
+	Name string `json:"name,omitempty"`
+
+	// Name of Namespace.
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzupackagerepositoryclustergroupmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepository Package Repository created at cluster group level.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.namespace.tanzupackage.repository.Repository
+type VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepository struct {
+
+	// Full name for the Package Repository.
+	FullName *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName `json:"fullName,omitempty"`
+
+	// Metadata for the Package Repository object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the Package Repository.
+	Spec *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositorySpec `json:"spec,omitempty"`
+
+	// Status for the Package Repository.
+	Status *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepository) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepository) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepository
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
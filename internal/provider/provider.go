@@ -14,10 +14,13 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster/nodepools"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/clusterclass"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/clustergroup"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/credential"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/customiamrole"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/custompolicytemplate"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/dataprotection"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/effectiveiamaccess"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/effectivepolicies"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/ekscluster"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/gitrepository"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmcharts"
@@ -26,13 +29,17 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrepository"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/iampolicy"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/inspections"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kubeconfig"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kubernetessecret"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kubernetesversions"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kustomization"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/managementcluster"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/namespace"
 	tanzupackage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/package"
 	tanzupackages "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packages"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packageversions"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/permissiontemplate"
+	policyexemption "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/exemption"
 	custompolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
 	custompolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom/resource"
 	imagepolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
@@ -45,6 +52,7 @@ import (
 	quotapolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota/resource"
 	securitypolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
 	securitypolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security/resource"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policyinsights"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/provisioner"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/sourcesecret"
 	utkgresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzukubernetescluster"
@@ -59,37 +67,41 @@ func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: authctx.ProviderAuthSchema(),
 		ResourcesMap: map[string]*schema.Resource{
-			cluster.ResourceName:              cluster.ResourceTMCCluster(),
-			ekscluster.ResourceName:           ekscluster.ResourceTMCEKSCluster(),
-			akscluster.ResourceName:           akscluster.ResourceTMCAKSCluster(),
-			workspace.ResourceName:            workspace.ResourceWorkspace(),
-			namespace.ResourceName:            namespace.ResourceNamespace(),
-			clustergroup.ResourceName:         clustergroup.ResourceClusterGroup(),
-			nodepools.ResourceName:            nodepools.ResourceNodePool(),
-			iampolicy.ResourceName:            iampolicy.ResourceIAMPolicy(),
-			custompolicy.ResourceName:         custompolicyresource.ResourceCustomPolicy(),
-			securitypolicy.ResourceName:       securitypolicyresource.ResourceSecurityPolicy(),
-			imagepolicy.ResourceName:          imagepolicyresource.ResourceImagePolicy(),
-			quotapolicy.ResourceName:          quotapolicyresource.ResourceQuotaPolicy(),
-			networkpolicy.ResourceName:        networkpolicyresource.ResourceNetworkPolicy(),
-			credential.ResourceName:           credential.ResourceCredential(),
-			gitrepository.ResourceName:        gitrepository.ResourceGitRepository(),
-			kustomization.ResourceName:        kustomization.ResourceKustomization(),
-			sourcesecret.ResourceName:         sourcesecret.ResourceSourceSecret(),
-			packagerepository.ResourceName:    packagerepository.ResourcePackageRepository(),
-			tanzupackageinstall.ResourceName:  tanzupackageinstall.ResourcePackageInstall(),
-			kubernetessecret.ResourceName:     kubernetessecret.ResourceSecret(),
-			mutationpolicy.ResourceName:       mutationpolicyresource.ResourceMutationPolicy(),
-			helmrelease.ResourceName:          helmrelease.ResourceHelmRelease(),
-			helmfeature.ResourceName:          helmfeature.ResourceHelm(),
-			backupschedule.ResourceName:       backupschedule.ResourceBackupSchedule(),
-			dataprotection.ResourceName:       dataprotection.ResourceEnableDataProtection(),
-			targetlocation.ResourceName:       targetlocation.ResourceTargetLocation(),
-			managementcluster.ResourceName:    managementcluster.ResourceManagementClusterRegistration(),
-			utkgresource.ResourceName:         utkgresource.ResourceTanzuKubernetesCluster(),
-			provisioner.ResourceName:          provisioner.ResourceProvisioner(),
-			custompolicytemplate.ResourceName: custompolicytemplate.ResourceCustomPolicyTemplate(),
-			customiamrole.ResourceName:        customiamrole.ResourceCustomIAMRole(),
+			cluster.ResourceName:               cluster.ResourceTMCCluster(),
+			ekscluster.ResourceName:            ekscluster.ResourceTMCEKSCluster(),
+			akscluster.ResourceName:            akscluster.ResourceTMCAKSCluster(),
+			workspace.ResourceName:             workspace.ResourceWorkspace(),
+			namespace.ResourceName:             namespace.ResourceNamespace(),
+			namespace.ClusterGroupResourceName: namespace.ResourceClusterGroupNamespace(),
+			clustergroup.ResourceName:          clustergroup.ResourceClusterGroup(),
+			nodepools.ResourceName:             nodepools.ResourceNodePool(),
+			iampolicy.ResourceName:             iampolicy.ResourceIAMPolicy(),
+			custompolicy.ResourceName:          custompolicyresource.ResourceCustomPolicy(),
+			securitypolicy.ResourceName:        securitypolicyresource.ResourceSecurityPolicy(),
+			imagepolicy.ResourceName:           imagepolicyresource.ResourceImagePolicy(),
+			quotapolicy.ResourceName:           quotapolicyresource.ResourceQuotaPolicy(),
+			networkpolicy.ResourceName:         networkpolicyresource.ResourceNetworkPolicy(),
+			credential.ResourceName:            credential.ResourceCredential(),
+			gitrepository.ResourceName:         gitrepository.ResourceGitRepository(),
+			kustomization.ResourceName:         kustomization.ResourceKustomization(),
+			sourcesecret.ResourceName:          sourcesecret.ResourceSourceSecret(),
+			packagerepository.ResourceName:     packagerepository.ResourcePackageRepository(),
+			tanzupackageinstall.ResourceName:   tanzupackageinstall.ResourcePackageInstall(),
+			kubernetessecret.ResourceName:      kubernetessecret.ResourceSecret(),
+			mutationpolicy.ResourceName:        mutationpolicyresource.ResourceMutationPolicy(),
+			helmrelease.ResourceName:           helmrelease.ResourceHelmRelease(),
+			helmfeature.ResourceName:           helmfeature.ResourceHelm(),
+			backupschedule.ResourceName:        backupschedule.ResourceBackupSchedule(),
+			dataprotection.ResourceName:        dataprotection.ResourceEnableDataProtection(),
+			targetlocation.ResourceName:        targetlocation.ResourceTargetLocation(),
+			managementcluster.ResourceName:     managementcluster.ResourceManagementClusterRegistration(),
+			utkgresource.ResourceName:          utkgresource.ResourceTanzuKubernetesCluster(),
+			provisioner.ResourceName:           provisioner.ResourceProvisioner(),
+			custompolicytemplate.ResourceName:  custompolicytemplate.ResourceCustomPolicyTemplate(),
+			customiamrole.ResourceName:         customiamrole.ResourceCustomIAMRole(),
+			continuousdelivery.ResourceName:    continuousdelivery.ResourceContinuousDelivery(),
+			helmrepository.ResourceName:        helmrepository.ResourceHelmRepository(),
+			policyexemption.ResourceName:       policyexemption.ResourcePolicyExemption(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			utkgresource.ResourceName:                 utkgresource.DataSourceTanzuKubernetesCluster(),
@@ -106,10 +118,12 @@ func Provider() *schema.Provider {
 			packagerepository.ResourceName:            packagerepository.DataSourcePackageRepository(),
 			tanzupackage.ResourceName:                 tanzupackage.DataSourceTanzuPackage(),
 			tanzupackages.ResourceName:                tanzupackages.DataSourceTanzuPackages(),
+			packageversions.ResourceName:              packageversions.DataSourcePackageVersions(),
 			tanzupackageinstall.ResourceName:          tanzupackageinstall.DataSourcePackageInstall(),
 			kubernetessecret.ResourceName:             kubernetessecret.DataSourceSecret(),
 			helmfeature.ResourceName:                  helmfeature.DataSourceHelm(),
 			helmcharts.ResourceName:                   helmcharts.DataSourceHelmCharts(),
+			helmcharts.SearchResourceName:             helmcharts.DataSourceHelmChartSearch(),
 			helmrepository.ResourceName:               helmrepository.DataSourceHelmRepository(),
 			backupschedule.ResourceName:               backupschedule.DataSourceBackupSchedule(),
 			targetlocation.ResourceName:               targetlocation.DataSourceTargetLocations(),
@@ -118,7 +132,13 @@ func Provider() *schema.Provider {
 			provisioner.ResourceName:                  provisioner.DataSourceProvisioner(),
 			inspections.ResourceNameInspections:       inspections.DataSourceInspections(),
 			inspections.ResourceNameInspectionResults: inspections.DataSourceInspectionResults(),
+			policyinsights.ResourceName:               policyinsights.DataSourcePolicyInsights(),
+			custompolicytemplate.TestResourceName:     custompolicytemplate.DataSourceCustomPolicyTemplateTest(),
+			effectiveiamaccess.ResourceName:           effectiveiamaccess.DataSourceEffectiveIAMAccess(),
+			effectivepolicies.ResourceName:            effectivepolicies.DataSourceEffectivePolicies(),
 			permissiontemplate.ResourceName:           permissiontemplate.DataSourcePermissionTemplate(),
+			kubeconfig.ResourceName:                   kubeconfig.DataSourceKubeconfig(),
+			kubernetesversions.ResourceName:           kubernetesversions.DataSourceKubernetesVersions(),
 		},
 		ConfigureContextFunc: authctx.ProviderConfigureContext,
 	}
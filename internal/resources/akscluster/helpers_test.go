@@ -591,6 +591,10 @@ func (m *mockClusterClient) AksClusterResourceServiceGetByID(id string) (*models
 	return &models.VmwareTanzuManageV1alpha1AksclusterGetAksClusterResponse{AksCluster: m.getClusterByIDResp}, m.getErr
 }
 
+func (m *mockClusterClient) AksClusterResourceServiceGetKubernetesVersions(_ *models.VmwareTanzuManageV1alpha1AksclusterFullName, _ string) (*models.VmwareTanzuManageV1alpha1AksclusterGetKubernetesVersionsResponse, error) {
+	return nil, m.getErr
+}
+
 func (m *mockClusterClient) AksClusterResourceServiceUpdate(ucr *models.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterRequest) (*models.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterResponse, error) {
 	m.AksUpdateClusterWasCalledWith = ucr.AksCluster
 
@@ -670,3 +674,7 @@ func (m *mockKubeConfigClient) KubeconfigServiceGet(fn *configModels.VmwareTanzu
 
 	return m.kubeConfigResponse, m.kubeConfigError
 }
+
+func (m *mockKubeConfigClient) KubeconfigServiceGetForCLI(fn *configModels.VmwareTanzuManageV1alpha1ClusterFullName, _ configModels.VmwareTanzuManageV1alpha1ClusterKubeconfigCliType) (*configModels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse, error) {
+	return m.KubeconfigServiceGet(fn)
+}
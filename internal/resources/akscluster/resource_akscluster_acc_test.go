@@ -605,6 +605,10 @@ func (m *mockClusterService) AksClusterResourceServiceGetByID(id string) (*aksmo
 	panic("not implemented")
 }
 
+func (m *mockClusterService) AksClusterResourceServiceGetKubernetesVersions(_ *aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName, _ string) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterGetKubernetesVersionsResponse, error) {
+	panic("not implemented")
+}
+
 func (m *mockClusterService) AksClusterResourceServiceUpdate(request *aksmodel.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterRequest) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterResponse, error) {
 	resp := m.updateResponse[m.updateCall]
 	m.updateCall += 1
@@ -32,4 +32,10 @@ const (
 	workerNodeCountKey             = "worker_node_count"
 	classKey                       = "class"
 	storageClassKey                = "storage_class"
+	detachPolicyKey                = "detach_policy"
+	detachTimeoutKey               = "detach_timeout"
+	detachPolicyGraceful           = "graceful"
+	detachPolicyForce              = "force"
+	detachPolicyGracefulThenForce  = "graceful_then_force"
+	defaultDetachTimeout           = "5m"
 )
@@ -27,10 +27,25 @@ func DataSourceTMCCluster() *schema.Resource {
 		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			return dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.DataRead), d, m)
 		},
-		Schema: clusterSchema,
+		Schema: getDataSourceSchema(),
 	}
 }
 
+// getDataSourceSchema creates a data source version of the resource schema without the delete only fields.
+func getDataSourceSchema() map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(clusterSchema))
+
+	for k, v := range clusterSchema {
+		if k == detachPolicyKey || k == detachTimeoutKey {
+			continue
+		}
+
+		ds[k] = v
+	}
+
+	return ds
+}
+
 func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(authctx.TanzuContext)
 
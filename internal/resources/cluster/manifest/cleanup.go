@@ -0,0 +1,78 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AgentNamespace is the namespace the TMC agents are installed in.
+	AgentNamespace = "vmware-system-tmc"
+	// AgentCRDGroupSuffix is the API group suffix of the CRDs installed by the TMC agents.
+	AgentCRDGroupSuffix = "tmc.cloud.vmware.com"
+)
+
+var (
+	namespaceGVK = runtimeSchema.GroupVersionKind{Version: "v1", Kind: "Namespace"}
+	crdListGVK   = runtimeSchema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinitionList"}
+)
+
+// CleanupAgent removes the TMC agent namespace and the CRDs installed by the TMC agents from the kubernetes cluster.
+// It returns the list of objects which were deleted.
+func CleanupAgent(k8sclient *k8sClient.Client) (deleted []string, err error) {
+	if k8sclient == nil {
+		return nil, errors.New("kubernetes client cannot be empty")
+	}
+
+	namespace := &unstructured.Unstructured{}
+	namespace.SetGroupVersionKind(namespaceGVK)
+
+	err = (*k8sclient).Get(context.Background(), types.NamespacedName{Name: AgentNamespace}, namespace)
+
+	switch {
+	case err == nil:
+		if err = ensureObjectDeleted(k8sclient, namespace); err != nil {
+			return deleted, errors.WithMessagef(err, "failed to delete namespace %s", AgentNamespace)
+		}
+
+		deleted = append(deleted, fmt.Sprintf("namespace/%s", AgentNamespace))
+	case !k8serrors.IsNotFound(err):
+		return deleted, errors.WithMessagef(err, "failed to get namespace %s", AgentNamespace)
+	}
+
+	crds := &unstructured.UnstructuredList{}
+	crds.SetGroupVersionKind(crdListGVK)
+
+	if err = (*k8sclient).List(context.Background(), crds); err != nil {
+		return deleted, errors.WithMessage(err, "failed to list custom resource definitions")
+	}
+
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		if !strings.HasSuffix(group, AgentCRDGroupSuffix) {
+			continue
+		}
+
+		if err = ensureObjectDeleted(k8sclient, crd); err != nil {
+			return deleted, errors.WithMessagef(err, "failed to delete custom resource definition %s", crd.GetName())
+		}
+
+		deleted = append(deleted, fmt.Sprintf("customresourcedefinition/%s", crd.GetName()))
+	}
+
+	return deleted, nil
+}
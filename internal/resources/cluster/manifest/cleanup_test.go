@@ -0,0 +1,83 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testCRD(name, group string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: group},
+	}
+}
+
+func testNamespace(name string) *unstructured.Unstructured {
+	namespace := &unstructured.Unstructured{}
+	namespace.SetGroupVersionKind(namespaceGVK)
+	namespace.SetName(name)
+
+	return namespace
+}
+
+func TestCleanupAgent(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		objects     []runtime.Object
+		expected    []string
+	}{
+		{
+			description: "agent namespace and CRDs present",
+			objects: []runtime.Object{
+				testNamespace(AgentNamespace),
+				testCRD("agents.clusters.tmc.cloud.vmware.com", "clusters.tmc.cloud.vmware.com"),
+				testCRD("certificates.cert-manager.io", "cert-manager.io"),
+			},
+			expected: []string{
+				"namespace/" + AgentNamespace,
+				"customresourcedefinition/agents.clusters.tmc.cloud.vmware.com",
+			},
+		},
+		{
+			description: "agent already removed",
+			objects: []runtime.Object{
+				testCRD("certificates.cert-manager.io", "cert-manager.io"),
+			},
+			expected: nil,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			clientScheme := runtime.NewScheme()
+			require.NoError(t, scheme.AddToScheme(clientScheme))
+			require.NoError(t, apiextensionsv1.AddToScheme(clientScheme))
+
+			client := fake.NewFakeClientWithScheme(clientScheme, test.objects...)
+
+			deleted, err := CleanupAgent(&client)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, deleted)
+		})
+	}
+
+	_, err := CleanupAgent((*k8sClient.Client)(nil))
+	require.Error(t, err)
+}
@@ -8,9 +8,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -85,8 +87,34 @@ var clusterSchema = map[string]*schema.Schema{
 		Default:     "default",
 		Optional:    true,
 	},
+	detachPolicyKey: {
+		Type: schema.TypeString,
+		Description: fmt.Sprintf("Policy used to detach the cluster on delete. '%s' waits for the TMC agents to uninstall themselves, '%s' detaches the cluster immediately "+
+			"and '%s' falls back to a force detach when the graceful detach does not complete within the detach timeout. "+
+			"On force detach the TMC agent namespace and CRDs are removed from the cluster when an attach kubeconfig is provided.\nValid values are: %v",
+			detachPolicyGraceful, detachPolicyForce, detachPolicyGracefulThenForce, detachPolicies),
+		Default:      detachPolicyGracefulThenForce,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(detachPolicies, false),
+	},
+	detachTimeoutKey: {
+		Type:        schema.TypeString,
+		Description: "Timeout duration to wait for a graceful detach of the cluster. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero.",
+		Default:     defaultDetachTimeout,
+		Optional:    true,
+		ValidateDiagFunc: func(i interface{}, _ cty.Path) diag.Diagnostics {
+			timeout, err := time.ParseDuration(i.(string))
+			if err != nil || timeout <= 0 {
+				return diag.Errorf("invalid %s value '%s', please refer to 'https://pkg.go.dev/time#ParseDuration' for providing the right value", detachTimeoutKey, i)
+			}
+
+			return nil
+		},
+	},
 }
 
+var detachPolicies = []string{detachPolicyGraceful, detachPolicyForce, detachPolicyGracefulThenForce}
+
 func constructFullname(d *schema.ResourceData) (fullname *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) {
 	fullname = &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{}
 
@@ -307,10 +335,9 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 	config := m.(authctx.TanzuContext)
 
 	var (
-		k8sclient  *k8sClient.Client
-		err        error
-		kubeConfig interface{}
-		manifests  string
+		k8sclient *k8sClient.Client
+		err       error
+		manifests string
 	)
 
 	if v, ok := d.GetOk(attachClusterKey); ok {
@@ -323,35 +350,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 			return diag.FromErr(err)
 		}
 
-		isKubeConfigPresent := func(typeKey string) bool {
-			if value, ok := d.GetOk(helper.GetFirstElementOf(attachClusterKey, typeKey)); ok {
-				if value != nil {
-					kubeConfig = value
-					return true
-				}
-			}
-
-			return false
-		}
-
-		switch {
-		case isKubeConfigPresent(attachClusterKubeConfigPathKey):
-			kubeConfigFile, _ := kubeConfig.(string)
-			if strings.TrimSpace(kubeConfigFile) == "" {
-				return diag.FromErr(fmt.Errorf("expected kubeconfig file path to not be an empty string or whitespace"))
-			}
-
-			k8sclient, err = getK8sClient(withPath(kubeConfigFile))
-
-		case isKubeConfigPresent(attachClusterKubeConfigRawKey):
-			rawKubeConfig, _ := kubeConfig.(string)
-			if strings.TrimSpace(rawKubeConfig) == "" {
-				return diag.FromErr(fmt.Errorf("expected raw kubeconfig to not be an empty string or whitespace"))
-			}
-
-			k8sclient, err = getK8sClient(withRaw(rawKubeConfig))
-		}
-
+		k8sclient, err = getAttachClusterK8sClient(d)
 		if err != nil {
 			log.Println("[ERROR] error while creating kubernetes client: ", err.Error())
 			return diag.FromErr(err)
@@ -437,6 +436,41 @@ func withRaw(r string) kubeConfigOption {
 	}
 }
 
+// getAttachClusterK8sClient builds a kubernetes client from the kubeconfig provided in the attach cluster block.
+func getAttachClusterK8sClient(d *schema.ResourceData) (*k8sClient.Client, error) {
+	var kubeConfig interface{}
+
+	isKubeConfigPresent := func(typeKey string) bool {
+		if value, ok := d.GetOk(helper.GetFirstElementOf(attachClusterKey, typeKey)); ok {
+			if value != nil {
+				kubeConfig = value
+				return true
+			}
+		}
+
+		return false
+	}
+
+	switch {
+	case isKubeConfigPresent(attachClusterKubeConfigPathKey):
+		kubeConfigFile, _ := kubeConfig.(string)
+		if strings.TrimSpace(kubeConfigFile) == "" {
+			return nil, fmt.Errorf("expected kubeconfig file path to not be an empty string or whitespace")
+		}
+
+		return getK8sClient(withPath(kubeConfigFile))
+	case isKubeConfigPresent(attachClusterKubeConfigRawKey):
+		rawKubeConfig, _ := kubeConfig.(string)
+		if strings.TrimSpace(rawKubeConfig) == "" {
+			return nil, fmt.Errorf("expected raw kubeconfig to not be an empty string or whitespace")
+		}
+
+		return getK8sClient(withRaw(rawKubeConfig))
+	}
+
+	return nil, nil
+}
+
 func getK8sClient(opts ...kubeConfigOption) (*k8sClient.Client, error) {
 	cfg := &kubeConfig{}
 
@@ -482,17 +516,26 @@ func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interfac
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceDelete(constructFullname(d), "false")
+	detachPolicy, _ := d.Get(detachPolicyKey).(string)
+	if detachPolicy == "" {
+		detachPolicy = detachPolicyGracefulThenForce
+	}
+
+	detachTimeout, err := time.ParseDuration(d.Get(detachTimeoutKey).(string))
+	if err != nil || detachTimeout <= 0 {
+		detachTimeout, _ = time.ParseDuration(defaultDetachTimeout)
+	}
+
+	fn := constructFullname(d)
+	forceDetach := detachPolicy == detachPolicyForce
+
+	err = config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceDelete(fn, strconv.FormatBool(forceDetach))
 	if err != nil && !clienterrors.IsNotFoundError(err) {
 		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
 	}
 
-	// d.SetId("") is automatically called assuming delete returns no errors, but
-	// it is added here for explicitness.
-	_ = schema.RemoveFromState(d, m)
-
 	getClusterResourceRetryableFn := func() (retry bool, err error) {
-		_, err = config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(constructFullname(d))
+		_, err = config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(fn)
 		if err == nil {
 			return true, errors.New("cluster deletion in progress")
 		}
@@ -504,28 +547,82 @@ func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interfac
 		return false, nil
 	}
 
-	_, err = helper.Retry(getClusterResourceRetryableFn, 10*time.Second, 25)
-	if err == nil {
-		return diags
+	_, err = helper.RetryUntilTimeout(getClusterResourceRetryableFn, 10*time.Second, detachTimeout)
+
+	switch {
+	case err == nil:
+	case detachPolicy == detachPolicyGraceful:
+		return diag.FromErr(errors.Wrapf(err, "Graceful detach of %s cluster did not complete within %s, "+
+			"set %s to '%s' or '%s' to force detach the cluster", d.Get(NameKey), detachTimeout, detachPolicyKey, detachPolicyForce, detachPolicyGracefulThenForce))
+	case detachPolicy == detachPolicyGracefulThenForce:
+		// if the cluster is still not removed then invoke force delete of the cluster.
+		log.Printf("[INFO] Cluster deletion in progress. Initiating force detach of the cluster entry as k8s cluster might not be responsive %s", fn.ToString())
+
+		forceDetach = true
+
+		err = config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceDelete(fn, "true")
+		if err != nil && !clienterrors.IsNotFoundError(err) {
+			return diag.FromErr(errors.Wrapf(err, "Unable to force detach Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
+		}
+
+		_, err = helper.RetryUntilTimeout(getClusterResourceRetryableFn, 10*time.Second, detachTimeout)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "verify %s cluster resource clean up", d.Get(NameKey)))
+		}
+	default:
+		return diag.FromErr(errors.Wrapf(err, "verify %s cluster resource clean up", d.Get(NameKey)))
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	_ = schema.RemoveFromState(d, m)
+
+	if forceDetach {
+		diags = append(diags, cleanupAgent(d)...)
 	}
 
-	// if the cluster is still not removed then invoke force delete of the cluster.
-	_, err = config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(constructFullname(d))
-	if err == nil {
-		_ = config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceDelete(constructFullname(d), "true")
+	return diags
+}
+
+// cleanupAgent removes the TMC agents left behind by a force detach, when the attach cluster kubeconfig is available.
+func cleanupAgent(d *schema.ResourceData) diag.Diagnostics {
+	manualCleanupDetail := fmt.Sprintf("Please remove the TMC agents and the %s namespace manually following "+
+		"https://techdocs.broadcom.com/us/en/vmware-tanzu/standalone-components/tanzu-mission-control/1-4/tanzu-mission-control-documentation/tanzumc-using-GUID-3061A796-CA3D-4354-A0B7-19F50F2617CE.html",
+		manifest.AgentNamespace)
 
-		log.Printf("[INFO] Cluster deletion in progress. Initiating force detach of the cluster entry as k8s cluster might not be responsive %s", constructFullname(d).ToString())
+	k8sclient, err := getAttachClusterK8sClient(d)
 
-		diags = diag.FromErr(errors.Wrapf(err, "Initiating force detach for %s cluster."+
-			"Ideally clean up of tmc agents and vmware-system-tmc namespace should have happened if not please remove them manually following "+
-			"https://techdocs.broadcom.com/us/en/vmware-tanzu/standalone-components/tanzu-mission-control/1-4/tanzu-mission-control-documentation/tanzumc-using-GUID-3061A796-CA3D-4354-A0B7-19F50F2617CE.html", d.Get(NameKey)))
+	switch {
+	case err != nil:
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Force detached %s cluster, unable to create kubernetes client to clean up TMC agents: %s", d.Get(NameKey), err.Error()),
+			Detail:   manualCleanupDetail,
+		}}
+	case k8sclient == nil:
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Force detached %s cluster, kubeconfig not provided to clean up TMC agents", d.Get(NameKey)),
+			Detail:   manualCleanupDetail,
+		}}
 	}
 
+	deleted, err := manifest.CleanupAgent(k8sclient)
 	if err != nil {
-		diags = diag.FromErr(errors.Wrapf(err, "verify %s cluster resource clean up", d.Get(NameKey)))
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Force detached %s cluster, TMC agents clean up failed: %s", d.Get(NameKey), err.Error()),
+			Detail:   fmt.Sprintf("Deleted objects: %s\n%s", strings.Join(deleted, ", "), manualCleanupDetail),
+		}}
 	}
 
-	return diags
+	log.Printf("[INFO] TMC agents cleaned up from the cluster(%s): %s", constructFullname(d).ToString(), strings.Join(deleted, ", "))
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Force detached %s cluster and cleaned up TMC agents", d.Get(NameKey)),
+		Detail:   fmt.Sprintf("Deleted objects: %s", strings.Join(deleted, ", ")),
+	}}
 }
 
 func withTKGmVsphereVersionUpdate(d *schema.ResourceData, cluster *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster) bool {
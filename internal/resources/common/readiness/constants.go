@@ -0,0 +1,19 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package readiness
+
+import "time"
+
+const (
+	WaitForReadyKey  = "wait_for_ready"
+	TimeoutKey       = "timeout"
+	ReadyFractionKey = "cluster_group_ready_fraction"
+
+	conditionReady = "Ready"
+
+	defaultTimeout       = 10 * time.Minute
+	defaultReadyFraction = 1.0
+	pollInterval         = 10 * time.Second
+)
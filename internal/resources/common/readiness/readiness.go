@@ -0,0 +1,298 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package readiness
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+// Config is the wait for ready configuration of a resource.
+type Config struct {
+	Timeout       time.Duration
+	ReadyFraction float64
+}
+
+// State is the readiness of a resource observed on the target.
+type State struct {
+	Ready   bool
+	Failed  bool
+	Message string
+}
+
+// StateFunc gets the current readiness of a resource.
+type StateFunc func() (*State, error)
+
+// ClusterStatus is the status of a cluster scoped resource reconciled on the cluster.
+type ClusterStatus struct {
+	Meta               *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	ObservedGeneration string
+	State              *State
+}
+
+// ClusterStatusFunc gets the current status of a cluster scoped resource, nil is returned while no status is reported.
+type ClusterStatusFunc func() (*ClusterStatus, error)
+
+// BatchStatus is the status of a cluster group scoped resource rolled out to the member clusters in batches.
+type BatchStatus struct {
+	Meta               *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	ObservedGeneration string
+	Phase              *statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhase
+	Details            *statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails
+}
+
+// BatchStatusFunc gets the current status of a cluster group scoped resource, nil is returned while no status is reported.
+type BatchStatusFunc func() (*BatchStatus, error)
+
+// StatusFuncs are the callbacks of a resource getting its status in each scope.
+type StatusFuncs struct {
+	Cluster      ClusterStatusFunc
+	ClusterGroup BatchStatusFunc
+}
+
+// ConstructConfig returns the wait for ready configuration of a resource, nil is returned when the resource doesn't wait.
+func ConstructConfig(d *schema.ResourceData) (*Config, error) {
+	value, ok := d.Get(WaitForReadyKey).([]interface{})
+	if !ok || len(value) == 0 {
+		return nil, nil
+	}
+
+	config := &Config{
+		Timeout:       defaultTimeout,
+		ReadyFraction: defaultReadyFraction,
+	}
+
+	data, _ := value[0].(map[string]interface{})
+
+	if timeout, ok := data[TimeoutKey].(string); ok && timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s.%s: %s", WaitForReadyKey, TimeoutKey, timeout)
+		}
+
+		config.Timeout = duration
+	}
+
+	if readyFraction, ok := data[ReadyFractionKey].(float64); ok {
+		config.ReadyFraction = readyFraction
+	}
+
+	return config, nil
+}
+
+// WaitForReady waits until a resource is ready when wait_for_ready is set.
+// The readiness of a cluster scoped resource is returned by its callback, the readiness of a cluster group scoped resource
+// is derived from its batch status. In both scopes the status must reflect the latest generation of the resource.
+func WaitForReady(d *schema.ResourceData, resourceScope commonscope.Scope, resourceName string, statusFuncs *StatusFuncs) error {
+	config, err := ConstructConfig(d)
+	if err != nil || config == nil {
+		return err
+	}
+
+	var getState StateFunc
+
+	switch resourceScope {
+	case commonscope.ClusterScope:
+		getState = func() (*State, error) {
+			status, err := statusFuncs.Cluster()
+			if err != nil {
+				return nil, err
+			}
+
+			return status.state(), nil
+		}
+	case commonscope.ClusterGroupScope:
+		getState = func() (*State, error) {
+			status, err := statusFuncs.ClusterGroup()
+			if err != nil {
+				return nil, err
+			}
+
+			return status.state(config.ReadyFraction), nil
+		}
+	default:
+		return nil
+	}
+
+	return Wait(config, resourceName, getState)
+}
+
+// Wait polls the readiness of a resource until it is ready.
+// An error is returned when the resource failed to reconcile or isn't ready within the timeout.
+func Wait(config *Config, resourceName string, getState StateFunc) error {
+	var state *State
+
+	getStateRetryableFn := func() (retry bool, err error) {
+		state, err = getState()
+		if err != nil {
+			return false, err
+		}
+
+		if state.Failed {
+			return false, errors.Errorf("%s failed to reconcile: %s", resourceName, state.Message)
+		}
+
+		return !state.Ready, nil
+	}
+
+	_, err := helper.RetryUntilTimeout(getStateRetryableFn, pollInterval, config.Timeout)
+	if err != nil {
+		return err
+	}
+
+	if state == nil || !state.Ready {
+		message := ""
+
+		if state != nil {
+			message = state.Message
+		}
+
+		return errors.Errorf("timed out after %s waiting for %s to be ready: %s", config.Timeout, resourceName, message)
+	}
+
+	return nil
+}
+
+// ConditionState returns the readiness of a cluster scoped resource from its Ready condition.
+// A Ready condition with the False status and an error severity, which is the default, means the resource failed to reconcile.
+func ConditionState(conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition) *State {
+	condition, ok := conditions[conditionReady]
+	if !ok {
+		return &State{Message: "waiting for the Ready condition"}
+	}
+
+	state := &State{Message: conditionMessage(&condition)}
+
+	if condition.Status == nil {
+		return state
+	}
+
+	switch *condition.Status {
+	case statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE:
+		state.Ready = true
+	case statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE:
+		state.Failed = condition.Severity == nil || *condition.Severity == statusmodel.VmwareTanzuCoreV1alpha1StatusConditionSeverityERROR
+	}
+
+	return state
+}
+
+// BatchState returns the readiness of a cluster group scoped resource from its batch phase and details.
+// The resource is ready once it is applied on the ready fraction of the member clusters,
+// and failed once too many member clusters are in error or overridden to reach it.
+func BatchState(phase *statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhase, details *statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails, readyFraction float64) *State {
+	if details == nil || details.AvailableTargets == 0 {
+		if phase == nil {
+			return &State{Message: "waiting for the phase"}
+		}
+
+		state := &State{Message: fmt.Sprintf("phase %s", *phase)}
+
+		switch *phase {
+		case statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED:
+			state.Ready = true
+		case statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseERROR:
+			state.Failed = true
+		}
+
+		return state
+	}
+
+	required := int32(math.Ceil(readyFraction * float64(details.AvailableTargets)))
+	state := &State{
+		Message: fmt.Sprintf("applied on %d, pending on %d, in error on %d and overridden on %d of %d member clusters, %d required",
+			details.Applied, details.Pending, details.Error, details.Overridden, details.AvailableTargets, required),
+	}
+
+	switch {
+	case details.Applied >= required:
+		state.Ready = true
+	case details.AvailableTargets-details.Error-details.Overridden < required:
+		state.Failed = true
+	}
+
+	return state
+}
+
+// GenerationState returns a pending state while the status of a resource doesn't reflect its latest generation, nil otherwise.
+// The status reflects the latest generation once its observed generation is at or above the generation in the meta.
+func GenerationState(meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, observedGeneration string) *State {
+	if meta == nil || meta.Generation == "" || isGenerationObserved(meta.Generation, observedGeneration) {
+		return nil
+	}
+
+	if observedGeneration == "" {
+		return &State{Message: fmt.Sprintf("waiting for generation %s to be observed", meta.Generation)}
+	}
+
+	return &State{Message: fmt.Sprintf("waiting for generation %s to be observed, observed generation %s", meta.Generation, observedGeneration)}
+}
+
+// state returns the readiness of a cluster scoped resource from its status.
+func (status *ClusterStatus) state() *State {
+	if status == nil || status.State == nil {
+		return ConditionState(nil)
+	}
+
+	if state := GenerationState(status.Meta, status.ObservedGeneration); state != nil {
+		return state
+	}
+
+	return status.State
+}
+
+// state returns the readiness of a cluster group scoped resource from its batch status.
+func (status *BatchStatus) state(readyFraction float64) *State {
+	if status == nil {
+		return BatchState(nil, nil, readyFraction)
+	}
+
+	if state := GenerationState(status.Meta, status.ObservedGeneration); state != nil {
+		return state
+	}
+
+	return BatchState(status.Phase, status.Details, readyFraction)
+}
+
+// isGenerationObserved returns whether the observed generation is at or above the generation,
+// generations which aren't numbers are compared as is.
+func isGenerationObserved(generation, observedGeneration string) bool {
+	if observedGeneration == "" {
+		return false
+	}
+
+	want, err := strconv.ParseInt(generation, 10, 64)
+	if err != nil {
+		return generation == observedGeneration
+	}
+
+	got, err := strconv.ParseInt(observedGeneration, 10, 64)
+	if err != nil {
+		return generation == observedGeneration
+	}
+
+	return got >= want
+}
+
+func conditionMessage(condition *statusmodel.VmwareTanzuCoreV1alpha1StatusCondition) string {
+	switch {
+	case condition.Reason != "" && condition.Message != "":
+		return fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+	case condition.Message != "":
+		return condition.Message
+	default:
+		return condition.Reason
+	}
+}
@@ -0,0 +1,201 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package readiness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+func TestConditionState(t *testing.T) {
+	cases := []struct {
+		name       string
+		conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition
+		expected   *State
+	}{
+		{
+			name:       "no ready condition",
+			conditions: nil,
+			expected:   &State{Message: "waiting for the Ready condition"},
+		},
+		{
+			name: "ready",
+			conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				conditionReady: {
+					Reason: "ReconciliationSucceeded",
+					Status: statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE.Pointer(),
+				},
+			},
+			expected: &State{Ready: true, Message: "ReconciliationSucceeded"},
+		},
+		{
+			name: "reconciling",
+			conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				conditionReady: {
+					Reason:  "Progressing",
+					Message: "reconciliation in progress",
+					Status:  statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusSTATUSUNSPECIFIED.Pointer(),
+				},
+			},
+			expected: &State{Message: "Progressing: reconciliation in progress"},
+		},
+		{
+			name: "failed",
+			conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				conditionReady: {
+					Reason:  "InstallFailed",
+					Message: "chart not found",
+					Status:  statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE.Pointer(),
+				},
+			},
+			expected: &State{Failed: true, Message: "InstallFailed: chart not found"},
+		},
+		{
+			name: "not ready with a warning",
+			conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				conditionReady: {
+					Message:  "dependency not ready",
+					Severity: statusmodel.VmwareTanzuCoreV1alpha1StatusConditionSeverityWARNING.Pointer(),
+					Status:   statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE.Pointer(),
+				},
+			},
+			expected: &State{Message: "dependency not ready"},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, ConditionState(test.conditions))
+		})
+	}
+}
+
+func TestBatchState(t *testing.T) {
+	cases := []struct {
+		name          string
+		phase         *statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhase
+		details       *statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails
+		readyFraction float64
+		ready         bool
+		failed        bool
+	}{
+		{
+			name:          "no phase",
+			readyFraction: 1,
+		},
+		{
+			name:          "applied without member clusters",
+			phase:         statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED.Pointer(),
+			readyFraction: 1,
+			ready:         true,
+		},
+		{
+			name:          "error without member clusters",
+			phase:         statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseERROR.Pointer(),
+			readyFraction: 1,
+			failed:        true,
+		},
+		{
+			name:          "pending on some member clusters",
+			phase:         statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhasePENDING.Pointer(),
+			details:       &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{AvailableTargets: 4, Applied: 3, Pending: 1},
+			readyFraction: 1,
+		},
+		{
+			name:          "applied on the ready fraction of the member clusters",
+			phase:         statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhasePENDING.Pointer(),
+			details:       &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{AvailableTargets: 4, Applied: 3, Pending: 1},
+			readyFraction: 0.75,
+			ready:         true,
+		},
+		{
+			name:          "ready fraction can't be reached",
+			phase:         statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseERROR.Pointer(),
+			details:       &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{AvailableTargets: 4, Applied: 2, Pending: 1, Error: 1},
+			readyFraction: 1,
+			failed:        true,
+		},
+		{
+			name:          "error below the ready fraction",
+			phase:         statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseERROR.Pointer(),
+			details:       &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{AvailableTargets: 4, Applied: 2, Pending: 1, Error: 1},
+			readyFraction: 0.5,
+			ready:         true,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			state := BatchState(test.phase, test.details, test.readyFraction)
+			require.Equal(t, test.ready, state.Ready)
+			require.Equal(t, test.failed, state.Failed)
+		})
+	}
+}
+
+func TestGenerationState(t *testing.T) {
+	require.Nil(t, GenerationState(nil, "1"))
+	require.Nil(t, GenerationState(&objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}, "1"))
+	require.Nil(t, GenerationState(&objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "2"}, "2"))
+	require.Nil(t, GenerationState(&objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "2"}, "3"))
+	require.Nil(t, GenerationState(&objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "10"}, "10"))
+	require.NotNil(t, GenerationState(&objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "10"}, "9"))
+	require.NotNil(t, GenerationState(&objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "2"}, ""))
+
+	state := GenerationState(&objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "2"}, "1")
+	require.NotNil(t, state)
+	require.False(t, state.Ready)
+	require.False(t, state.Failed)
+}
+
+func TestClusterStatusState(t *testing.T) {
+	ready := &State{Ready: true, Message: "ReconciliationSucceeded"}
+
+	var missing *ClusterStatus
+
+	require.False(t, missing.state().Ready)
+
+	stale := &ClusterStatus{Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "2"}, ObservedGeneration: "1", State: ready}
+	require.False(t, stale.state().Ready)
+
+	unobserved := &ClusterStatus{Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "2"}, State: ready}
+	require.False(t, unobserved.state().Ready)
+
+	observed := &ClusterStatus{Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "2"}, ObservedGeneration: "2", State: ready}
+	require.Equal(t, ready, observed.state())
+}
+
+func TestBatchStatusState(t *testing.T) {
+	applied := statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED.Pointer()
+
+	var missing *BatchStatus
+
+	require.False(t, missing.state(1).Ready)
+
+	stale := &BatchStatus{Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "2"}, ObservedGeneration: "1", Phase: applied}
+	require.False(t, stale.state(1).Ready)
+
+	observed := &BatchStatus{Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "2"}, ObservedGeneration: "2", Phase: applied}
+	require.True(t, observed.state(1).Ready)
+}
+
+func TestWait(t *testing.T) {
+	config := &Config{Timeout: time.Second, ReadyFraction: 1}
+
+	err := Wait(config, "test", func() (*State, error) {
+		return &State{Ready: true}, nil
+	})
+	require.NoError(t, err)
+
+	err = Wait(config, "test", func() (*State, error) {
+		return &State{Failed: true, Message: "InstallFailed: chart not found"}, nil
+	})
+	require.EqualError(t, err, "test failed to reconcile: InstallFailed: chart not found")
+}
@@ -0,0 +1,52 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package readiness
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var WaitForReadySchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "When specified, create and update wait until the resource is reconciled and ready on the target, and fail with the reconciliation message otherwise.",
+	Optional:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			TimeoutKey: {
+				Type:             schema.TypeString,
+				Description:      "Wait timeout duration until the resource is ready. Accepted timeout duration values like 5s, 5m, or 1h, higher than zero.",
+				Optional:         true,
+				Default:          defaultTimeout.String(),
+				ValidateDiagFunc: validateTimeout,
+			},
+			ReadyFractionKey: {
+				Type:         schema.TypeFloat,
+				Description:  "Fraction of the member clusters of the cluster group on which the resource has to be applied, between 0 and 1. Only used for the cluster group scope.",
+				Optional:     true,
+				Default:      defaultReadyFraction,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+		},
+	},
+}
+
+var validateTimeout = validation.ToDiagFunc(func(i interface{}, k string) (warnings []string, errs []error) {
+	value, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return nil, []error{fmt.Errorf("invalid %s: %q. Please refer to 'https://pkg.go.dev/time#ParseDuration' for providing the right value", k, value)}
+	}
+
+	return nil, nil
+})
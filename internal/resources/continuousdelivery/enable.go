@@ -0,0 +1,122 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package continuousdelivery
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
+	continuousdeliveryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/clustergroup"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery/scope"
+)
+
+// EnableIfMissing enables continuous delivery for the scope unless it is already enabled.
+// It reports whether the feature had to be enabled by this call, which means the configuration
+// does not manage the feature through the continuous delivery resource.
+func EnableIfMissing(config *authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) (bool, error) {
+	if config == nil || scopedFullnameData == nil {
+		return false, errors.New("missing variables: error while enabling Tanzu Mission Control continuous delivery feature")
+	}
+
+	continuousDeliveryDataFromServer, err := retrieveContinuousDeliveryDataFromServer(config, scopedFullnameData)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return false, err
+	}
+
+	if continuousDeliveryDataFromServer != nil {
+		return false, nil
+	}
+
+	err = createContinuousDelivery(config, scopedFullnameData, meta)
+	if err != nil {
+		if clienterrors.IsAlreadyExistsError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RequireEnabled returns an error unless continuous delivery is enabled for the scope.
+// Resources which don't enable continuous delivery on their own behalf use it to ask for a continuous delivery resource.
+func RequireEnabled(config *authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, resourceType string) error {
+	if config == nil || scopedFullnameData == nil {
+		return errors.New("missing variables: error while checking Tanzu Mission Control continuous delivery feature")
+	}
+
+	continuousDeliveryDataFromServer, err := retrieveContinuousDeliveryDataFromServer(config, scopedFullnameData)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return err
+	}
+
+	if continuousDeliveryDataFromServer == nil {
+		return errors.Errorf("continuous delivery is not enabled for the scope of this %s resource: "+
+			"declare a %s resource for the same scope and add it to the depends_on of this resource", resourceType, ResourceName)
+	}
+
+	return nil
+}
+
+// ImplicitEnablementWarning is the diagnostic returned by GitOps resources which had to enable continuous delivery themselves.
+func ImplicitEnablementWarning(resourceType string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Continuous delivery was enabled implicitly",
+		Detail: fmt.Sprintf("Continuous delivery was not enabled for the scope of this %s resource, so it has been enabled on its behalf. "+
+			"Declare a %s resource for the same scope and add it to the depends_on of this resource; implicit enablement is deprecated and will be removed in a future release.",
+			resourceType, ResourceName),
+	}
+}
+
+func createContinuousDelivery(config *authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) error {
+	if meta == nil {
+		meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			continuousDeliveryReq := &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryContinuousDeliveryRequest{
+				ContinuousDelivery: &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryContinuousDelivery{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     meta,
+				},
+			}
+
+			_, err := config.TMCConnection.ClusterContinuousDeliveryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryResourceServiceCreate(continuousDeliveryReq)
+			if err != nil {
+				return errors.Wrap(err, "Unable to enable Tanzu Mission Control cluster continuous delivery feature")
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			continuousDeliveryReq := &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryContinuousDeliveryRequest{
+				ContinuousDelivery: &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryContinuousDelivery{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     meta,
+				},
+			}
+
+			_, err := config.TMCConnection.ClusterGroupContinuousDeliveryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryResourceServiceCreate(continuousDeliveryReq)
+			if err != nil {
+				return errors.Wrap(err, "Unable to enable Tanzu Mission Control cluster group continuous delivery feature")
+			}
+		}
+	case commonscope.UnknownScope:
+		return errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	return nil
+}
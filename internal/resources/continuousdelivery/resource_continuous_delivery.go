@@ -0,0 +1,298 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package continuousdelivery
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
+	continuousdeliveryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery/status"
+)
+
+const (
+	ResourceName = "tanzu-mission-control_continuous_delivery"
+
+	statusKey           = "status"
+	disableOnDestroyKey = "disable_on_destroy"
+)
+
+func ResourceContinuousDelivery() *schema.Resource {
+	return &schema.Resource{
+		Schema:        continuousDeliverySchema,
+		CreateContext: resourceContinuousDeliveryCreate,
+		ReadContext:   resourceContinuousDeliveryRead,
+		UpdateContext: resourceContinuousDeliveryUpdate,
+		DeleteContext: resourceContinuousDeliveryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceContinuousDeliveryImporter,
+		},
+		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+	}
+}
+
+var continuousDeliverySchema = map[string]*schema.Schema{
+	commonscope.ScopeKey: scope.ScopeSchema,
+	disableOnDestroyKey: {
+		Type:        schema.TypeBool,
+		Description: "If true, continuous delivery is disabled on the cluster or cluster group when the resource is destroyed. Disabling the feature removes Flux from the cluster(s) along with the git repositories, kustomizations and source secrets managed by it. Defaults to false, in which case destroying the resource only removes it from the Terraform state.",
+		Optional:    true,
+		Default:     false,
+	},
+	statusKey: status.StatusSchema,
+}
+
+type dataFromServer struct {
+	UID                     string
+	clusterScopeStatus      *continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryStatus
+	clusterGroupScopeStatus *continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryStatus
+}
+
+func resourceContinuousDeliveryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	scopedFullnameData := scope.ConstructScope(d)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to enable Tanzu Mission Control continuous delivery feature; Scope full name is empty")
+	}
+
+	// The feature may already have been enabled through the console or implicitly by a GitOps resource,
+	// in which case the existing enablement is adopted.
+	if err := createContinuousDelivery(&config, scopedFullnameData, nil); err != nil && !clienterrors.IsAlreadyExistsError(err) {
+		return diag.FromErr(err)
+	}
+
+	diags = resourceContinuousDeliveryRead(ctx, d, m)
+
+	if !diags.HasError() && d.Id() == "" {
+		return diag.Errorf("Unable to enable Tanzu Mission Control continuous delivery feature; no continuous delivery entry found after creation")
+	}
+
+	return diags
+}
+
+func resourceContinuousDeliveryRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	scopedFullnameData := scope.ConstructScope(d)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to get Tanzu Mission Control continuous delivery entry; Scope full name is empty")
+	}
+
+	continuousDeliveryDataFromServer, err := retrieveContinuousDeliveryDataFromServer(&config, scopedFullnameData)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if continuousDeliveryDataFromServer == nil {
+		_ = schema.RemoveFromState(d, m)
+		return diags
+	}
+
+	// always run
+	d.SetId(continuousDeliveryDataFromServer.UID)
+
+	if err := d.Set(commonscope.ScopeKey, scope.FlattenScope(scopedFullnameData)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var flattenedStatus []interface{}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		flattenedStatus = status.FlattenStatusForClusterScope(continuousDeliveryDataFromServer.clusterScopeStatus)
+	case commonscope.ClusterGroupScope:
+		flattenedStatus = status.FlattenStatusForClusterGroupScope(continuousDeliveryDataFromServer.clusterGroupScopeStatus)
+	}
+
+	if err := d.Set(statusKey, flattenedStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceContinuousDeliveryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// Only disable_on_destroy can change in place and it is only consumed on destroy.
+	return resourceContinuousDeliveryRead(ctx, d, m)
+}
+
+func resourceContinuousDeliveryDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	if !d.Get(disableOnDestroyKey).(bool) {
+		d.SetId("")
+
+		return diags
+	}
+
+	scopedFullnameData := scope.ConstructScope(d)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to disable Tanzu Mission Control continuous delivery feature; Scope full name is empty")
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			err := config.TMCConnection.ClusterContinuousDeliveryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryResourceServiceDelete(scopedFullnameData.FullnameCluster)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrap(err, "Unable to disable Tanzu Mission Control cluster continuous delivery feature"))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			err := config.TMCConnection.ClusterGroupContinuousDeliveryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrap(err, "Unable to disable Tanzu Mission Control cluster group continuous delivery feature"))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+func resourceContinuousDeliveryImporter(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	config := m.(authctx.TanzuContext)
+	importID := d.Id()
+
+	if importID == "" {
+		return nil, errors.New("ID is needed to import a continuous delivery feature")
+	}
+
+	namesArray := strings.Split(importID, "/")
+	scopedFullnameData := &scope.ScopedFullname{}
+
+	switch len(namesArray) {
+	case 3:
+		scopedFullnameData.Scope = commonscope.ClusterScope
+		scopedFullnameData.FullnameCluster = &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName{
+			ManagementClusterName: namesArray[0],
+			ProvisionerName:       namesArray[1],
+			ClusterName:           namesArray[2],
+		}
+	case 1:
+		scopedFullnameData.Scope = commonscope.ClusterGroupScope
+		scopedFullnameData.FullnameClusterGroup = &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryFullName{
+			ClusterGroupName: namesArray[0],
+		}
+	default:
+		return nil, errors.Errorf("Invalid import ID %q, expected management_cluster_name/provisioner_name/cluster_name for a cluster or cluster_group_name for a cluster group", importID)
+	}
+
+	continuousDeliveryDataFromServer, err := retrieveContinuousDeliveryDataFromServer(&config, scopedFullnameData)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Couldn't import continuous delivery feature, ID: %s", importID)
+	}
+
+	if continuousDeliveryDataFromServer == nil {
+		return nil, errors.Errorf("Couldn't import continuous delivery feature, ID: %s; the feature is not enabled", importID)
+	}
+
+	d.SetId(continuousDeliveryDataFromServer.UID)
+
+	if err := d.Set(commonscope.ScopeKey, scope.FlattenScope(scopedFullnameData)); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set(disableOnDestroyKey, false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// retrieveContinuousDeliveryDataFromServer returns nil data when continuous delivery is not enabled for the scope.
+// The full name of the scope is updated from the server response.
+func retrieveContinuousDeliveryDataFromServer(config *authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname) (*dataFromServer, error) {
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			resp, err := config.TMCConnection.ClusterContinuousDeliveryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryResourceServiceList(
+				&continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryListContinuousDeliveriesRequestParameters{
+					SearchScope: &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliverySearchScope{
+						ClusterName:           scopedFullnameData.FullnameCluster.ClusterName,
+						ManagementClusterName: scopedFullnameData.FullnameCluster.ManagementClusterName,
+						ProvisionerName:       scopedFullnameData.FullnameCluster.ProvisionerName,
+					},
+				},
+			)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					return nil, err
+				}
+
+				return nil, errors.Wrap(err, "Unable to get Tanzu Mission Control cluster continuous delivery entry")
+			}
+
+			if len(resp.ContinuousDeliveries) == 0 {
+				return nil, nil
+			}
+
+			continuousDeliveryRes := resp.ContinuousDeliveries[0]
+			scopedFullnameData.FullnameCluster = continuousDeliveryRes.FullName
+
+			return &dataFromServer{
+				UID:                continuousDeliveryRes.Meta.UID,
+				clusterScopeStatus: continuousDeliveryRes.Status,
+			}, nil
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			resp, err := config.TMCConnection.ClusterGroupContinuousDeliveryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryResourceServiceList(
+				&continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryListContinuousDeliveriesRequestParameters{
+					SearchScope: &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliverySearchScope{
+						ClusterGroupName: scopedFullnameData.FullnameClusterGroup.ClusterGroupName,
+					},
+				},
+			)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					return nil, err
+				}
+
+				return nil, errors.Wrap(err, "Unable to get Tanzu Mission Control cluster group continuous delivery entry")
+			}
+
+			if len(resp.ContinuousDeliveries) == 0 {
+				return nil, nil
+			}
+
+			continuousDeliveryRes := resp.ContinuousDeliveries[0]
+			scopedFullnameData.FullnameClusterGroup = continuousDeliveryRes.FullName
+
+			return &dataFromServer{
+				UID:                     continuousDeliveryRes.Meta.UID,
+				clusterGroupScopeStatus: continuousDeliveryRes.Status,
+			}, nil
+		}
+	case commonscope.UnknownScope:
+		return nil, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	return nil, nil
+}
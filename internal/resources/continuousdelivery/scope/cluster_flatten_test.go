@@ -0,0 +1,53 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func TestFlattenClusterContinuousDeliveryFullname(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster continuous delivery full name",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster continuous delivery full name",
+			input: &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName{
+				ClusterName:           "c",
+				ManagementClusterName: "m",
+				ProvisionerName:       "p",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					commonscope.NameKey:                  "c",
+					commonscope.ManagementClusterNameKey: "m",
+					commonscope.ProvisionerNameKey:       "p",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenClusterContinuousDeliveryFullname(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
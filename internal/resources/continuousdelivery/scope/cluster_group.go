@@ -0,0 +1,39 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package scope
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	continuousdeliveryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func ConstructClusterGroupContinuousDeliveryFullname(data []interface{}) (fullname *continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryFullName) {
+	if len(data) == 0 || data[0] == nil {
+		return fullname
+	}
+
+	fullNameData, _ := data[0].(map[string]interface{})
+
+	fullname = &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryFullName{}
+
+	if nameValue, ok := fullNameData[commonscope.NameKey]; ok {
+		helper.SetPrimitiveValue(nameValue, &fullname.ClusterGroupName, commonscope.NameKey)
+	}
+
+	return fullname
+}
+
+func FlattenClusterGroupContinuousDeliveryFullname(fullname *continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryFullName) (data []interface{}) {
+	if fullname == nil {
+		return data
+	}
+
+	flattenFullname := make(map[string]interface{})
+
+	flattenFullname[commonscope.NameKey] = fullname.ClusterGroupName
+
+	return []interface{}{flattenFullname}
+}
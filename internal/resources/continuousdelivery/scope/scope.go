@@ -0,0 +1,90 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package scope
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+
+	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
+	continuousdeliveryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+// ScopedFullname is a struct for all types of continuous delivery full names.
+type ScopedFullname struct {
+	Scope                commonscope.Scope
+	FullnameCluster      *continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName
+	FullnameClusterGroup *continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryFullName
+}
+
+var (
+	ScopesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey}
+	ScopeSchema   = commonscope.GetScopeSchema(
+		commonscope.WithDescription(fmt.Sprintf("Scope for the continuous delivery feature, having one of the valid scopes: %v.", strings.Join(ScopesAllowed[:], `, `))),
+		commonscope.WithScopes(ScopesAllowed[:]))
+)
+
+func ConstructScope(d *schema.ResourceData) (scopedFullnameData *ScopedFullname) {
+	value, ok := d.GetOk(commonscope.ScopeKey)
+
+	if !ok {
+		return scopedFullnameData
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return scopedFullnameData
+	}
+
+	scopeData := data[0].(map[string]interface{})
+
+	if clusterData, ok := scopeData[commonscope.ClusterKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.ClusterKey) {
+		if clusterValue, ok := clusterData.([]interface{}); ok && len(clusterValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:           commonscope.ClusterScope,
+				FullnameCluster: ConstructClusterContinuousDeliveryFullname(clusterValue),
+			}
+		}
+	}
+
+	if clusterGroupData, ok := scopeData[commonscope.ClusterGroupKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+		if clusterGroupValue, ok := clusterGroupData.([]interface{}); ok && len(clusterGroupValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:                commonscope.ClusterGroupScope,
+				FullnameClusterGroup: ConstructClusterGroupContinuousDeliveryFullname(clusterGroupValue),
+			}
+		}
+	}
+
+	return scopedFullnameData
+}
+
+func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}) {
+	if scopedFullname == nil {
+		return data
+	}
+
+	flattenScopeData := make(map[string]interface{})
+
+	switch scopedFullname.Scope {
+	case commonscope.ClusterScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.ClusterKey) {
+			flattenScopeData[commonscope.ClusterKey] = FlattenClusterContinuousDeliveryFullname(scopedFullname.FullnameCluster)
+		}
+	case commonscope.ClusterGroupScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+			flattenScopeData[commonscope.ClusterGroupKey] = FlattenClusterGroupContinuousDeliveryFullname(scopedFullname.FullnameClusterGroup)
+		}
+	case commonscope.UnknownScope:
+		fmt.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(ScopesAllowed[:], `, `))
+	}
+
+	return []interface{}{flattenScopeData}
+}
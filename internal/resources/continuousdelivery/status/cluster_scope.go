@@ -0,0 +1,72 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package status
+
+import (
+	"sort"
+
+	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+func FlattenStatusForClusterScope(status *continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryStatus) (data []interface{}) {
+	if status == nil {
+		return data
+	}
+
+	if status.Conditions == nil {
+		return data
+	}
+
+	flattenStatusData := make(map[string]interface{})
+
+	if condition, ok := status.Conditions[conditionReady]; ok {
+		flattenStatusData[phaseKey] = condition.Reason
+	}
+
+	flattenStatusData[conditionsKey] = flattenConditions(status.Conditions)
+
+	return []interface{}{flattenStatusData}
+}
+
+func flattenConditions(conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition) (data []interface{}) {
+	if len(conditions) == 0 {
+		return data
+	}
+
+	conditionNames := make([]string, 0, len(conditions))
+
+	for name := range conditions {
+		conditionNames = append(conditionNames, name)
+	}
+
+	// Conditions are returned as a map, sort them to keep the state stable between reads.
+	sort.Strings(conditionNames)
+
+	for _, name := range conditionNames {
+		condition := conditions[name]
+		flattenCondition := make(map[string]interface{})
+
+		flattenCondition[typeKey] = name
+		flattenCondition[reasonKey] = condition.Reason
+		flattenCondition[messageKey] = condition.Message
+
+		if condition.Status != nil {
+			flattenCondition[statusKey] = string(*condition.Status)
+		}
+
+		if condition.Severity != nil {
+			flattenCondition[severityKey] = string(*condition.Severity)
+		}
+
+		if !condition.LastTransitionTime.IsZero() {
+			flattenCondition[lastTransitionTimeKey] = condition.LastTransitionTime.String()
+		}
+
+		data = append(data, flattenCondition)
+	}
+
+	return data
+}
@@ -0,0 +1,24 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package status
+
+const (
+	conditionReady = "Ready"
+
+	phaseKey              = "phase"
+	conditionsKey         = "conditions"
+	typeKey               = "type"
+	statusKey             = "status"
+	reasonKey             = "reason"
+	messageKey            = "message"
+	severityKey           = "severity"
+	lastTransitionTimeKey = "last_transition_time"
+	detailsKey            = "details"
+	availableTargetsKey   = "available_targets"
+	appliedKey            = "applied"
+	pendingKey            = "pending"
+	overriddenKey         = "overridden"
+	errorKey              = "error"
+)
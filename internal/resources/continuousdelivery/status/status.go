@@ -0,0 +1,101 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package status
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var StatusSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Status for the continuous delivery feature.",
+	Computed:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			phaseKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Reason of the Ready condition for cluster scope. For cluster group scope, phase of the feature enablement on member clusters.",
+			},
+			conditionsKey: conditionsStatus,
+			detailsKey:    detailsStatus,
+		},
+	},
+}
+
+var conditionsStatus = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Conditions reported for the continuous delivery feature on the cluster.",
+	Computed:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			typeKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the condition.",
+			},
+			statusKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the condition.",
+			},
+			reasonKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "One-word reason for the condition's last transition.",
+			},
+			messageKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Human readable message indicating details about the last transition.",
+			},
+			severityKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Severity of the condition.",
+			},
+			lastTransitionTimeKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last time the condition transitioned from one status to another.",
+			},
+		},
+	},
+}
+
+var detailsStatus = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Aggregated status of the feature across the member clusters of the cluster group.",
+	Computed:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			availableTargetsKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of member clusters the feature can be enabled on.",
+			},
+			appliedKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of member clusters the feature has been enabled on.",
+			},
+			pendingKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of member clusters the feature enablement is pending on.",
+			},
+			overriddenKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of member clusters where a cluster scoped setting overrides the cluster group one.",
+			},
+			errorKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of member clusters the feature failed to be enabled on.",
+			},
+		},
+	},
+}
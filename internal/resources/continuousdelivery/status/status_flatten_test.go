@@ -0,0 +1,127 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package status
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
+	continuousdeliveryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/clustergroup"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+func TestFlattenStatusForClusterScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryStatus
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster continuous delivery status",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster continuous delivery status",
+			input: &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryStatus{
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					conditionReady: {
+						Type:     conditionReady,
+						Status:   statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE.Pointer(),
+						Severity: statusmodel.VmwareTanzuCoreV1alpha1StatusConditionSeverityINFO.Pointer(),
+						Reason:   "Enabled",
+						Message:  "Feature is enabled on the cluster",
+					},
+					"Applied": {
+						Type:   "Applied",
+						Status: statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE.Pointer(),
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					phaseKey: "Enabled",
+					conditionsKey: []interface{}{
+						map[string]interface{}{
+							typeKey:    "Applied",
+							statusKey:  "TRUE",
+							reasonKey:  "",
+							messageKey: "",
+						},
+						map[string]interface{}{
+							typeKey:     conditionReady,
+							statusKey:   "TRUE",
+							severityKey: "INFO",
+							reasonKey:   "Enabled",
+							messageKey:  "Feature is enabled on the cluster",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenStatusForClusterScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenStatusForClusterGroupScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryStatus
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster group continuous delivery status",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster group continuous delivery status",
+			input: &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryStatus{
+				Phase: statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED.Pointer(),
+				Details: &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{
+					Applied:          2,
+					AvailableTargets: 3,
+					Pending:          1,
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					phaseKey: fmt.Sprint(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+					detailsKey: []interface{}{
+						map[string]interface{}{
+							availableTargetsKey: 3,
+							appliedKey:          2,
+							pendingKey:          1,
+							overriddenKey:       0,
+							errorKey:            0,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenStatusForClusterGroupScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
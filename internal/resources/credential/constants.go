@@ -34,4 +34,29 @@ const (
 	clientCertificateKey        = "client_certificate"
 	managedSubscriptionsKey     = "managed_subscriptions"
 	waitKey                     = "ready_wait_timeout"
+	proxyConfigKey              = "proxy_config"
+	proxyTypeKey                = "proxy_type"
+	httpProxyKey                = "http_proxy"
+	httpsProxyKey               = "https_proxy"
+	httpUsernameKey             = "http_username"
+	httpPasswordKey             = "http_password"
+	httpsUsernameKey            = "https_username"
+	httpsPasswordKey            = "https_password"
+	noProxyKey                  = "no_proxy"
+	proxyCAKey                  = "proxy_ca"
+	imageRegistryKey            = "image_registry"
+	urlKey                      = "url"
+	usernameKey                 = "username"
+	passwordKey                 = "password"
+	caCertKey                   = "ca_cert"
+	skipVerifyKey               = "skip_verify"
+	namespaceKey                = "namespace"
+)
+
+const (
+	proxyConfigCapability   = "PROXY_CONFIG"
+	imageRegistryCapability = "IMAGE_REGISTRY"
+
+	explicitProxyType    = "explicit"
+	transparentProxyType = "transparent"
 )
@@ -104,6 +104,8 @@ func dataSourceCredentialRead(ctx context.Context, d *schema.ResourceData, m int
 		_ = d.Set(specKey, specData)
 	}
 
+	removeTypedCredentialAnnotations(d)
+
 	if err != nil {
 		log.Println(err)
 
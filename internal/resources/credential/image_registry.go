@@ -0,0 +1,103 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package credential
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Annotations and key value data keys of an IMAGE_REGISTRY credential.
+const (
+	repositoryNamespaceAnnotation = "repository-namespace"
+
+	registryURLDataKey = "registry-url"
+	usernameDataKey    = "username"
+	passwordDataKey    = "password"
+	caCertDataKey      = "ca-cert"
+	skipVerifyDataKey  = "skip-verify"
+)
+
+var imageRegistryAnnotations = []string{repositoryNamespaceAnnotation}
+
+var imageRegistrySpec = &schema.Schema{
+	Type:          schema.TypeList,
+	Optional:      true,
+	MaxItems:      1,
+	Description:   "Image registry credential, used with the IMAGE_REGISTRY capability",
+	ConflictsWith: dataBlockConflicts(imageRegistryKey),
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			urlKey: {
+				Description:  "URL of the image registry, e.g. harbor.example.com",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			namespaceKey: {
+				Description: "Repository namespace in the image registry the images are pulled from",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			usernameKey: {
+				Description:  "Username of the image registry",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{imageRegistryFieldPath(passwordKey)},
+			},
+			passwordKey: {
+				Description:  "Password of the image registry",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{imageRegistryFieldPath(usernameKey)},
+			},
+			caCertKey: {
+				Description:   "PEM encoded CA certificate of the image registry",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{imageRegistryFieldPath(skipVerifyKey)},
+			},
+			skipVerifyKey: {
+				Description: "Skip the TLS verification of the image registry certificate",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	},
+}
+
+func imageRegistryFieldPath(key string) string {
+	return strings.Join([]string{specKey, "0", dataKey, "0", imageRegistryKey, "0", key}, ".")
+}
+
+// constructImageRegistry returns the annotations and key value data of an IMAGE_REGISTRY credential.
+func constructImageRegistry(imageRegistryData map[string]interface{}) (annotations map[string]string, data map[string]strfmt.Base64) {
+	annotations = make(map[string]string)
+	data = map[string]strfmt.Base64{
+		registryURLDataKey: strfmt.Base64(imageRegistryData[urlKey].(string)),
+	}
+
+	if namespace, _ := imageRegistryData[namespaceKey].(string); namespace != "" {
+		annotations[repositoryNamespaceAnnotation] = namespace
+	}
+
+	for key, dataKey := range map[string]string{usernameKey: usernameDataKey, passwordKey: passwordDataKey, caCertKey: caCertDataKey} {
+		if value, _ := imageRegistryData[key].(string); value != "" {
+			data[dataKey] = strfmt.Base64(value)
+		}
+	}
+
+	if skipVerify, _ := imageRegistryData[skipVerifyKey].(bool); skipVerify {
+		data[skipVerifyDataKey] = strfmt.Base64(strconv.FormatBool(skipVerify))
+	}
+
+	return annotations, data
+}
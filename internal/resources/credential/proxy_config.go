@@ -0,0 +1,145 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package credential
+
+import (
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Annotations and key value data keys of a PROXY_CONFIG credential.
+const (
+	proxyTypeAnnotation   = "proxyType"
+	httpProxyAnnotation   = "httpProxy"
+	httpsProxyAnnotation  = "httpsProxy"
+	noProxyListAnnotation = "noProxyList"
+
+	httpUserNameDataKey  = "httpUserName"
+	httpPasswordDataKey  = "httpPassword"
+	httpsUserNameDataKey = "httpsUserName"
+	httpsPasswordDataKey = "httpsPassword"
+	proxyCABundleDataKey = "proxyCABundle"
+)
+
+var proxyConfigAnnotations = []string{proxyTypeAnnotation, httpProxyAnnotation, httpsProxyAnnotation, noProxyListAnnotation}
+
+var proxyConfigSpec = &schema.Schema{
+	Type:          schema.TypeList,
+	Optional:      true,
+	MaxItems:      1,
+	Description:   "Proxy configuration credential, used with the PROXY_CONFIG capability",
+	ConflictsWith: dataBlockConflicts(proxyConfigKey),
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			proxyTypeKey: {
+				Description:  "Type of the proxy, explicit proxies require http_proxy or https_proxy. Valid values are: [explicit transparent]",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      explicitProxyType,
+				ValidateFunc: validation.StringInSlice([]string{explicitProxyType, transparentProxyType}, false),
+			},
+			httpProxyKey: {
+				Description:  "URL of the HTTP proxy, e.g. http://proxy.example.com:3128",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+			},
+			httpsProxyKey: {
+				Description:  "URL of the HTTPS proxy, e.g. http://proxy.example.com:3128",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+			},
+			httpUsernameKey: {
+				Description:  "Username of the HTTP proxy",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{proxyConfigFieldPath(httpProxyKey), proxyConfigFieldPath(httpPasswordKey)},
+			},
+			httpPasswordKey: {
+				Description:  "Password of the HTTP proxy",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{proxyConfigFieldPath(httpUsernameKey)},
+			},
+			httpsUsernameKey: {
+				Description:  "Username of the HTTPS proxy",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{proxyConfigFieldPath(httpsProxyKey), proxyConfigFieldPath(httpsPasswordKey)},
+			},
+			httpsPasswordKey: {
+				Description:  "Password of the HTTPS proxy",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{proxyConfigFieldPath(httpsUsernameKey)},
+			},
+			noProxyKey: {
+				Description: "Hostnames, domains and CIDRs which are accessed without the proxy",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			proxyCAKey: {
+				Description: "PEM encoded CA bundle of the proxy, a chain of certificates is supported",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	},
+}
+
+func proxyConfigFieldPath(key string) string {
+	return strings.Join([]string{specKey, "0", dataKey, "0", proxyConfigKey, "0", key}, ".")
+}
+
+// constructProxyConfig returns the annotations and key value data of a PROXY_CONFIG credential.
+func constructProxyConfig(proxyConfigData map[string]interface{}) (annotations map[string]string, data map[string]strfmt.Base64) {
+	annotations = map[string]string{
+		proxyTypeAnnotation: proxyConfigData[proxyTypeKey].(string),
+	}
+	data = make(map[string]strfmt.Base64)
+
+	setIfNotEmpty := func(values map[string]string, key string, value interface{}) {
+		if v, _ := value.(string); v != "" {
+			values[key] = v
+		}
+	}
+
+	setIfNotEmpty(annotations, httpProxyAnnotation, proxyConfigData[httpProxyKey])
+	setIfNotEmpty(annotations, httpsProxyAnnotation, proxyConfigData[httpsProxyKey])
+
+	if noProxyData, ok := proxyConfigData[noProxyKey].([]interface{}); ok && len(noProxyData) > 0 {
+		noProxyList := make([]string, 0, len(noProxyData))
+
+		for _, noProxy := range noProxyData {
+			noProxyList = append(noProxyList, noProxy.(string))
+		}
+
+		annotations[noProxyListAnnotation] = strings.Join(noProxyList, ",")
+	}
+
+	secretData := make(map[string]string)
+
+	setIfNotEmpty(secretData, httpUserNameDataKey, proxyConfigData[httpUsernameKey])
+	setIfNotEmpty(secretData, httpPasswordDataKey, proxyConfigData[httpPasswordKey])
+	setIfNotEmpty(secretData, httpsUserNameDataKey, proxyConfigData[httpsUsernameKey])
+	setIfNotEmpty(secretData, httpsPasswordDataKey, proxyConfigData[httpsPasswordKey])
+	setIfNotEmpty(secretData, proxyCABundleDataKey, proxyConfigData[proxyCAKey])
+
+	for k, v := range secretData {
+		data[k] = strfmt.Base64(v)
+	}
+
+	return annotations, data
+}
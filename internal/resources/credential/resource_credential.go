@@ -25,6 +25,7 @@ func ResourceCredential() *schema.Resource {
 		UpdateContext: resourceCredentialUpdate,
 		DeleteContext: resourceCredentialDelete,
 		Schema:        credentialSchema,
+		CustomizeDiff: validateTypedCredential,
 	}
 }
 
@@ -90,6 +91,8 @@ var dataSpec = &schema.Schema{
 			awsCredentialKey:   awsCredSpec,
 			keyValueKey:        keyValueSpec,
 			azureCredentialKey: azureCredSpec,
+			proxyConfigKey:     proxyConfigSpec,
+			imageRegistryKey:   imageRegistrySpec,
 		},
 	},
 }
@@ -102,6 +105,8 @@ func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(errors.Wrapf(err, "unable to create Tanzu Mission Control credential."))
 	}
 
+	constructTypedCredential(d, model)
+
 	request := &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialCreateCredentialRequest{
 		Credential: model,
 	}
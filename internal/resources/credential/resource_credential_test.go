@@ -59,6 +59,20 @@ func TestAcceptanceForCredentialResource(t *testing.T) {
 					checkResourceAttributes(provider, resourceName, credentialName),
 				),
 			},
+			{
+				Config: getTestResourceCredentialProxyConfigValue(credentialName),
+				Check: resource.ComposeTestCheckFunc(
+					checkResourceAttributes(provider, resourceName, credentialName),
+					verifyCredentialCapability(provider, resourceName, credentialName, proxyConfigCapability),
+				),
+			},
+			{
+				Config: getTestResourceCredentialImageRegistryValue(credentialName),
+				Check: resource.ComposeTestCheckFunc(
+					checkResourceAttributes(provider, resourceName, credentialName),
+					verifyCredentialCapability(provider, resourceName, credentialName, imageRegistryCapability),
+				),
+			},
 		},
 	},
 	)
@@ -170,6 +184,48 @@ resource "%s" "%s" {
 `, credentialResource, credentialResourceVar, credentialName)
 }
 
+func getTestResourceCredentialProxyConfigValue(credentialName string) string {
+	return fmt.Sprintf(`
+resource "%s" "%s" {
+  name = "%s"
+
+  spec {
+    data {
+      proxy_config {
+        http_proxy     = "http://proxy.example.com:3128"
+        https_proxy    = "http://proxy.example.com:3128"
+        http_username  = "username"
+        http_password  = "password"
+        https_username = "username"
+        https_password = "password"
+        no_proxy       = ["noproxy.example.com", "10.0.0.0/8"]
+      }
+    }
+  }
+}
+`, credentialResource, credentialResourceVar, credentialName)
+}
+
+func getTestResourceCredentialImageRegistryValue(credentialName string) string {
+	return fmt.Sprintf(`
+resource "%s" "%s" {
+  name = "%s"
+
+  spec {
+    data {
+      image_registry {
+        url         = "harbor.example.com"
+        namespace   = "tmc"
+        username    = "username"
+        password    = "password"
+        skip_verify = true
+      }
+    }
+  }
+}
+`, credentialResource, credentialResourceVar, credentialName)
+}
+
 func checkResourceAttributes(provider *schema.Provider, resourceName, credentialName string) resource.TestCheckFunc {
 	checks := []resource.TestCheckFunc{
 		resource.TestCheckResourceAttr(resourceName, "meta.#", "1"),
@@ -206,31 +262,68 @@ func verifyCredentialResourceCreation(
 			return fmt.Errorf("ID not set, resource %s", resourceName)
 		}
 
-		config := authctx.TanzuContext{
-			ServerEndpoint:   os.Getenv(authctx.ServerEndpointEnvVar),
-			Token:            os.Getenv(authctx.VMWCloudAPITokenEnvVar),
-			VMWCloudEndPoint: os.Getenv(authctx.VMWCloudEndpointEnvVar),
-			TLSConfig:        &proxy.TLSConfig{},
+		resp, err := getCredential(credName)
+		if err != nil {
+			return err
 		}
 
-		err := config.Setup()
-		if err != nil {
-			return errors.Wrap(err, "unable to set the context")
+		if resp == nil {
+			return fmt.Errorf("credential resource is empty, resource: %s", resourceName)
 		}
 
-		fn := &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialFullName{
-			Name: credName,
+		return nil
+	}
+}
+
+// verifyCredentialCapability checks the capability of the credential in TMC, the capability set by a typed credential data block isn't part of the state.
+func verifyCredentialCapability(provider *schema.Provider, resourceName, credName, capability string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if provider == nil {
+			return fmt.Errorf("provider not initialised")
 		}
 
-		resp, err := config.TMCConnection.CredentialResourceService.CredentialResourceServiceGet(fn)
+		if _, ok := s.RootModule().Resources[resourceName]; !ok {
+			return fmt.Errorf("not found resource %s", resourceName)
+		}
+
+		resp, err := getCredential(credName)
 		if err != nil {
-			return fmt.Errorf("credential resource not found: %s", err)
+			return err
 		}
 
-		if resp == nil {
+		if resp == nil || resp.Credential == nil || resp.Credential.Spec == nil {
 			return fmt.Errorf("credential resource is empty, resource: %s", resourceName)
 		}
 
+		if resp.Credential.Spec.Capability != capability {
+			return fmt.Errorf("expected capability %s, got %s, resource: %s", capability, resp.Credential.Spec.Capability, resourceName)
+		}
+
 		return nil
 	}
 }
+
+func getCredential(credName string) (*credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialGetCredentialResponse, error) {
+	config := authctx.TanzuContext{
+		ServerEndpoint:   os.Getenv(authctx.ServerEndpointEnvVar),
+		Token:            os.Getenv(authctx.VMWCloudAPITokenEnvVar),
+		VMWCloudEndPoint: os.Getenv(authctx.VMWCloudEndpointEnvVar),
+		TLSConfig:        &proxy.TLSConfig{},
+	}
+
+	err := config.Setup()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set the context")
+	}
+
+	fn := &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialFullName{
+		Name: credName,
+	}
+
+	resp, err := config.TMCConnection.CredentialResourceService.CredentialResourceServiceGet(fn)
+	if err != nil {
+		return nil, fmt.Errorf("credential resource not found: %s", err)
+	}
+
+	return resp, nil
+}
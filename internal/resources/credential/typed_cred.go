@@ -0,0 +1,173 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package credential
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	credentialsmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/credential"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+// typedCredentialCapabilities maps the typed credential data blocks to the capability they are used with.
+var typedCredentialCapabilities = map[string]string{
+	proxyConfigKey:   proxyConfigCapability,
+	imageRegistryKey: imageRegistryCapability,
+}
+
+// typedCredentialAnnotations maps the typed credential data blocks to the annotations they manage.
+var typedCredentialAnnotations = map[string][]string{
+	proxyConfigKey:   proxyConfigAnnotations,
+	imageRegistryKey: imageRegistryAnnotations,
+}
+
+type resourceDataGetter interface {
+	Get(key string) interface{}
+}
+
+// dataBlockConflicts returns the paths of the credential data blocks other than the given block.
+func dataBlockConflicts(blockKey string) []string {
+	conflicts := make([]string, 0)
+
+	for _, key := range []string{genericCredentialKey, awsCredentialKey, keyValueKey, azureCredentialKey, proxyConfigKey, imageRegistryKey} {
+		if key != blockKey {
+			conflicts = append(conflicts, strings.Join([]string{specKey, "0", dataKey, "0", key}, "."))
+		}
+	}
+
+	return conflicts
+}
+
+// getTypedCredentialData returns the typed credential data block which is set and its data.
+func getTypedCredentialData(d resourceDataGetter) (blockKey string, blockData map[string]interface{}) {
+	for key := range typedCredentialCapabilities {
+		blocks, _ := d.Get(strings.Join([]string{specKey, "0", dataKey, "0", key}, ".")).([]interface{})
+
+		if len(blocks) > 0 && blocks[0] != nil {
+			return key, blocks[0].(map[string]interface{})
+		}
+	}
+
+	return "", nil
+}
+
+// constructTypedCredential sets the capability, provider, annotations and key value data of a credential configured with a typed credential data block.
+func constructTypedCredential(d *schema.ResourceData, model *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialCredential) {
+	var (
+		annotations map[string]string
+		data        map[string]strfmt.Base64
+	)
+
+	blockKey, blockData := getTypedCredentialData(d)
+
+	switch blockKey {
+	case proxyConfigKey:
+		annotations, data = constructProxyConfig(blockData)
+	case imageRegistryKey:
+		annotations, data = constructImageRegistry(blockData)
+	default:
+		return
+	}
+
+	if model.Spec == nil {
+		model.Spec = &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialSpec{}
+	}
+
+	if model.Spec.Capability == "" {
+		model.Spec.Capability = typedCredentialCapabilities[blockKey]
+	}
+
+	if model.Spec.Meta == nil {
+		model.Spec.Meta = &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialMeta{}
+	}
+
+	if model.Spec.Meta.Provider == nil || *model.Spec.Meta.Provider == credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderPROVIDERUNSPECIFIED {
+		model.Spec.Meta.Provider = credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderGENERICKEYVALUE.Pointer()
+	}
+
+	model.Spec.Data = &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+		KeyValue: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+			Data: data,
+		},
+	}
+
+	if model.Meta == nil {
+		model.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	if model.Meta.Annotations == nil {
+		model.Meta.Annotations = make(map[string]string)
+	}
+
+	for k, v := range annotations {
+		model.Meta.Annotations[k] = v
+	}
+}
+
+// validateTypedCredential validates the typed credential data blocks against the capability, the meta annotations and the proxy type.
+func validateTypedCredential(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	blockKey, blockData := getTypedCredentialData(diff)
+
+	if blockKey == "" {
+		return nil
+	}
+
+	capability, _ := diff.Get(strings.Join([]string{specKey, "0", capabilityKey}, ".")).(string)
+
+	if capability != "" && capability != typedCredentialCapabilities[blockKey] {
+		return errors.Errorf("%s can only be used with the %s capability, capability: %s", blockKey, typedCredentialCapabilities[blockKey], capability)
+	}
+
+	annotations, _ := diff.Get(strings.Join([]string{common.MetaKey, "0", common.AnnotationsKey}, ".")).(map[string]interface{})
+
+	for _, annotation := range typedCredentialAnnotations[blockKey] {
+		if _, ok := annotations[annotation]; ok {
+			return errors.Errorf("annotation %s is set by %s and can't be set in meta", annotation, blockKey)
+		}
+	}
+
+	if blockKey == proxyConfigKey {
+		httpProxy, _ := blockData[httpProxyKey].(string)
+		httpsProxy, _ := blockData[httpsProxyKey].(string)
+
+		switch blockData[proxyTypeKey] {
+		case explicitProxyType:
+			if httpProxy == "" && httpsProxy == "" {
+				return errors.Errorf("%s or %s must be set for an explicit proxy", httpProxyKey, httpsProxyKey)
+			}
+		case transparentProxyType:
+			if httpProxy != "" || httpsProxy != "" {
+				return errors.Errorf("%s and %s can't be set for a transparent proxy", httpProxyKey, httpsProxyKey)
+			}
+		}
+	}
+
+	return nil
+}
+
+// removeTypedCredentialAnnotations removes the annotations managed by a typed credential data block from the meta,
+// these are not part of the meta configuration and would otherwise show up as a diff.
+func removeTypedCredentialAnnotations(d *schema.ResourceData) {
+	blockKey, _ := getTypedCredentialData(d)
+	metaData, _ := d.Get(common.MetaKey).([]interface{})
+
+	if blockKey == "" || len(metaData) == 0 || metaData[0] == nil {
+		return
+	}
+
+	annotations, _ := metaData[0].(map[string]interface{})[common.AnnotationsKey].(map[string]interface{})
+
+	for _, annotation := range typedCredentialAnnotations[blockKey] {
+		delete(annotations, annotation)
+	}
+
+	_ = d.Set(common.MetaKey, metaData)
+}
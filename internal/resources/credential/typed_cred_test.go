@@ -0,0 +1,240 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package credential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	credentialsmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/credential"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func testTypedCredentialConfig(capability string, blockKey string, blockData map[string]interface{}, annotations map[string]interface{}) map[string]interface{} {
+	config := map[string]interface{}{
+		NameKey: "test-credential",
+		specKey: []interface{}{
+			map[string]interface{}{
+				capabilityKey: capability,
+				dataKey: []interface{}{
+					map[string]interface{}{
+						blockKey: []interface{}{blockData},
+					},
+				},
+			},
+		},
+	}
+
+	if annotations != nil {
+		config[common.MetaKey] = []interface{}{
+			map[string]interface{}{
+				common.AnnotationsKey: annotations,
+			},
+		}
+	}
+
+	return config
+}
+
+func TestConstructProxyConfig(t *testing.T) {
+	cases := []struct {
+		description         string
+		input               map[string]interface{}
+		expectedAnnotations map[string]string
+		expectedData        map[string]strfmt.Base64
+	}{
+		{
+			description: "transparent proxy",
+			input: map[string]interface{}{
+				proxyTypeKey: transparentProxyType,
+			},
+			expectedAnnotations: map[string]string{proxyTypeAnnotation: transparentProxyType},
+			expectedData:        map[string]strfmt.Base64{},
+		},
+		{
+			description: "explicit proxy with credentials",
+			input: map[string]interface{}{
+				proxyTypeKey:     explicitProxyType,
+				httpProxyKey:     "http://proxy.example.com:3128",
+				httpsProxyKey:    "http://proxy.example.com:3129",
+				httpUsernameKey:  "user",
+				httpPasswordKey:  "password",
+				httpsUsernameKey: "",
+				noProxyKey:       []interface{}{"noproxy.example.com", "10.0.0.0/8"},
+				proxyCAKey:       "ca-bundle",
+			},
+			expectedAnnotations: map[string]string{
+				proxyTypeAnnotation:   explicitProxyType,
+				httpProxyAnnotation:   "http://proxy.example.com:3128",
+				httpsProxyAnnotation:  "http://proxy.example.com:3129",
+				noProxyListAnnotation: "noproxy.example.com,10.0.0.0/8",
+			},
+			expectedData: map[string]strfmt.Base64{
+				httpUserNameDataKey:  strfmt.Base64("user"),
+				httpPasswordDataKey:  strfmt.Base64("password"),
+				proxyCABundleDataKey: strfmt.Base64("ca-bundle"),
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.description, func(t *testing.T) {
+			annotations, data := constructProxyConfig(test.input)
+			require.Equal(t, test.expectedAnnotations, annotations)
+			require.Equal(t, test.expectedData, data)
+		})
+	}
+}
+
+func TestConstructImageRegistry(t *testing.T) {
+	cases := []struct {
+		description         string
+		input               map[string]interface{}
+		expectedAnnotations map[string]string
+		expectedData        map[string]strfmt.Base64
+	}{
+		{
+			description: "registry url only",
+			input: map[string]interface{}{
+				urlKey:        "harbor.example.com",
+				skipVerifyKey: false,
+			},
+			expectedAnnotations: map[string]string{},
+			expectedData: map[string]strfmt.Base64{
+				registryURLDataKey: strfmt.Base64("harbor.example.com"),
+			},
+		},
+		{
+			description: "registry with namespace, credentials and skipped verification",
+			input: map[string]interface{}{
+				urlKey:        "harbor.example.com",
+				namespaceKey:  "tmc",
+				usernameKey:   "user",
+				passwordKey:   "password",
+				caCertKey:     "",
+				skipVerifyKey: true,
+			},
+			expectedAnnotations: map[string]string{repositoryNamespaceAnnotation: "tmc"},
+			expectedData: map[string]strfmt.Base64{
+				registryURLDataKey: strfmt.Base64("harbor.example.com"),
+				usernameDataKey:    strfmt.Base64("user"),
+				passwordDataKey:    strfmt.Base64("password"),
+				skipVerifyDataKey:  strfmt.Base64("true"),
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.description, func(t *testing.T) {
+			annotations, data := constructImageRegistry(test.input)
+			require.Equal(t, test.expectedAnnotations, annotations)
+			require.Equal(t, test.expectedData, data)
+		})
+	}
+}
+
+func TestConstructTypedCredential(t *testing.T) {
+	cases := []struct {
+		description         string
+		config              map[string]interface{}
+		expectedCapability  string
+		expectedProvider    credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProvider
+		expectedAnnotations map[string]string
+		expectedData        map[string]strfmt.Base64
+	}{
+		{
+			description:         "proxy config without capability",
+			config:              testTypedCredentialConfig("", proxyConfigKey, map[string]interface{}{proxyTypeKey: transparentProxyType}, nil),
+			expectedCapability:  proxyConfigCapability,
+			expectedProvider:    credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderGENERICKEYVALUE,
+			expectedAnnotations: map[string]string{proxyTypeAnnotation: transparentProxyType},
+			expectedData:        map[string]strfmt.Base64{},
+		},
+		{
+			description:         "image registry with capability and meta annotations",
+			config:              testTypedCredentialConfig(imageRegistryCapability, imageRegistryKey, map[string]interface{}{urlKey: "harbor.example.com", namespaceKey: "tmc"}, map[string]interface{}{"team": "platform"}),
+			expectedCapability:  imageRegistryCapability,
+			expectedProvider:    credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderGENERICKEYVALUE,
+			expectedAnnotations: map[string]string{"team": "platform", repositoryNamespaceAnnotation: "tmc"},
+			expectedData:        map[string]strfmt.Base64{registryURLDataKey: strfmt.Base64("harbor.example.com")},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.description, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, credentialSchema, test.config)
+			model := &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialCredential{
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+					Annotations: map[string]string{"team": "platform"},
+				},
+				Spec: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialSpec{
+					Capability: test.config[specKey].([]interface{})[0].(map[string]interface{})[capabilityKey].(string),
+				},
+			}
+
+			if _, ok := test.config[common.MetaKey]; !ok {
+				model.Meta = nil
+			}
+
+			constructTypedCredential(d, model)
+
+			require.Equal(t, test.expectedCapability, model.Spec.Capability)
+			require.Equal(t, test.expectedProvider, *model.Spec.Meta.Provider)
+			require.Equal(t, test.expectedAnnotations, model.Meta.Annotations)
+			require.Equal(t, test.expectedData, model.Spec.Data.KeyValue.Data)
+		})
+	}
+}
+
+func TestValidateTypedCredential(t *testing.T) {
+	cases := []struct {
+		description   string
+		config        map[string]interface{}
+		expectedError string
+	}{
+		{
+			description: "explicit proxy",
+			config:      testTypedCredentialConfig("", proxyConfigKey, map[string]interface{}{proxyTypeKey: explicitProxyType, httpsProxyKey: "http://proxy.example.com:3128"}, nil),
+		},
+		{
+			description:   "explicit proxy without proxies",
+			config:        testTypedCredentialConfig("", proxyConfigKey, map[string]interface{}{proxyTypeKey: explicitProxyType}, nil),
+			expectedError: "http_proxy or https_proxy must be set for an explicit proxy",
+		},
+		{
+			description:   "transparent proxy with proxies",
+			config:        testTypedCredentialConfig("", proxyConfigKey, map[string]interface{}{proxyTypeKey: transparentProxyType, httpProxyKey: "http://proxy.example.com:3128"}, nil),
+			expectedError: "http_proxy and https_proxy can't be set for a transparent proxy",
+		},
+		{
+			description:   "image registry with another capability",
+			config:        testTypedCredentialConfig("DATA_PROTECTION", imageRegistryKey, map[string]interface{}{urlKey: "harbor.example.com"}, nil),
+			expectedError: "image_registry can only be used with the IMAGE_REGISTRY capability, capability: DATA_PROTECTION",
+		},
+		{
+			description:   "annotation managed by the typed credential",
+			config:        testTypedCredentialConfig(imageRegistryCapability, imageRegistryKey, map[string]interface{}{urlKey: "harbor.example.com"}, map[string]interface{}{repositoryNamespaceAnnotation: "tmc"}),
+			expectedError: "annotation repository-namespace is set by image_registry and can't be set in meta",
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.description, func(t *testing.T) {
+			_, err := ResourceCredential().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(test.config), nil)
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.expectedError)
+			}
+		})
+	}
+}
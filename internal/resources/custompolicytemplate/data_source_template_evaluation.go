@@ -0,0 +1,252 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package custompolicytemplate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+const (
+	TestResourceName = "tanzu-mission-control_custom_policy_template_test"
+
+	// Root Keys.
+	ParametersKey       = "parameters"
+	InventoryObjectsKey = "data_inventory_objects"
+	ExampleKey          = "example"
+	FailOnMismatchKey   = "fail_on_mismatch"
+	PassedKey           = "passed"
+	ResultsKey          = "results"
+
+	// Example Directive Keys.
+	ManifestKey   = "manifest"
+	ExpectKey     = "expect"
+	ViolatedKey   = "violated"
+	ViolationsKey = "violations"
+
+	expectAllow = "allow"
+	expectDeny  = "deny"
+)
+
+func DataSourceCustomPolicyTemplateTest() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCustomPolicyTemplateTestRead,
+		Schema:      customPolicyTemplateTestSchema,
+	}
+}
+
+var customPolicyTemplateTestSchema = map[string]*schema.Schema{
+	TemplateManifestKey: {
+		Type:         schema.TypeString,
+		Description:  "YAML formatted Gatekeeper ConstraintTemplate, as set in the template_manifest of the custom policy template.",
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	},
+	ParametersKey: {
+		Type:         schema.TypeString,
+		Description:  "JSON encoded template parameters, as set in the custom policy.",
+		Optional:     true,
+		ValidateFunc: validation.StringIsJSON,
+	},
+	InventoryObjectsKey: {
+		Type:        schema.TypeList,
+		Description: "YAML or JSON formatted Kubernetes objects exposed to the Rego as \"data.inventory\", like the objects of the data_inventory kinds synced by Gatekeeper.",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+	ExampleKey: {
+		Type:        schema.TypeList,
+		Description: "Example Kubernetes object evaluated against the template as in an admission request.",
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NameKey: {
+					Type:         schema.TypeString,
+					Description:  "Name of the example.",
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				ManifestKey: {
+					Type:         schema.TypeString,
+					Description:  "YAML or JSON formatted Kubernetes object.",
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				ExpectKey: {
+					Type:         schema.TypeString,
+					Description:  fmt.Sprintf("Expected outcome of the evaluation, valid values are: [%s, %s]", expectAllow, expectDeny),
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{expectAllow, expectDeny}, false),
+				},
+			},
+		},
+	},
+	FailOnMismatchKey: {
+		Type:        schema.TypeBool,
+		Description: "Fail reading the data source when an example doesn't have the expected outcome.",
+		Optional:    true,
+		Default:     true,
+	},
+	PassedKey: {
+		Type:        schema.TypeBool,
+		Description: "True when all the examples have the expected outcome.",
+		Computed:    true,
+	},
+	ResultsKey: {
+		Type:        schema.TypeList,
+		Description: "Outcome of the evaluation of each example, in the order of the examples.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the example.",
+					Computed:    true,
+				},
+				ExpectKey: {
+					Type:        schema.TypeString,
+					Description: "Expected outcome of the evaluation.",
+					Computed:    true,
+				},
+				ViolatedKey: {
+					Type:        schema.TypeBool,
+					Description: "True when the example violates the template.",
+					Computed:    true,
+				},
+				ViolationsKey: {
+					Type:        schema.TypeList,
+					Description: "Messages of the violations of the example.",
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				PassedKey: {
+					Type:        schema.TypeBool,
+					Description: "True when the example has the expected outcome.",
+					Computed:    true,
+				},
+			},
+		},
+	},
+}
+
+type exampleResult struct {
+	name       string
+	expect     string
+	violations []string
+}
+
+func (r *exampleResult) passed() bool {
+	return (len(r.violations) > 0) == (r.expect == expectDeny)
+}
+
+func dataSourceCustomPolicyTemplateTestRead(ctx context.Context, data *schema.ResourceData, _ interface{}) (diags diag.Diagnostics) {
+	templateManifest, _ := data.Get(TemplateManifestKey).(string)
+	parametersData, _ := data.Get(ParametersKey).(string)
+	failOnMismatch, _ := data.Get(FailOnMismatchKey).(bool)
+
+	var parameters map[string]interface{}
+
+	if parametersData != "" {
+		if err := json.Unmarshal([]byte(parametersData), &parameters); err != nil {
+			return diag.FromErr(errors.Wrap(err, "template parameters must be a JSON object"))
+		}
+	}
+
+	inventoryObjects := make([]map[string]interface{}, 0)
+
+	for i, manifest := range data.Get(InventoryObjectsKey).([]interface{}) {
+		manifestData, _ := manifest.(string)
+
+		object, err := parseManifest(manifestData)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "couldn't parse data inventory object %d", i))
+		}
+
+		inventoryObjects = append(inventoryObjects, object)
+	}
+
+	evaluator, err := newTemplateEvaluator(ctx, templateManifest, parameters, inventoryObjects)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	results := make([]*exampleResult, 0)
+
+	for _, example := range data.Get(ExampleKey).([]interface{}) {
+		exampleData, _ := example.(map[string]interface{})
+		result := &exampleResult{}
+		result.name, _ = exampleData[NameKey].(string)
+		result.expect, _ = exampleData[ExpectKey].(string)
+		manifestData, _ := exampleData[ManifestKey].(string)
+
+		object, err := parseManifest(manifestData)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "couldn't parse the manifest of example %s", result.name))
+		}
+
+		result.violations, err = evaluator.violations(ctx, object)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "example %s", result.name))
+		}
+
+		results = append(results, result)
+	}
+
+	passed := true
+	mismatches := make([]string, 0)
+
+	for _, result := range results {
+		if !result.passed() {
+			passed = false
+
+			mismatches = append(mismatches, fmt.Sprintf("example %s: expected %s, got %d violation(s) %q", result.name, result.expect, len(result.violations), result.violations))
+		}
+	}
+
+	if failOnMismatch && !passed {
+		return diag.Errorf("custom policy template doesn't have the expected outcome:\n%s", strings.Join(mismatches, "\n"))
+	}
+
+	data.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(templateManifest+parametersData))))
+
+	if err := data.Set(PassedKey, passed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := data.Set(ResultsKey, flattenExampleResults(results)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func flattenExampleResults(results []*exampleResult) []interface{} {
+	data := make([]interface{}, 0, len(results))
+
+	for _, result := range results {
+		data = append(data, map[string]interface{}{
+			NameKey:       result.name,
+			ExpectKey:     result.expect,
+			ViolatedKey:   len(result.violations) > 0,
+			ViolationsKey: result.violations,
+			PassedKey:     result.passed(),
+		})
+	}
+
+	return data
+}
@@ -0,0 +1,286 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package custompolicytemplate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/storage"
+	"github.com/open-policy-agent/opa/storage/inmem"
+	"github.com/open-policy-agent/opa/topdown"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// admissionTarget is the Gatekeeper target which evaluates the admission requests.
+	admissionTarget = "admission.k8s.gatekeeper.sh"
+
+	// violationRule is the rule a Gatekeeper template has to define, its messages are reported as violations.
+	violationRule = "violation"
+
+	defaultOperation = "CREATE"
+
+	// resultVar binds the violations of the query.
+	resultVar = "violations"
+)
+
+// templateEvaluator evaluates the Rego of a Gatekeeper ConstraintTemplate like Gatekeeper does for an admission request.
+// The Rego is evaluated with the compiler and the evaluator of OPA, without the rego package which pulls the OPA runtime in.
+type templateEvaluator struct {
+	compiler   *ast.Compiler
+	query      ast.Body
+	store      storage.Store
+	parameters map[string]interface{}
+}
+
+// newTemplateEvaluator compiles the Rego of the admission target of the template manifest,
+// the inventory objects are exposed to the Rego as data.inventory.
+func newTemplateEvaluator(ctx context.Context, templateManifest string, parameters map[string]interface{}, inventoryObjects []map[string]interface{}) (*templateEvaluator, error) {
+	template := make(map[string]interface{})
+
+	if err := yaml.Unmarshal([]byte(templateManifest), &template); err != nil {
+		return nil, errors.Wrap(err, "template manifest is not valid YAML")
+	}
+
+	regoSource, libs, err := admissionTargetRego(template)
+	if err != nil {
+		return nil, err
+	}
+
+	module, err := ast.ParseModule("template.rego", regoSource)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse the Rego of the template")
+	}
+
+	modules := map[string]*ast.Module{"template.rego": module}
+
+	for i, lib := range libs {
+		name := fmt.Sprintf("lib_%d.rego", i)
+
+		libModule, err := ast.ParseModule(name, lib)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse the Rego of library %d of the template", i)
+		}
+
+		modules[name] = libModule
+	}
+
+	compiler := ast.NewCompiler().WithCapabilities(gatekeeperCapabilities())
+
+	if compiler.Compile(modules); compiler.Failed() {
+		return nil, errors.Wrap(compiler.Errors, "couldn't compile the Rego of the template")
+	}
+
+	query, err := ast.ParseBody(fmt.Sprintf("%s = %s.%s", resultVar, module.Package.Path, violationRule))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't construct the query of the template")
+	}
+
+	if parameters == nil {
+		parameters = map[string]interface{}{}
+	}
+
+	return &templateEvaluator{
+		compiler:   compiler,
+		query:      query,
+		store:      inmem.NewFromObject(map[string]interface{}{"inventory": constructInventory(inventoryObjects)}),
+		parameters: parameters,
+	}, nil
+}
+
+// gatekeeperCapabilities returns the capabilities of OPA without the builtins Gatekeeper doesn't allow in templates,
+// the ones reaching out of the evaluation: http.send, the net builtins and opa.runtime.
+func gatekeeperCapabilities() *ast.Capabilities {
+	capabilities := ast.CapabilitiesForThisVersion()
+	builtins := make([]*ast.Builtin, 0, len(capabilities.Builtins))
+
+	for _, builtin := range capabilities.Builtins {
+		if isDisallowedBuiltin(builtin.Name) {
+			continue
+		}
+
+		builtins = append(builtins, builtin)
+	}
+
+	capabilities.Builtins = builtins
+
+	return capabilities
+}
+
+func isDisallowedBuiltin(name string) bool {
+	return name == ast.HTTPSend.Name || name == ast.OPARuntime.Name || strings.HasPrefix(name, "net.")
+}
+
+func admissionTargetRego(template map[string]interface{}) (regoSource string, libs []string, err error) {
+	spec, _ := template["spec"].(map[string]interface{})
+	targets, _ := spec["targets"].([]interface{})
+
+	for _, target := range targets {
+		targetData, _ := target.(map[string]interface{})
+
+		if name, _ := targetData["target"].(string); name != admissionTarget {
+			continue
+		}
+
+		regoSource, _ = targetData["rego"].(string)
+
+		if regoSource == "" {
+			return "", nil, errors.Errorf("target %s of the template has no Rego", admissionTarget)
+		}
+
+		targetLibs, _ := targetData["libs"].([]interface{})
+
+		for _, lib := range targetLibs {
+			if libSource, ok := lib.(string); ok {
+				libs = append(libs, libSource)
+			}
+		}
+
+		return regoSource, libs, nil
+	}
+
+	return "", nil, errors.Errorf("template has no %s target", admissionTarget)
+}
+
+// constructInventory arranges the objects like the Gatekeeper sync cache,
+// data.inventory.cluster[apiVersion][kind][name] and data.inventory.namespace[namespace][apiVersion][kind][name].
+func constructInventory(objects []map[string]interface{}) map[string]interface{} {
+	inventory := map[string]interface{}{
+		"cluster":   map[string]interface{}{},
+		"namespace": map[string]interface{}{},
+	}
+
+	for _, object := range objects {
+		apiVersion, _ := object["apiVersion"].(string)
+		kind, _ := object["kind"].(string)
+		metadata, _ := object["metadata"].(map[string]interface{})
+		name, _ := metadata["name"].(string)
+		namespace, _ := metadata["namespace"].(string)
+
+		path := []string{"cluster", apiVersion, kind}
+
+		if namespace != "" {
+			path = []string{"namespace", namespace, apiVersion, kind}
+		}
+
+		node := inventory
+
+		for _, key := range path {
+			child, ok := node[key].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				node[key] = child
+			}
+
+			node = child
+		}
+
+		node[name] = object
+	}
+
+	return inventory
+}
+
+// violations returns the sorted messages of the violations of the object.
+func (e *templateEvaluator) violations(ctx context.Context, object map[string]interface{}) ([]string, error) {
+	input, err := ast.InterfaceToValue(e.constructInput(object))
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't construct the input of the template")
+	}
+
+	txn, err := e.store.NewTransaction(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't read the inventory")
+	}
+
+	defer e.store.Abort(ctx, txn)
+
+	results, err := topdown.NewQuery(e.query).
+		WithCompiler(e.compiler).
+		WithStore(e.store).
+		WithTransaction(txn).
+		WithInput(ast.NewTerm(input)).
+		Run(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't evaluate the Rego of the template")
+	}
+
+	messages := make([]string, 0)
+
+	for _, result := range results {
+		term, ok := result[ast.Var(resultVar)]
+		if !ok {
+			continue
+		}
+
+		value, err := ast.JSON(term.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't read the violations of the template")
+		}
+
+		violations, _ := value.([]interface{})
+
+		for _, violation := range violations {
+			violationData, _ := violation.(map[string]interface{})
+			message, _ := violationData["msg"].(string)
+			messages = append(messages, message)
+		}
+	}
+
+	sort.Strings(messages)
+
+	return messages, nil
+}
+
+// constructInput returns the input of an admission review of the object.
+func (e *templateEvaluator) constructInput(object map[string]interface{}) map[string]interface{} {
+	apiVersion, _ := object["apiVersion"].(string)
+	kind, _ := object["kind"].(string)
+	metadata, _ := object["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+
+	group, version := "", apiVersion
+
+	if i := strings.LastIndex(apiVersion, "/"); i >= 0 {
+		group, version = apiVersion[:i], apiVersion[i+1:]
+	}
+
+	return map[string]interface{}{
+		"review": map[string]interface{}{
+			"kind": map[string]interface{}{
+				"group":   group,
+				"version": version,
+				"kind":    kind,
+			},
+			"name":      name,
+			"namespace": namespace,
+			"operation": defaultOperation,
+			"object":    object,
+			"userInfo":  map[string]interface{}{},
+		},
+		"parameters": e.parameters,
+	}
+}
+
+// parseManifest parses a YAML or JSON Kubernetes manifest.
+func parseManifest(manifest string) (map[string]interface{}, error) {
+	object := make(map[string]interface{})
+
+	if err := yaml.Unmarshal([]byte(manifest), &object); err != nil {
+		return nil, err
+	}
+
+	if kind, _ := object["kind"].(string); kind == "" {
+		return nil, errors.New("manifest has no kind")
+	}
+
+	return object, nil
+}
@@ -0,0 +1,190 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package custompolicytemplate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testRequiredLabelsTemplate = `
+apiVersion: templates.gatekeeper.sh/v1beta1
+kind: ConstraintTemplate
+metadata:
+  name: k8srequiredlabels
+spec:
+  crd:
+    spec:
+      names:
+        kind: K8sRequiredLabels
+  targets:
+    - target: admission.k8s.gatekeeper.sh
+      rego: |
+        package k8srequiredlabels
+
+        violation[{"msg": msg}] {
+          required := {label | label := input.parameters.labels[_]}
+          provided := {label | input.review.object.metadata.labels[label]}
+          missing := required - provided
+          count(missing) > 0
+          msg := sprintf("%v <%v> is missing the labels: %v", [input.review.kind.kind, input.review.name, missing])
+        }
+`
+
+const testUniqueServiceSelectorTemplate = `
+apiVersion: templates.gatekeeper.sh/v1beta1
+kind: ConstraintTemplate
+metadata:
+  name: k8suniqueserviceselector
+spec:
+  crd:
+    spec:
+      names:
+        kind: K8sUniqueServiceSelector
+  targets:
+    - target: admission.k8s.gatekeeper.sh
+      libs:
+        - |
+          package lib.selectors
+
+          flatten(selector) = s {
+            s := concat(",", [sprintf("%s:%s", [key, val]) | val := selector[key]])
+          }
+      rego: |
+        package k8suniqueserviceselector
+
+        import data.lib.selectors
+
+        violation[{"msg": msg}] {
+          input.review.kind.kind == "Service"
+          selector := selectors.flatten(input.review.object.spec.selector)
+          other := data.inventory.namespace[namespace][_]["Service"][name]
+          not is_reviewed_object(namespace, name)
+          selectors.flatten(other.spec.selector) == selector
+          msg := sprintf("same selector as service <%v> in namespace <%v>", [name, namespace])
+        }
+
+        is_reviewed_object(namespace, name) {
+          namespace == input.review.namespace
+          name == input.review.name
+        }
+`
+
+const testService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: %s
+  namespace: default
+spec:
+  selector:
+    app: web
+`
+
+func TestTemplateEvaluatorViolations(t *testing.T) {
+	ctx := context.Background()
+
+	evaluator, err := newTemplateEvaluator(ctx, testRequiredLabelsTemplate, map[string]interface{}{"labels": []interface{}{"owner", "team"}}, nil)
+	require.NoError(t, err)
+
+	object, err := parseManifest(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "web", "labels": {"owner": "me", "team": "platform"}}}`)
+	require.NoError(t, err)
+
+	violations, err := evaluator.violations(ctx, object)
+	require.NoError(t, err)
+	require.Empty(t, violations)
+
+	object, err = parseManifest("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n  labels:\n    owner: me\n")
+	require.NoError(t, err)
+
+	violations, err = evaluator.violations(ctx, object)
+	require.NoError(t, err)
+	require.Equal(t, []string{`Deployment <web> is missing the labels: {"team"}`}, violations)
+}
+
+func TestTemplateEvaluatorInventory(t *testing.T) {
+	ctx := context.Background()
+
+	existing, err := parseManifest(fmt.Sprintf(testService, "existing"))
+	require.NoError(t, err)
+
+	evaluator, err := newTemplateEvaluator(ctx, testUniqueServiceSelectorTemplate, nil, []map[string]interface{}{existing})
+	require.NoError(t, err)
+
+	// The object itself is part of the inventory when it is updated.
+	violations, err := evaluator.violations(ctx, existing)
+	require.NoError(t, err)
+	require.Empty(t, violations)
+
+	object, err := parseManifest(fmt.Sprintf(testService, "duplicate"))
+	require.NoError(t, err)
+
+	violations, err = evaluator.violations(ctx, object)
+	require.NoError(t, err)
+	require.Equal(t, []string{"same selector as service <existing> in namespace <default>"}, violations)
+}
+
+func TestNewTemplateEvaluatorErrors(t *testing.T) {
+	cases := []struct {
+		description      string
+		templateManifest string
+	}{
+		{
+			description:      "invalid YAML",
+			templateManifest: "spec: [",
+		},
+		{
+			description:      "no admission target",
+			templateManifest: "spec:\n  targets:\n    - target: audit\n      rego: package a\n",
+		},
+		{
+			description:      "Rego syntax error",
+			templateManifest: "spec:\n  targets:\n    - target: admission.k8s.gatekeeper.sh\n      rego: |\n        package a\n        violation[{\"msg\": msg}] {\n",
+		},
+		{
+			description:      "undefined function",
+			templateManifest: "spec:\n  targets:\n    - target: admission.k8s.gatekeeper.sh\n      rego: |\n        package a\n        violation[{\"msg\": msg}] {\n          msg := undefined_function(input.review)\n        }\n",
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.description, func(t *testing.T) {
+			_, err := newTemplateEvaluator(context.Background(), test.templateManifest, nil, nil)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewTemplateEvaluatorDisallowedBuiltins(t *testing.T) {
+	cases := []struct {
+		description string
+		call        string
+	}{
+		{
+			description: "http.send",
+			call:        `http.send({"method": "get", "url": "https://example.com"})`,
+		},
+		{
+			description: "net.lookup_ip_addr",
+			call:        `net.lookup_ip_addr("example.com")`,
+		},
+		{
+			description: "opa.runtime",
+			call:        `opa.runtime()`,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.description, func(t *testing.T) {
+			templateManifest := fmt.Sprintf("spec:\n  targets:\n    - target: admission.k8s.gatekeeper.sh\n      rego: |\n        package a\n        violation[{\"msg\": msg}] {\n          response := %s\n          msg := sprintf(\"%%v\", [response])\n        }\n", test.call)
+
+			_, err := newTemplateEvaluator(context.Background(), templateManifest, nil, nil)
+			require.ErrorContains(t, err, "undefined function "+test.description)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package effectiveiamaccess
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+
+	customiamrolemodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/customiamrole"
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+)
+
+// scopedPolicies are the iam policies returned for a scope of the hierarchy of the target.
+type scopedPolicies struct {
+	scope    string
+	name     string
+	policies []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy
+}
+
+// grant is a role held by a subject, with the scope of the role binding granting it.
+// aggregatedFrom is the role of the role binding when the role is aggregated by it, empty for a role bound directly.
+type grant struct {
+	subjectName    string
+	subjectKind    string
+	role           string
+	sourceScope    string
+	sourceName     string
+	aggregatedFrom string
+}
+
+// collectGrants returns the roles bound to the subjects by the iam policies of the hierarchy.
+// The scopes are expected from the broadest to the narrowest: a policy returned for several scopes, such as an inherited
+// policy returned along with the policies of a scope, is attributed to the first scope it is returned for.
+func collectGrants(scopes []*scopedPolicies) []*grant {
+	grants := make([]*grant, 0)
+	seenPolicies := make(map[string]bool)
+
+	for _, s := range scopes {
+		for _, policy := range s.policies {
+			if policy == nil {
+				continue
+			}
+
+			if policy.Meta != nil && policy.Meta.UID != "" {
+				if seenPolicies[policy.Meta.UID] {
+					continue
+				}
+
+				seenPolicies[policy.Meta.UID] = true
+			}
+
+			for _, roleBinding := range policy.RoleBindings {
+				if roleBinding == nil {
+					continue
+				}
+
+				for _, subject := range roleBinding.Subjects {
+					if subject == nil {
+						continue
+					}
+
+					subjectKind := ""
+
+					if subject.Kind != nil {
+						subjectKind = string(*subject.Kind)
+					}
+
+					grants = append(grants, &grant{
+						subjectName: subject.Name,
+						subjectKind: subjectKind,
+						role:        roleBinding.Role,
+						sourceScope: s.scope,
+						sourceName:  s.name,
+					})
+				}
+			}
+		}
+	}
+
+	return grants
+}
+
+// expandAggregatedRoles adds the roles aggregated by the aggregation rule of the roles of the grants.
+// An aggregated role aggregating other roles is expanded transitively.
+func expandAggregatedRoles(grants []*grant, roles []*customiamrolemodels.VmwareTanzuManageV1alpha1IamRole) []*grant {
+	aggregated := make(map[string][]string)
+
+	for _, g := range grants {
+		if _, ok := aggregated[g.role]; !ok {
+			aggregated[g.role] = aggregatedRoles(g.role, roles)
+		}
+	}
+
+	expanded := make([]*grant, 0, len(grants))
+
+	for _, g := range grants {
+		expanded = append(expanded, g)
+
+		for _, role := range aggregated[g.role] {
+			expanded = append(expanded, &grant{
+				subjectName:    g.subjectName,
+				subjectKind:    g.subjectKind,
+				role:           role,
+				sourceScope:    g.sourceScope,
+				sourceName:     g.sourceName,
+				aggregatedFrom: g.role,
+			})
+		}
+	}
+
+	return expanded
+}
+
+// aggregatedRoles returns the names of the roles selected by the aggregation rule of a role, sorted.
+func aggregatedRoles(roleName string, roles []*customiamrolemodels.VmwareTanzuManageV1alpha1IamRole) []string {
+	rolesByName := make(map[string]*customiamrolemodels.VmwareTanzuManageV1alpha1IamRole, len(roles))
+
+	for _, role := range roles {
+		if role != nil && role.FullName != nil {
+			rolesByName[role.FullName.Name] = role
+		}
+	}
+
+	visited := map[string]bool{roleName: true}
+	pending := []string{roleName}
+	result := make([]string, 0)
+
+	for len(pending) > 0 {
+		current := rolesByName[pending[0]]
+		pending = pending[1:]
+
+		if current == nil || current.Spec == nil || current.Spec.AggregationRule == nil {
+			continue
+		}
+
+		for _, role := range roles {
+			if role == nil || role.FullName == nil || visited[role.FullName.Name] {
+				continue
+			}
+
+			if !matchesAnySelector(current.Spec.AggregationRule.ClusterRoleSelectors, roleLabels(role)) {
+				continue
+			}
+
+			visited[role.FullName.Name] = true
+			pending = append(pending, role.FullName.Name)
+			result = append(result, role.FullName.Name)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+func roleLabels(role *customiamrolemodels.VmwareTanzuManageV1alpha1IamRole) map[string]string {
+	if role.Meta == nil {
+		return nil
+	}
+
+	return role.Meta.Labels
+}
+
+// matchesAnySelector reports whether the labels satisfy one of the label selectors, like the cluster role selectors of a Kubernetes aggregation rule.
+func matchesAnySelector(selectors []*customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector, labels map[string]string) bool {
+	for _, selector := range selectors {
+		if selector != nil && matchesSelector(selector, labels) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesSelector reports whether the labels satisfy the label selector, a selector which isn't valid matches no labels.
+func matchesSelector(selector *customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector, labels map[string]string) bool {
+	roleSelector := &metav1.LabelSelector{MatchLabels: selector.MatchLabels}
+
+	for _, requirement := range selector.MatchExpressions {
+		if requirement != nil {
+			roleSelector.MatchExpressions = append(roleSelector.MatchExpressions, metav1.LabelSelectorRequirement{
+				Key:      requirement.Key,
+				Operator: metav1.LabelSelectorOperator(requirement.Operator),
+				Values:   requirement.Values,
+			})
+		}
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(roleSelector)
+	if err != nil {
+		return false
+	}
+
+	return labelSelector.Matches(k8slabels.Set(labels))
+}
+
+// flattenSubjects groups the grants by subject, the subjects are sorted by kind and name and their roles by name and
+// from the broadest to the narrowest scope. A role granted twice by the same scope and aggregated role is listed once.
+func flattenSubjects(grants []*grant) []interface{} {
+	type subjectKey struct {
+		kind string
+		name string
+	}
+
+	bySubject := make(map[subjectKey][]*grant)
+	subjects := make([]subjectKey, 0)
+
+	for _, g := range grants {
+		key := subjectKey{kind: g.subjectKind, name: g.subjectName}
+
+		if _, ok := bySubject[key]; !ok {
+			subjects = append(subjects, key)
+		}
+
+		bySubject[key] = append(bySubject[key], g)
+	}
+
+	sort.Slice(subjects, func(i, j int) bool {
+		if subjects[i].kind != subjects[j].kind {
+			return subjects[i].kind < subjects[j].kind
+		}
+
+		return subjects[i].name < subjects[j].name
+	})
+
+	data := make([]interface{}, 0, len(subjects))
+
+	for _, subject := range subjects {
+		subjectGrants := bySubject[subject]
+
+		sort.SliceStable(subjectGrants, func(i, j int) bool {
+			a, b := subjectGrants[i], subjectGrants[j]
+
+			switch {
+			case a.role != b.role:
+				return a.role < b.role
+			case a.sourceScope != b.sourceScope:
+				return scopeOrder[a.sourceScope] < scopeOrder[b.sourceScope]
+			case a.sourceName != b.sourceName:
+				return a.sourceName < b.sourceName
+			default:
+				return a.aggregatedFrom < b.aggregatedFrom
+			}
+		})
+
+		roles := make([]interface{}, 0, len(subjectGrants))
+		seen := make(map[grant]bool)
+
+		for _, g := range subjectGrants {
+			if seen[*g] {
+				continue
+			}
+
+			seen[*g] = true
+
+			roles = append(roles, map[string]interface{}{
+				RoleKey:           g.role,
+				SourceScopeKey:    g.sourceScope,
+				SourceNameKey:     g.sourceName,
+				AggregatedFromKey: g.aggregatedFrom,
+			})
+		}
+
+		data = append(data, map[string]interface{}{
+			NameKey:  subject.name,
+			KindKey:  subject.kind,
+			RolesKey: roles,
+		})
+	}
+
+	return data
+}
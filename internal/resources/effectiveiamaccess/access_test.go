@@ -0,0 +1,163 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package effectiveiamaccess
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	customiamrolemodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/customiamrole"
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func newTestPolicy(uid string, roleBindings ...*iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding) *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy {
+	return &iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+		Meta:         &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: uid},
+		RoleBindings: roleBindings,
+	}
+}
+
+func newTestRoleBinding(role string, kind iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKind, names ...string) *iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding {
+	roleBinding := &iammodel.VmwareTanzuCoreV1alpha1PolicyRoleBinding{Role: role}
+
+	for _, name := range names {
+		subjectKind := kind
+		roleBinding.Subjects = append(roleBinding.Subjects, &iammodel.VmwareTanzuCoreV1alpha1PolicySubject{Name: name, Kind: &subjectKind})
+	}
+
+	return roleBinding
+}
+
+func newTestRole(name string, labels map[string]string, selectors ...*customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector) *customiamrolemodels.VmwareTanzuManageV1alpha1IamRole {
+	role := &customiamrolemodels.VmwareTanzuManageV1alpha1IamRole{
+		FullName: &customiamrolemodels.VmwareTanzuManageV1alpha1IamRoleFullName{Name: name},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: labels},
+		Spec:     &customiamrolemodels.VmwareTanzuManageV1alpha1IamRoleSpec{},
+	}
+
+	if len(selectors) > 0 {
+		role.Spec.AggregationRule = &customiamrolemodels.VmwareTanzuManageV1alpha1IamRoleAggregationRule{ClusterRoleSelectors: selectors}
+	}
+
+	return role
+}
+
+func TestCollectGrants(t *testing.T) {
+	orgPolicy := newTestPolicy("org-uid", newTestRoleBinding("organization.view", iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindGROUP, "auditors"))
+
+	grants := collectGrants([]*scopedPolicies{
+		{scope: scopeOrganization, policies: []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{orgPolicy}},
+		// The policies of the ancestors returned along with the policies of the namespace are attributed to the ancestors.
+		{scope: scopeNamespace, name: "payments", policies: []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+			orgPolicy,
+			newTestPolicy("ns-uid", newTestRoleBinding("namespace.edit", iammodel.VmwareTanzuCoreV1alpha1PolicySubjectKindUSER, "alice", "bob")),
+		}},
+	})
+
+	require.Equal(t, []*grant{
+		{subjectName: "auditors", subjectKind: "GROUP", role: "organization.view", sourceScope: scopeOrganization},
+		{subjectName: "alice", subjectKind: "USER", role: "namespace.edit", sourceScope: scopeNamespace, sourceName: "payments"},
+		{subjectName: "bob", subjectKind: "USER", role: "namespace.edit", sourceScope: scopeNamespace, sourceName: "payments"},
+	}, grants)
+}
+
+func TestAggregatedRoles(t *testing.T) {
+	roles := []*customiamrolemodels.VmwareTanzuManageV1alpha1IamRole{
+		newTestRole("platform-admin", nil, &customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector{
+			MatchLabels: map[string]string{"aggregate-to-platform-admin": "true"},
+		}),
+		newTestRole("network-admin", map[string]string{"aggregate-to-platform-admin": "true"}, &customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector{
+			MatchExpressions: []*customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+				{Key: "area", Operator: selectorOperatorIn, Values: []string{"network"}},
+			},
+		}),
+		newTestRole("ingress-admin", map[string]string{"area": "network"}),
+		newTestRole("storage-admin", map[string]string{"aggregate-to-platform-admin": "false"}),
+	}
+
+	require.Equal(t, []string{"ingress-admin", "network-admin"}, aggregatedRoles("platform-admin", roles))
+	require.Equal(t, []string{"ingress-admin"}, aggregatedRoles("network-admin", roles))
+	require.Empty(t, aggregatedRoles("storage-admin", roles))
+	require.Empty(t, aggregatedRoles("cluster.admin", roles))
+}
+
+func TestMatchesSelector(t *testing.T) {
+	labels := map[string]string{"area": "network", "tier": "admin"}
+
+	cases := []struct {
+		name     string
+		selector *customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector
+		expected bool
+	}{
+		{
+			name:     "match labels",
+			selector: &customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector{MatchLabels: map[string]string{"area": "network"}},
+			expected: true,
+		},
+		{
+			name:     "match labels with another value",
+			selector: &customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector{MatchLabels: map[string]string{"area": "storage"}},
+			expected: false,
+		},
+		{
+			name: "not in",
+			selector: &customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector{MatchExpressions: []*customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+				{Key: "tier", Operator: selectorOperatorNotIn, Values: []string{"admin"}},
+			}},
+			expected: false,
+		},
+		{
+			name: "exists and does not exist",
+			selector: &customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector{MatchExpressions: []*customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+				{Key: "tier", Operator: selectorOperatorExists},
+				{Key: "deprecated", Operator: selectorOperatorMissing},
+			}},
+			expected: true,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, matchesSelector(test.selector, labels))
+		})
+	}
+}
+
+func TestFlattenSubjects(t *testing.T) {
+	roles := []*customiamrolemodels.VmwareTanzuManageV1alpha1IamRole{
+		newTestRole("platform-admin", nil, &customiamrolemodels.K8sIoApimachineryPkgApisMetaV1LabelSelector{
+			MatchLabels: map[string]string{"aggregate-to-platform-admin": "true"},
+		}),
+		newTestRole("network-admin", map[string]string{"aggregate-to-platform-admin": "true"}),
+	}
+
+	grants := expandAggregatedRoles([]*grant{
+		{subjectName: "alice", subjectKind: "USER", role: "network-admin", sourceScope: scopeCluster, sourceName: "c1"},
+		{subjectName: "alice", subjectKind: "USER", role: "platform-admin", sourceScope: scopeClusterGroup, sourceName: "cg1"},
+		{subjectName: "alice", subjectKind: "USER", role: "platform-admin", sourceScope: scopeClusterGroup, sourceName: "cg1"},
+		{subjectName: "sre", subjectKind: "GROUP", role: "cluster.admin", sourceScope: scopeOrganization},
+	}, roles)
+
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			NameKey: "sre",
+			KindKey: "GROUP",
+			RolesKey: []interface{}{
+				map[string]interface{}{RoleKey: "cluster.admin", SourceScopeKey: scopeOrganization, SourceNameKey: "", AggregatedFromKey: ""},
+			},
+		},
+		map[string]interface{}{
+			NameKey: "alice",
+			KindKey: "USER",
+			RolesKey: []interface{}{
+				map[string]interface{}{RoleKey: "network-admin", SourceScopeKey: scopeClusterGroup, SourceNameKey: "cg1", AggregatedFromKey: "platform-admin"},
+				map[string]interface{}{RoleKey: "network-admin", SourceScopeKey: scopeCluster, SourceNameKey: "c1", AggregatedFromKey: ""},
+				map[string]interface{}{RoleKey: "platform-admin", SourceScopeKey: scopeClusterGroup, SourceNameKey: "cg1", AggregatedFromKey: ""},
+			},
+		},
+	}, flattenSubjects(grants))
+}
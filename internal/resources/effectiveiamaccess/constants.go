@@ -0,0 +1,51 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package effectiveiamaccess
+
+const (
+	ResourceName = "tanzu-mission-control_effective_iam_access"
+
+	// Target Keys.
+	TargetKey                = "target"
+	ClusterKey               = "cluster"
+	NamespaceKey             = "namespace"
+	NameKey                  = "name"
+	ClusterNameKey           = "cluster_name"
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+
+	// Computed Keys.
+	ClusterGroupNameKey = "cluster_group_name"
+	WorkspaceNameKey    = "workspace_name"
+	SubjectsKey         = "subjects"
+	KindKey             = "kind"
+	RolesKey            = "roles"
+	RoleKey             = "role"
+	SourceScopeKey      = "source_scope"
+	SourceNameKey       = "source_name"
+	AggregatedFromKey   = "aggregated_from"
+
+	// Scopes of the hierarchy a role binding is inherited from.
+	scopeOrganization = "organization"
+	scopeClusterGroup = "cluster_group"
+	scopeWorkspace    = "workspace"
+	scopeCluster      = "cluster"
+	scopeNamespace    = "namespace"
+
+	// Label selector operators as defined in API.
+	selectorOperatorIn      = "In"
+	selectorOperatorNotIn   = "NotIn"
+	selectorOperatorExists  = "Exists"
+	selectorOperatorMissing = "DoesNotExist"
+)
+
+// scopeOrder is the order of the scopes of the hierarchy, from the broadest to the narrowest.
+var scopeOrder = map[string]int{
+	scopeOrganization: 0,
+	scopeClusterGroup: 1,
+	scopeWorkspace:    2,
+	scopeCluster:      3,
+	scopeNamespace:    4,
+}
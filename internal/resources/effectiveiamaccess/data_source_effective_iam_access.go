@@ -0,0 +1,325 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package effectiveiamaccess
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	organizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/organization"
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+)
+
+func DataSourceEffectiveIAMAccess() *schema.Resource {
+	return &schema.Resource{
+		Schema:      effectiveIAMAccessSchema,
+		ReadContext: dataSourceEffectiveIAMAccessRead,
+	}
+}
+
+var targetKeys = []string{
+	fmt.Sprintf("%s.0.%s", TargetKey, ClusterKey),
+	fmt.Sprintf("%s.0.%s", TargetKey, NamespaceKey),
+}
+
+var effectiveIAMAccessSchema = map[string]*schema.Schema{
+	TargetKey: {
+		Type:        schema.TypeList,
+		Description: "Cluster or namespace to compute the effective access for.",
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ClusterKey: {
+					Type:         schema.TypeList,
+					Description:  "The role bindings inherited by a cluster from its organization and cluster group, and the role bindings of the cluster.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: targetKeys,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							NameKey:                  nameSchema("Name of the cluster."),
+							ManagementClusterNameKey: managementClusterNameSchema,
+							ProvisionerNameKey:       provisionerNameSchema,
+						},
+					},
+				},
+				NamespaceKey: {
+					Type:         schema.TypeList,
+					Description:  "The role bindings inherited by a namespace from its organization, cluster group, workspace and cluster, and the role bindings of the namespace.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: targetKeys,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							NameKey:                  nameSchema("Name of the namespace."),
+							ClusterNameKey:           nameSchema("Name of the cluster of the namespace."),
+							ManagementClusterNameKey: managementClusterNameSchema,
+							ProvisionerNameKey:       provisionerNameSchema,
+						},
+					},
+				},
+			},
+		},
+	},
+	ClusterGroupNameKey: computedStringSchema("Name of the cluster group of the cluster."),
+	WorkspaceNameKey:    computedStringSchema("Name of the workspace of the namespace, empty for a cluster target."),
+	SubjectsKey: {
+		Type:        schema.TypeList,
+		Description: "Subjects holding a role on the target, sorted by kind and name.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NameKey: computedStringSchema("Name of the subject."),
+				KindKey: computedStringSchema("Kind of the subject: USER, GROUP, SERVICEACCOUNT or K8S_SERVICEACCOUNT."),
+				RolesKey: {
+					Type:        schema.TypeList,
+					Description: "Roles held by the subject, sorted by role and from the broadest to the narrowest source scope.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							RoleKey:           computedStringSchema("Name of the role."),
+							SourceScopeKey:    computedStringSchema("Scope of the role binding granting the role: organization, cluster_group, workspace, cluster or namespace."),
+							SourceNameKey:     computedStringSchema("Name of the cluster group, workspace, cluster or namespace of the role binding, empty for the organization."),
+							AggregatedFromKey: computedStringSchema("Role of the role binding aggregating the role through its aggregation rule, empty for a role bound directly."),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var managementClusterNameSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Name of the management cluster.",
+	Optional:    true,
+	Default:     "attached",
+}
+
+var provisionerNameSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Name of the provisioner.",
+	Optional:    true,
+	Default:     "attached",
+}
+
+func nameSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  description,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+}
+
+func computedStringSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: description,
+		Computed:    true,
+	}
+}
+
+// target is the cluster or namespace the effective access is computed for.
+type target struct {
+	clusterFullName *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName
+	namespaceName   string
+}
+
+func dataSourceEffectiveIAMAccessRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	t := constructTarget(d)
+	if t == nil {
+		return diag.Errorf("%s must be set", TargetKey)
+	}
+
+	clusterResp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(t.clusterFullName)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Couldn't get cluster %s.", t.clusterFullName.Name))
+	}
+
+	clusterGroupName := ""
+
+	if clusterResp.Cluster != nil && clusterResp.Cluster.Spec != nil {
+		clusterGroupName = clusterResp.Cluster.Spec.ClusterGroupName
+	}
+
+	var namespaceFullName *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName
+
+	workspaceName := ""
+
+	if t.namespaceName != "" {
+		namespaceFullName = &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+			ClusterName:           t.clusterFullName.Name,
+			ManagementClusterName: t.clusterFullName.ManagementClusterName,
+			ProvisionerName:       t.clusterFullName.ProvisionerName,
+			Name:                  t.namespaceName,
+		}
+
+		namespaceResp, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceGet(namespaceFullName)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "Couldn't get namespace %s of cluster %s.", t.namespaceName, t.clusterFullName.Name))
+		}
+
+		if namespaceResp.Namespace != nil && namespaceResp.Namespace.Spec != nil {
+			workspaceName = namespaceResp.Namespace.Spec.WorkspaceName
+		}
+	}
+
+	scopes, err := getInheritedPolicies(config, t.clusterFullName, clusterGroupName, workspaceName, namespaceFullName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grants := collectGrants(scopes)
+
+	rolesResp, err := config.TMCConnection.CustomIAMRoleResourceService.CustomIAMRoleResourceServiceList()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unable to expand the aggregated roles",
+			Detail:   fmt.Sprintf("Couldn't list the iam roles, only the roles bound directly are returned: %s", err.Error()),
+		})
+	} else {
+		grants = expandAggregatedRoles(grants, rolesResp.Roles)
+	}
+
+	d.SetId(strings.Join([]string{
+		t.clusterFullName.ManagementClusterName,
+		t.clusterFullName.ProvisionerName,
+		t.clusterFullName.Name,
+		t.namespaceName,
+	}, "/"))
+
+	if err := d.Set(ClusterGroupNameKey, clusterGroupName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(WorkspaceNameKey, workspaceName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(SubjectsKey, flattenSubjects(grants)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func constructTarget(d *schema.ResourceData) *target {
+	targetData := firstElement(d.Get(TargetKey))
+
+	if cluster := firstElement(targetData[ClusterKey]); cluster != nil {
+		fullName := &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{}
+		fullName.Name, _ = cluster[NameKey].(string)
+		fullName.ManagementClusterName, _ = cluster[ManagementClusterNameKey].(string)
+		fullName.ProvisionerName, _ = cluster[ProvisionerNameKey].(string)
+
+		return &target{clusterFullName: fullName}
+	}
+
+	if namespace := firstElement(targetData[NamespaceKey]); namespace != nil {
+		fullName := &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{}
+		fullName.Name, _ = namespace[ClusterNameKey].(string)
+		fullName.ManagementClusterName, _ = namespace[ManagementClusterNameKey].(string)
+		fullName.ProvisionerName, _ = namespace[ProvisionerNameKey].(string)
+
+		t := &target{clusterFullName: fullName}
+		t.namespaceName, _ = namespace[NameKey].(string)
+
+		return t
+	}
+
+	return nil
+}
+
+func firstElement(value interface{}) map[string]interface{} {
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	element, _ := data[0].(map[string]interface{})
+
+	return element
+}
+
+// getInheritedPolicies gets the iam policies of every scope of the hierarchy of the target, from the broadest to the narrowest.
+// The workspace and namespace policies are only read for a namespace target.
+func getInheritedPolicies(config authctx.TanzuContext, clusterFullName *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName, clusterGroupName, workspaceName string,
+	namespaceFullName *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName) ([]*scopedPolicies, error) {
+	scopes := make([]*scopedPolicies, 0)
+
+	orgResp, err := config.TMCConnection.OrganizationIAMResourceService.ManageV1alpha1OrganizationIAMPolicyGet(&organizationmodel.VmwareTanzuManageV1alpha1OrganizationFullName{})
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return nil, errors.Wrap(err, "Couldn't get the role bindings of the organization.")
+	}
+
+	if err == nil {
+		scopes = append(scopes, &scopedPolicies{scope: scopeOrganization, policies: orgResp.PolicyList})
+	}
+
+	if clusterGroupName != "" {
+		cgResp, err := config.TMCConnection.ClusterGroupIAMResourceService.ManageV1alpha1ClusterGroupIAMPolicyGet(&clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName{
+			Name: clusterGroupName,
+		})
+		if err != nil && !clienterrors.IsNotFoundError(err) {
+			return nil, errors.Wrapf(err, "Couldn't get the role bindings of cluster group %s.", clusterGroupName)
+		}
+
+		if err == nil {
+			scopes = append(scopes, &scopedPolicies{scope: scopeClusterGroup, name: clusterGroupName, policies: cgResp.PolicyList})
+		}
+	}
+
+	if workspaceName != "" {
+		wsResp, err := config.TMCConnection.WorkspaceIAMResourceService.ManageV1alpha1WorkspaceIAMPolicyGet(&workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{
+			Name: workspaceName,
+		})
+		if err != nil && !clienterrors.IsNotFoundError(err) {
+			return nil, errors.Wrapf(err, "Couldn't get the role bindings of workspace %s.", workspaceName)
+		}
+
+		if err == nil {
+			scopes = append(scopes, &scopedPolicies{scope: scopeWorkspace, name: workspaceName, policies: wsResp.PolicyList})
+		}
+	}
+
+	clusterResp, err := config.TMCConnection.ClusterIAMResourceService.ManageV1alpha1ClusterIAMPolicyGet(clusterFullName)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return nil, errors.Wrapf(err, "Couldn't get the role bindings of cluster %s.", clusterFullName.Name)
+	}
+
+	if err == nil {
+		scopes = append(scopes, &scopedPolicies{scope: scopeCluster, name: clusterFullName.Name, policies: clusterResp.PolicyList})
+	}
+
+	if namespaceFullName != nil {
+		namespaceResp, err := config.TMCConnection.NamespaceIAMResourceService.ManageV1alpha1ClusterNamespaceIAMPolicyGet(namespaceFullName)
+		if err != nil && !clienterrors.IsNotFoundError(err) {
+			return nil, errors.Wrapf(err, "Couldn't get the role bindings of namespace %s.", namespaceFullName.Name)
+		}
+
+		if err == nil {
+			scopes = append(scopes, &scopedPolicies{scope: scopeNamespace, name: namespaceFullName.Name, policies: namespaceResp.PolicyList})
+		}
+	}
+
+	return scopes, nil
+}
@@ -0,0 +1,59 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package effectivepolicies
+
+const (
+	ResourceName = "tanzu-mission-control_effective_policies"
+
+	// Target Keys.
+	TargetKey                = "target"
+	ClusterKey               = "cluster"
+	NamespaceKey             = "namespace"
+	NameKey                  = "name"
+	ClusterNameKey           = "cluster_name"
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+
+	// Computed Keys.
+	ClusterGroupNameKey  = "cluster_group_name"
+	WorkspaceNameKey     = "workspace_name"
+	PoliciesKey          = "policies"
+	KindKey              = "kind"
+	SourceScopeKey       = "source_scope"
+	SourceNameKey        = "source_name"
+	RecipeKey            = "recipe"
+	RecipeVersionKey     = "recipe_version"
+	InputKey             = "input"
+	NamespaceSelectorKey = "namespace_selector"
+	ConflictsKey         = "conflicts"
+	PolicyNamesKey       = "policy_names"
+	MessageKey           = "message"
+
+	// Scopes of the hierarchy a policy is inherited from.
+	scopeOrganization = "organization"
+	scopeClusterGroup = "cluster_group"
+	scopeWorkspace    = "workspace"
+	scopeCluster      = "cluster"
+
+	policyTypeSuffix      = "-policy"
+	namespaceNameLabelKey = "kubernetes.io/metadata.name"
+
+	// Label selector operators as defined in API.
+	selectorOperatorIn      = "In"
+	selectorOperatorNotIn   = "NotIn"
+	selectorOperatorExists  = "Exists"
+	selectorOperatorMissing = "DoesNotExist"
+)
+
+// scopeOrder is the order of the scopes of the hierarchy, from the broadest to the narrowest.
+var scopeOrder = map[string]int{
+	scopeOrganization: 0,
+	scopeClusterGroup: 1,
+	scopeWorkspace:    2,
+	scopeCluster:      3,
+}
+
+// singletonKinds are the kinds of the policies which conflict when more than one applies to the same target.
+var singletonKinds = []string{"security", "quota"}
@@ -0,0 +1,364 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package effectivepolicies
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	policyclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/cluster"
+	policyclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/clustergroup"
+	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
+	policyworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/workspace"
+)
+
+func DataSourceEffectivePolicies() *schema.Resource {
+	return &schema.Resource{
+		Schema:      effectivePoliciesSchema,
+		ReadContext: dataSourceEffectivePoliciesRead,
+	}
+}
+
+var targetKeys = []string{
+	fmt.Sprintf("%s.0.%s", TargetKey, ClusterKey),
+	fmt.Sprintf("%s.0.%s", TargetKey, NamespaceKey),
+}
+
+var effectivePoliciesSchema = map[string]*schema.Schema{
+	TargetKey: {
+		Type:        schema.TypeList,
+		Description: "Cluster or namespace to compute the effective policies for.",
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ClusterKey: {
+					Type:         schema.TypeList,
+					Description:  "The policies inherited by a cluster from its organization and cluster group, and the policies of the cluster.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: targetKeys,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							NameKey:                  nameSchema("Name of the cluster."),
+							ManagementClusterNameKey: managementClusterNameSchema,
+							ProvisionerNameKey:       provisionerNameSchema,
+						},
+					},
+				},
+				NamespaceKey: {
+					Type:         schema.TypeList,
+					Description:  "The policies inherited by a namespace from its organization, cluster group, workspace and cluster whose namespace selector matches the namespace.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: targetKeys,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							NameKey:                  nameSchema("Name of the namespace."),
+							ClusterNameKey:           nameSchema("Name of the cluster of the namespace."),
+							ManagementClusterNameKey: managementClusterNameSchema,
+							ProvisionerNameKey:       provisionerNameSchema,
+						},
+					},
+				},
+			},
+		},
+	},
+	ClusterGroupNameKey: computedStringSchema("Name of the cluster group of the cluster."),
+	WorkspaceNameKey:    computedStringSchema("Name of the workspace of the namespace, empty for a cluster target."),
+	PoliciesKey: {
+		Type:        schema.TypeList,
+		Description: "Policies applicable to the target, sorted by kind and from the broadest to the narrowest source scope.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NameKey:          computedStringSchema("Name of the policy."),
+				KindKey:          computedStringSchema("Kind of the policy, such as 'security' or 'quota'."),
+				SourceScopeKey:   computedStringSchema("Scope the policy is inherited from: organization, cluster_group, workspace or cluster."),
+				SourceNameKey:    computedStringSchema("Name of the cluster group, workspace or cluster the policy is attached to, empty for the organization."),
+				RecipeKey:        computedStringSchema("Recipe of the policy."),
+				RecipeVersionKey: computedStringSchema("Version of the recipe of the policy."),
+				InputKey:         computedStringSchema("Input of the recipe of the policy as JSON."),
+				NamespaceSelectorKey: {
+					Type:        schema.TypeBool,
+					Description: "True when the policy only applies to the namespaces matching its namespace selector.",
+					Computed:    true,
+				},
+			},
+		},
+	},
+	ConflictsKey: {
+		Type:        schema.TypeList,
+		Description: "Conflicts between the applicable policies, such as two quota policies.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				KindKey: computedStringSchema("Kind of the conflicting policies."),
+				PolicyNamesKey: {
+					Type:        schema.TypeList,
+					Description: "Conflicting policies, as <source_scope>/<source_name>/<name>.",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				MessageKey: computedStringSchema("Description of the conflict."),
+			},
+		},
+	},
+}
+
+var managementClusterNameSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Name of the management cluster.",
+	Optional:    true,
+	Default:     "attached",
+}
+
+var provisionerNameSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Name of the provisioner.",
+	Optional:    true,
+	Default:     "attached",
+}
+
+func nameSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  description,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+}
+
+func computedStringSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: description,
+		Computed:    true,
+	}
+}
+
+// target is the cluster or namespace the effective policies are computed for.
+type target struct {
+	clusterFullName *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName
+	namespaceName   string
+}
+
+func dataSourceEffectivePoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	t := constructTarget(d)
+	if t == nil {
+		return diag.Errorf("%s must be set", TargetKey)
+	}
+
+	clusterResp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(t.clusterFullName)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Couldn't get cluster %s.", t.clusterFullName.Name))
+	}
+
+	clusterGroupName := ""
+
+	if clusterResp.Cluster != nil && clusterResp.Cluster.Spec != nil {
+		clusterGroupName = clusterResp.Cluster.Spec.ClusterGroupName
+	}
+
+	var (
+		workspaceName   string
+		namespaceLabels map[string]string
+	)
+
+	if t.namespaceName != "" {
+		namespaceResp, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceGet(&namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+			ClusterName:           t.clusterFullName.Name,
+			ManagementClusterName: t.clusterFullName.ManagementClusterName,
+			ProvisionerName:       t.clusterFullName.ProvisionerName,
+			Name:                  t.namespaceName,
+		})
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "Couldn't get namespace %s of cluster %s.", t.namespaceName, t.clusterFullName.Name))
+		}
+
+		if namespaceResp.Namespace != nil {
+			if namespaceResp.Namespace.Spec != nil {
+				workspaceName = namespaceResp.Namespace.Spec.WorkspaceName
+			}
+
+			if namespaceResp.Namespace.Meta != nil {
+				namespaceLabels = namespaceResp.Namespace.Meta.Labels
+			}
+		}
+	}
+
+	policies, err := listInheritedPolicies(config, t.clusterFullName, clusterGroupName, workspaceName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	applicable := applicablePolicies(policies, t.namespaceName, namespaceLabels)
+
+	conflicts, err := findConflicts(applicable)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policiesData, err := flattenPolicies(applicable)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{
+		t.clusterFullName.ManagementClusterName,
+		t.clusterFullName.ProvisionerName,
+		t.clusterFullName.Name,
+		t.namespaceName,
+	}, "/"))
+
+	if err := d.Set(ClusterGroupNameKey, clusterGroupName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(WorkspaceNameKey, workspaceName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(PoliciesKey, policiesData); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(ConflictsKey, flattenConflicts(conflicts)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func constructTarget(d *schema.ResourceData) *target {
+	targetData := firstElement(d.Get(TargetKey))
+
+	if cluster := firstElement(targetData[ClusterKey]); cluster != nil {
+		fullName := &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{}
+		fullName.Name, _ = cluster[NameKey].(string)
+		fullName.ManagementClusterName, _ = cluster[ManagementClusterNameKey].(string)
+		fullName.ProvisionerName, _ = cluster[ProvisionerNameKey].(string)
+
+		return &target{clusterFullName: fullName}
+	}
+
+	if namespace := firstElement(targetData[NamespaceKey]); namespace != nil {
+		fullName := &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{}
+		fullName.Name, _ = namespace[ClusterNameKey].(string)
+		fullName.ManagementClusterName, _ = namespace[ManagementClusterNameKey].(string)
+		fullName.ProvisionerName, _ = namespace[ProvisionerNameKey].(string)
+
+		t := &target{clusterFullName: fullName}
+		t.namespaceName, _ = namespace[NameKey].(string)
+
+		return t
+	}
+
+	return nil
+}
+
+func firstElement(value interface{}) map[string]interface{} {
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	element, _ := data[0].(map[string]interface{})
+
+	return element
+}
+
+// listInheritedPolicies lists the policies of every scope of the hierarchy of the target.
+// The workspace policies are only listed for a namespace target.
+func listInheritedPolicies(config authctx.TanzuContext, clusterFullName *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName, clusterGroupName, workspaceName string) ([]*effectivePolicy, error) {
+	policies := make([]*effectivePolicy, 0)
+
+	orgResp, err := config.TMCConnection.OrganizationPolicyResourceService.ManageV1alpha1OrganizationPolicyResourceServiceList(&policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyFullName{})
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return nil, errors.Wrap(err, "Couldn't list the organization policies.")
+	}
+
+	if err == nil {
+		for _, policy := range orgResp.Policies {
+			if policy == nil || policy.FullName == nil {
+				continue
+			}
+
+			policies = append(policies, &effectivePolicy{name: policy.FullName.Name, sourceScope: scopeOrganization, spec: policy.Spec})
+		}
+	}
+
+	if clusterGroupName != "" {
+		cgResp, err := config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceList(&policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyFullName{
+			ClusterGroupName: clusterGroupName,
+		})
+		if err != nil && !clienterrors.IsNotFoundError(err) {
+			return nil, errors.Wrapf(err, "Couldn't list the policies of cluster group %s.", clusterGroupName)
+		}
+
+		if err == nil {
+			for _, policy := range cgResp.Policies {
+				if policy == nil || policy.FullName == nil {
+					continue
+				}
+
+				policies = append(policies, &effectivePolicy{name: policy.FullName.Name, sourceScope: scopeClusterGroup, sourceName: clusterGroupName, spec: policy.Spec})
+			}
+		}
+	}
+
+	if workspaceName != "" {
+		wsResp, err := config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceList(&policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyFullName{
+			WorkspaceName: workspaceName,
+		})
+		if err != nil && !clienterrors.IsNotFoundError(err) {
+			return nil, errors.Wrapf(err, "Couldn't list the policies of workspace %s.", workspaceName)
+		}
+
+		if err == nil {
+			for _, policy := range wsResp.Policies {
+				if policy == nil || policy.FullName == nil {
+					continue
+				}
+
+				policies = append(policies, &effectivePolicy{name: policy.FullName.Name, sourceScope: scopeWorkspace, sourceName: workspaceName, spec: policy.Spec})
+			}
+		}
+	}
+
+	clusterResp, err := config.TMCConnection.ClusterPolicyResourceService.ManageV1alpha1ClusterPolicyResourceServiceList(&policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyFullName{
+		ClusterName:           clusterFullName.Name,
+		ManagementClusterName: clusterFullName.ManagementClusterName,
+		ProvisionerName:       clusterFullName.ProvisionerName,
+	})
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return nil, errors.Wrapf(err, "Couldn't list the policies of cluster %s.", clusterFullName.Name)
+	}
+
+	if err == nil {
+		for _, policy := range clusterResp.Policies {
+			if policy == nil || policy.FullName == nil {
+				continue
+			}
+
+			policies = append(policies, &effectivePolicy{name: policy.FullName.Name, sourceScope: scopeCluster, sourceName: clusterFullName.Name, spec: policy.Spec})
+		}
+	}
+
+	return policies, nil
+}
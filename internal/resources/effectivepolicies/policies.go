@@ -0,0 +1,266 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package effectivepolicies
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+// effectivePolicy is a policy applicable to the target with the scope it is inherited from.
+type effectivePolicy struct {
+	name        string
+	sourceScope string
+	sourceName  string
+	spec        *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec
+}
+
+// conflict is a set of applicable policies of the same kind which can't all be honoured as written.
+type conflict struct {
+	kind        string
+	policyNames []string
+	message     string
+}
+
+func (p *effectivePolicy) kind() string {
+	if p.spec == nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(p.spec.Type, policyTypeSuffix)
+}
+
+// qualifiedName identifies a policy across the scopes of the hierarchy.
+func (p *effectivePolicy) qualifiedName() string {
+	return fmt.Sprintf("%s/%s/%s", p.sourceScope, p.sourceName, p.name)
+}
+
+// applicablePolicies returns the policies which apply to the target, sorted by kind, from the broadest to the narrowest scope.
+// The namespace selector of a policy is evaluated against the labels of the target namespace, all the policies apply to a cluster.
+func applicablePolicies(policies []*effectivePolicy, namespaceName string, namespaceLabels map[string]string) []*effectivePolicy {
+	applicable := make([]*effectivePolicy, 0, len(policies))
+
+	for _, policy := range policies {
+		if policy == nil || policy.spec == nil {
+			continue
+		}
+
+		if namespaceName != "" && !matchesNamespaceSelector(policy.spec.NamespaceSelector, namespaceName, namespaceLabels) {
+			continue
+		}
+
+		applicable = append(applicable, policy)
+	}
+
+	sort.SliceStable(applicable, func(i, j int) bool {
+		if applicable[i].kind() != applicable[j].kind() {
+			return applicable[i].kind() < applicable[j].kind()
+		}
+
+		if applicable[i].sourceScope != applicable[j].sourceScope {
+			return scopeOrder[applicable[i].sourceScope] < scopeOrder[applicable[j].sourceScope]
+		}
+
+		return applicable[i].qualifiedName() < applicable[j].qualifiedName()
+	})
+
+	return applicable
+}
+
+// matchesNamespaceSelector reports whether the labels of a namespace satisfy all the requirements of a namespace selector.
+// The name of the namespace is matched through the well known kubernetes.io/metadata.name label, a selector which isn't valid matches no namespace.
+func matchesNamespaceSelector(selector *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector, namespaceName string, namespaceLabels map[string]string) bool {
+	if selector == nil {
+		return true
+	}
+
+	namespaceSelector := &metav1.LabelSelector{}
+
+	for _, requirement := range selector.MatchExpressions {
+		if requirement != nil {
+			namespaceSelector.MatchExpressions = append(namespaceSelector.MatchExpressions, metav1.LabelSelectorRequirement{
+				Key:      requirement.Key,
+				Operator: metav1.LabelSelectorOperator(requirement.Operator),
+				Values:   requirement.Values,
+			})
+		}
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+	if err != nil {
+		return false
+	}
+
+	namespace := make(k8slabels.Set, len(namespaceLabels)+1)
+
+	for key, value := range namespaceLabels {
+		namespace[key] = value
+	}
+
+	namespace[namespaceNameLabelKey] = namespaceName
+
+	return labelSelector.Matches(namespace)
+}
+
+// findConflicts returns the conflicts between the applicable policies:
+// more than one policy of a kind which allows a single policy per target, and policies of the same kind and recipe with different inputs.
+func findConflicts(policies []*effectivePolicy) ([]*conflict, error) {
+	conflicts := make([]*conflict, 0)
+	byKind := make(map[string][]*effectivePolicy)
+	kinds := make([]string, 0)
+
+	for _, policy := range policies {
+		if _, ok := byKind[policy.kind()]; !ok {
+			kinds = append(kinds, policy.kind())
+		}
+
+		byKind[policy.kind()] = append(byKind[policy.kind()], policy)
+	}
+
+	sort.Strings(kinds)
+
+	for _, kind := range kinds {
+		kindPolicies := byKind[kind]
+
+		if containsString(singletonKinds, kind) {
+			if len(kindPolicies) > 1 {
+				conflicts = append(conflicts, &conflict{
+					kind:        kind,
+					policyNames: qualifiedNames(kindPolicies),
+					message:     fmt.Sprintf("%d %s policies apply to the target, the most restrictive settings of each are enforced.", len(kindPolicies), kind),
+				})
+			}
+
+			continue
+		}
+
+		recipeConflicts, err := findRecipeConflicts(kind, kindPolicies)
+		if err != nil {
+			return nil, err
+		}
+
+		conflicts = append(conflicts, recipeConflicts...)
+	}
+
+	return conflicts, nil
+}
+
+func findRecipeConflicts(kind string, policies []*effectivePolicy) ([]*conflict, error) {
+	conflicts := make([]*conflict, 0)
+	byRecipe := make(map[string][]*effectivePolicy)
+	inputs := make(map[string]map[string]bool)
+	recipes := make([]string, 0)
+
+	for _, policy := range policies {
+		recipe := policy.spec.Recipe
+
+		if _, ok := byRecipe[recipe]; !ok {
+			recipes = append(recipes, recipe)
+			inputs[recipe] = make(map[string]bool)
+		}
+
+		input, err := marshalInput(policy.spec.Input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Couldn't marshal the input of policy %s.", policy.qualifiedName())
+		}
+
+		byRecipe[recipe] = append(byRecipe[recipe], policy)
+		inputs[recipe][input] = true
+	}
+
+	for _, recipe := range recipes {
+		if len(inputs[recipe]) < 2 {
+			continue
+		}
+
+		conflicts = append(conflicts, &conflict{
+			kind:        kind,
+			policyNames: qualifiedNames(byRecipe[recipe]),
+			message:     fmt.Sprintf("%d %s policies with recipe %s apply to the target with different inputs.", len(byRecipe[recipe]), kind, recipe),
+		})
+	}
+
+	return conflicts, nil
+}
+
+// marshalInput returns the input of a policy as JSON, the keys of the objects are sorted so that equal inputs compare equal.
+func marshalInput(input interface{}) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	inputJSON, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return string(inputJSON), nil
+}
+
+func qualifiedNames(policies []*effectivePolicy) []string {
+	names := make([]string, 0, len(policies))
+
+	for _, policy := range policies {
+		names = append(names, policy.qualifiedName())
+	}
+
+	return names
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenPolicies(policies []*effectivePolicy) ([]interface{}, error) {
+	data := make([]interface{}, 0, len(policies))
+
+	for _, policy := range policies {
+		input, err := marshalInput(policy.spec.Input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Couldn't marshal the input of policy %s.", policy.qualifiedName())
+		}
+
+		data = append(data, map[string]interface{}{
+			NameKey:              policy.name,
+			KindKey:              policy.kind(),
+			SourceScopeKey:       policy.sourceScope,
+			SourceNameKey:        policy.sourceName,
+			RecipeKey:            policy.spec.Recipe,
+			RecipeVersionKey:     policy.spec.RecipeVersion,
+			InputKey:             input,
+			NamespaceSelectorKey: policy.spec.NamespaceSelector != nil && len(policy.spec.NamespaceSelector.MatchExpressions) > 0,
+		})
+	}
+
+	return data, nil
+}
+
+func flattenConflicts(conflicts []*conflict) []interface{} {
+	data := make([]interface{}, 0, len(conflicts))
+
+	for _, c := range conflicts {
+		data = append(data, map[string]interface{}{
+			KindKey:        c.kind,
+			PolicyNamesKey: c.policyNames,
+			MessageKey:     c.message,
+		})
+	}
+
+	return data
+}
@@ -0,0 +1,160 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package effectivepolicies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+func newTestPolicy(name, sourceScope, sourceName, policyType, recipe string, input interface{}, selector ...*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement) *effectivePolicy {
+	spec := &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+		Type:   policyType,
+		Recipe: recipe,
+		Input:  input,
+	}
+
+	if len(selector) > 0 {
+		spec.NamespaceSelector = &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{MatchExpressions: selector}
+	}
+
+	return &effectivePolicy{name: name, sourceScope: sourceScope, sourceName: sourceName, spec: spec}
+}
+
+func TestMatchesNamespaceSelector(t *testing.T) {
+	labels := map[string]string{"env": "prod", "team": "payments"}
+
+	cases := []struct {
+		name        string
+		requirement *policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement
+		expected    bool
+	}{
+		{
+			name:        "in",
+			requirement: &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{Key: "env", Operator: selectorOperatorIn, Values: []string{"prod", "staging"}},
+			expected:    true,
+		},
+		{
+			name:        "in without the label",
+			requirement: &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{Key: "tier", Operator: selectorOperatorIn, Values: []string{"web"}},
+			expected:    false,
+		},
+		{
+			name:        "not in",
+			requirement: &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{Key: "env", Operator: selectorOperatorNotIn, Values: []string{"prod"}},
+			expected:    false,
+		},
+		{
+			name:        "not in without the label",
+			requirement: &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{Key: "tier", Operator: selectorOperatorNotIn, Values: []string{"web"}},
+			expected:    true,
+		},
+		{
+			name:        "exists",
+			requirement: &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{Key: "team", Operator: selectorOperatorExists},
+			expected:    true,
+		},
+		{
+			name:        "does not exist",
+			requirement: &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{Key: "team", Operator: selectorOperatorMissing},
+			expected:    false,
+		},
+		{
+			name:        "namespace name",
+			requirement: &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{Key: namespaceNameLabelKey, Operator: selectorOperatorIn, Values: []string{"payments"}},
+			expected:    true,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			selector := &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+				MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{test.requirement},
+			}
+
+			require.Equal(t, test.expected, matchesNamespaceSelector(selector, "payments", labels))
+		})
+	}
+
+	require.True(t, matchesNamespaceSelector(nil, "payments", labels))
+}
+
+func TestApplicablePolicies(t *testing.T) {
+	policies := []*effectivePolicy{
+		newTestPolicy("cluster-quota", scopeCluster, "c1", "quota-policy", "small", nil),
+		newTestPolicy("org-security", scopeOrganization, "", "security-policy", "baseline", nil),
+		newTestPolicy("cg-quota", scopeClusterGroup, "cg1", "quota-policy", "medium", nil),
+		newTestPolicy("ws-quota", scopeWorkspace, "ws1", "quota-policy", "large", nil,
+			&policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{Key: "env", Operator: selectorOperatorIn, Values: []string{"dev"}}),
+	}
+
+	applicable := applicablePolicies(policies, "", nil)
+	require.Equal(t, []string{
+		"cluster_group/cg1/cg-quota",
+		"workspace/ws1/ws-quota",
+		"cluster/c1/cluster-quota",
+		"organization//org-security",
+	}, qualifiedNames(applicable))
+
+	applicable = applicablePolicies(policies, "payments", map[string]string{"env": "prod"})
+	require.Equal(t, []string{
+		"cluster_group/cg1/cg-quota",
+		"cluster/c1/cluster-quota",
+		"organization//org-security",
+	}, qualifiedNames(applicable))
+}
+
+func TestFindConflicts(t *testing.T) {
+	policies := applicablePolicies([]*effectivePolicy{
+		newTestPolicy("org-security", scopeOrganization, "", "security-policy", "baseline", nil),
+		newTestPolicy("cg-quota", scopeClusterGroup, "cg1", "quota-policy", "small", nil),
+		newTestPolicy("cluster-quota", scopeCluster, "c1", "quota-policy", "medium", nil),
+		newTestPolicy("org-registry", scopeOrganization, "", "image-policy", "allowed-name-tag",
+			map[string]interface{}{"rules": []interface{}{map[string]interface{}{"imageName": "nginx"}}}),
+		newTestPolicy("cg-registry", scopeClusterGroup, "cg1", "image-policy", "allowed-name-tag",
+			map[string]interface{}{"rules": []interface{}{map[string]interface{}{"imageName": "redis"}}}),
+		newTestPolicy("org-network", scopeOrganization, "", "network-policy", "deny-all", nil),
+		newTestPolicy("cluster-network", scopeCluster, "c1", "network-policy", "deny-all", nil),
+	}, "", nil)
+
+	conflicts, err := findConflicts(policies)
+	require.NoError(t, err)
+	require.Equal(t, flattenConflicts([]*conflict{
+		{
+			kind:        "image",
+			policyNames: []string{"organization//org-registry", "cluster_group/cg1/cg-registry"},
+			message:     "2 image policies with recipe allowed-name-tag apply to the target with different inputs.",
+		},
+		{
+			kind:        "quota",
+			policyNames: []string{"cluster_group/cg1/cg-quota", "cluster/c1/cluster-quota"},
+			message:     "2 quota policies apply to the target, the most restrictive settings of each are enforced.",
+		},
+	}), flattenConflicts(conflicts))
+}
+
+func TestFlattenPolicies(t *testing.T) {
+	data, err := flattenPolicies([]*effectivePolicy{
+		newTestPolicy("cg-quota", scopeClusterGroup, "cg1", "quota-policy", "custom",
+			map[string]interface{}{"requestsCpu": "2", "limitsCpu": "4"},
+			&policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{Key: "env", Operator: selectorOperatorExists}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			NameKey:              "cg-quota",
+			KindKey:              "quota",
+			SourceScopeKey:       scopeClusterGroup,
+			SourceNameKey:        "cg1",
+			RecipeKey:            "custom",
+			RecipeVersionKey:     "",
+			InputKey:             `{"limitsCpu":"4","requestsCpu":"2"}`,
+			NamespaceSelectorKey: true,
+		},
+	}, data)
+}
@@ -9,60 +9,45 @@ import (
 	"fmt"
 	"strings"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
 	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
 	continuousdeliveryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/clustergroup"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery"
+	continuousdeliveryscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/gitrepository/scope"
 )
 
-func enableContinuousDelivery(config *authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) error {
+// enableContinuousDelivery enables continuous delivery for the scope when it is not enabled yet and
+// reports whether it had to do so, in which case the caller should surface continuousdelivery.ImplicitEnablementWarning.
+func enableContinuousDelivery(config *authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) (bool, error) {
 	if config == nil || scopedFullnameData == nil || meta == nil {
-		return errors.New("missing variables: error while enabling Tanzu Mission Control cluster continuous delivery feature")
+		return false, errors.New("missing variables: error while enabling Tanzu Mission Control cluster continuous delivery feature")
+	}
+
+	continuousDeliveryScopedFullname := &continuousdeliveryscope.ScopedFullname{
+		Scope: scopedFullnameData.Scope,
 	}
 
 	switch scopedFullnameData.Scope {
 	case commonscope.ClusterScope:
 		if scopedFullnameData.FullnameCluster != nil {
-			continuousDeliveryReq := &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryContinuousDeliveryRequest{
-				ContinuousDelivery: &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryContinuousDelivery{
-					FullName: &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName{
-						ClusterName:           scopedFullnameData.FullnameCluster.ClusterName,
-						ManagementClusterName: scopedFullnameData.FullnameCluster.ManagementClusterName,
-						ProvisionerName:       scopedFullnameData.FullnameCluster.ProvisionerName,
-					},
-					Meta: meta,
-				},
-			}
-
-			_, err := config.TMCConnection.ClusterContinuousDeliveryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryResourceServiceCreate(continuousDeliveryReq)
-			if err != nil && status.Code(err) != codes.AlreadyExists {
-				return err
+			continuousDeliveryScopedFullname.FullnameCluster = &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName{
+				ClusterName:           scopedFullnameData.FullnameCluster.ClusterName,
+				ManagementClusterName: scopedFullnameData.FullnameCluster.ManagementClusterName,
+				ProvisionerName:       scopedFullnameData.FullnameCluster.ProvisionerName,
 			}
 		}
 	case commonscope.ClusterGroupScope:
 		if scopedFullnameData.FullnameClusterGroup != nil {
-			continuousDeliveryReq := &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryContinuousDeliveryRequest{
-				ContinuousDelivery: &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryContinuousDelivery{
-					FullName: &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryFullName{
-						ClusterGroupName: scopedFullnameData.FullnameClusterGroup.ClusterGroupName,
-					},
-					Meta: meta,
-				},
-			}
-
-			_, err := config.TMCConnection.ClusterGroupContinuousDeliveryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryResourceServiceCreate(continuousDeliveryReq)
-			if err != nil && status.Code(err) != codes.AlreadyExists {
-				return err
+			continuousDeliveryScopedFullname.FullnameClusterGroup = &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryFullName{
+				ClusterGroupName: scopedFullnameData.FullnameClusterGroup.ClusterGroupName,
 			}
 		}
 	case commonscope.UnknownScope:
-		return fmt.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema", strings.Join(scope.ScopesAllowed[:], `, `))
+		return false, fmt.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
 
-	return nil
+	return continuousdelivery.EnableIfMissing(config, continuousDeliveryScopedFullname, meta)
 }
@@ -20,7 +20,9 @@ import (
 	gitrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/clustergroup"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/readiness"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/gitrepository/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/gitrepository/spec"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/gitrepository/status"
@@ -68,6 +70,10 @@ func getGitRepositorySchema(isDataSource bool) map[string]*schema.Schema {
 		}
 	}
 
+	if !isDataSource {
+		gitRepositorySchema[readiness.WaitForReadyKey] = readiness.WaitForReadySchema
+	}
+
 	return gitRepositorySchema
 }
 
@@ -95,11 +101,15 @@ func resourceGitRepositoryCreate(ctx context.Context, d *schema.ResourceData, m
 		meta = common.ConstructMeta(d)
 	)
 
-	err := enableContinuousDelivery(&config, scopedFullnameData, meta)
+	implicitlyEnabled, err := enableContinuousDelivery(&config, scopedFullnameData, meta)
 	if err != nil {
 		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control git repository entry, name : %s", gitRepositoryName))
 	}
 
+	if implicitlyEnabled {
+		diags = append(diags, continuousdelivery.ImplicitEnablementWarning(ResourceName))
+	}
+
 	switch scopedFullnameData.Scope {
 	case commonscope.ClusterScope:
 		if scopedFullnameData.FullnameCluster != nil {
@@ -142,7 +152,12 @@ func resourceGitRepositoryCreate(ctx context.Context, d *schema.ResourceData, m
 	// always run
 	d.SetId(UID)
 
-	return dataSourceGitRepositoryRead(ctx, d, m)
+	if err := waitForReady(config, d, scopedFullnameData); err != nil {
+		diags = append(diags, dataSourceGitRepositoryRead(ctx, d, m)...)
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, dataSourceGitRepositoryRead(ctx, d, m)...)
 }
 
 func resourceGitRepositoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -268,6 +283,10 @@ func resourceGitRepositoryInPlaceUpdate(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[INFO] git repository update successful")
 
+	if err := waitForReady(config, d, scopedFullnameData); err != nil {
+		return append(dataSourceGitRepositoryRead(ctx, d, m), diag.FromErr(err)...)
+	}
+
 	return dataSourceGitRepositoryRead(ctx, d, m)
 }
 
@@ -0,0 +1,54 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package gitrepository
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/readiness"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/gitrepository/scope"
+)
+
+// waitForReady waits until the git repository is reconciled by Flux when wait_for_ready is set.
+func waitForReady(config authctx.TanzuContext, d *schema.ResourceData, scopedFullnameData *scope.ScopedFullname) error {
+	return readiness.WaitForReady(d, scopedFullnameData.Scope, fmt.Sprintf("git repository %s", d.Get(nameKey)), &readiness.StatusFuncs{
+		Cluster: func() (*readiness.ClusterStatus, error) {
+			resp, err := config.TMCConnection.ClusterGitRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdGitrepositoryResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.GitRepository.Status == nil {
+				return nil, nil
+			}
+
+			return &readiness.ClusterStatus{
+				Meta:               resp.GitRepository.Meta,
+				ObservedGeneration: resp.GitRepository.Status.ObservedGeneration,
+				State:              readiness.ConditionState(resp.GitRepository.Status.Conditions),
+			}, nil
+		},
+		ClusterGroup: func() (*readiness.BatchStatus, error) {
+			resp, err := config.TMCConnection.ClusterGroupGitRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdGitrepositoryResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.GitRepository.Status == nil {
+				return nil, nil
+			}
+
+			return &readiness.BatchStatus{
+				Meta:               resp.GitRepository.Meta,
+				ObservedGeneration: resp.GitRepository.Status.ObservedGeneration,
+				Phase:              resp.GitRepository.Status.Phase,
+				Details:            resp.GitRepository.Status.Details,
+			}, nil
+		},
+	})
+}
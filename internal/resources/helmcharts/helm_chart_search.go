@@ -0,0 +1,95 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmcharts
+
+import (
+	"log"
+	"sort"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	chartsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmcharts"
+)
+
+// chartQuery is the query of the helm chart search data source.
+type chartQuery struct {
+	nameContains       string
+	versionConstraints string
+	kubeVersion        string
+	includeDeprecated  bool
+}
+
+// searchCharts returns the chart versions matching the query, best match first.
+// The best match is the newest version, ties between charts and repositories are broken by name.
+func searchCharts(charts []*chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChart, query *chartQuery) (
+	[]*chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChart, error) {
+	versionConstraints, err := helper.ParseHelmConstraints(query.versionConstraints)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.kubeVersion != "" {
+		if _, err := goversion.NewVersion(query.kubeVersion); err != nil {
+			return nil, errors.Wrapf(err, "invalid kube_version %q", query.kubeVersion)
+		}
+	}
+
+	nameContains := strings.ToLower(query.nameContains)
+	matching := make([]*chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChart, 0, len(charts))
+
+	for _, chart := range charts {
+		if chart == nil || chart.FullName == nil {
+			continue
+		}
+
+		switch {
+		case !strings.Contains(strings.ToLower(chart.FullName.ChartMetadataName), nameContains):
+			continue
+		case !versionConstraints.Check(chart.FullName.Name):
+			continue
+		case chart.Spec != nil && chart.Spec.Deprecated && !query.includeDeprecated:
+			continue
+		case query.kubeVersion != "" && !isKubeVersionCompatible(chart, query.kubeVersion):
+			continue
+		}
+
+		matching = append(matching, chart)
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		a, b := matching[i].FullName, matching[j].FullName
+
+		if result := helper.CompareVersions(a.Name, b.Name); result != 0 {
+			return result > 0
+		}
+
+		if a.ChartMetadataName != b.ChartMetadataName {
+			return a.ChartMetadataName < b.ChartMetadataName
+		}
+
+		return a.RepositoryName < b.RepositoryName
+	})
+
+	return matching, nil
+}
+
+// isKubeVersionCompatible checks the Kubernetes version against the kubeVersion constraints of the chart, caret and tilde ranges included.
+// A chart without constraints is compatible with any version, a chart with constraints which can't be parsed is excluded.
+func isKubeVersionCompatible(chart *chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChart, kubeVersion string) bool {
+	if chart.Spec == nil || chart.Spec.KubeVersion == "" {
+		return true
+	}
+
+	constraints, err := helper.ParseHelmConstraints(chart.Spec.KubeVersion)
+	if err != nil {
+		log.Printf("[WARN] excluding version %s of helm chart %s: %v", chart.FullName.Name, chart.FullName.ChartMetadataName, err)
+		return false
+	}
+
+	return constraints.Check(kubeVersion)
+}
@@ -0,0 +1,164 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmcharts
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	chartsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmcharts"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmcharts/spec"
+)
+
+const (
+	SearchResourceName = "tanzu-mission-control_helm_chart_search"
+
+	NameContainsKey       = "name_contains"
+	VersionConstraintsKey = "version_constraints"
+	KubeVersionKey        = "kube_version"
+	IncludeDeprecatedKey  = "include_deprecated"
+	BestMatchKey          = "best_match"
+	VersionKey            = "version"
+	AppVersionKey         = "app_version"
+)
+
+func DataSourceHelmChartSearch() *schema.Resource {
+	return &schema.Resource{
+		Schema:      helmChartSearchSchema,
+		ReadContext: dataSourceHelmChartSearchRead,
+	}
+}
+
+var helmChartSearchSchema = map[string]*schema.Schema{
+	ChartMetadataNameKey: {
+		Type:        schema.TypeString,
+		Description: "Exact name of the helm chart. All the charts are searched by default.",
+		Optional:    true,
+		Default:     "*",
+	},
+	NameContainsKey: {
+		Type:        schema.TypeString,
+		Description: "Case insensitive substring of the name of the helm chart.",
+		Optional:    true,
+	},
+	RepositoryNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of helm repository. All the repositories are searched by default.",
+		Optional:    true,
+		Default:     "*",
+	},
+	VersionConstraintsKey: {
+		Type:        schema.TypeString,
+		Description: "Semver constraints the chart version has to satisfy in the format of helm, such as '1.x', '^1.2.0', '~1.2' or '>=1.2.0 <2.0.0'. Any version matches by default.",
+		Optional:    true,
+	},
+	KubeVersionKey: {
+		Type:        schema.TypeString,
+		Description: "Kubernetes version of the target cluster, such as '1.28.3'. Only the chart versions whose kubeVersion constraints are satisfied by this version match.",
+		Optional:    true,
+	},
+	IncludeDeprecatedKey: {
+		Type:        schema.TypeBool,
+		Description: "Include the deprecated chart versions in the search.",
+		Optional:    true,
+		Default:     false,
+	},
+	BestMatchKey: {
+		Type:        schema.TypeList,
+		Description: "Newest chart version matching the search.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ChartMetadataNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the helm chart.",
+					Computed:    true,
+				},
+				RepositoryNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the helm repository of the chart.",
+					Computed:    true,
+				},
+				VersionKey: {
+					Type:        schema.TypeString,
+					Description: "Version of the helm chart.",
+					Computed:    true,
+				},
+				AppVersionKey: {
+					Type:        schema.TypeString,
+					Description: "Application version of the chart.",
+					Computed:    true,
+				},
+				KubeVersionKey: {
+					Type:        schema.TypeString,
+					Description: "A SemVer range of compatible Kubernetes versions.",
+					Computed:    true,
+				},
+			},
+		},
+	},
+	spec.ChartsKey: spec.Charts,
+}
+
+func dataSourceHelmChartSearchRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	metadataName, _ := d.Get(ChartMetadataNameKey).(string)
+	repositoryName, _ := d.Get(RepositoryNameKey).(string)
+
+	query := &chartQuery{}
+	query.nameContains, _ = d.Get(NameContainsKey).(string)
+	query.versionConstraints, _ = d.Get(VersionConstraintsKey).(string)
+	query.kubeVersion, _ = d.Get(KubeVersionKey).(string)
+	query.includeDeprecated, _ = d.Get(IncludeDeprecatedKey).(bool)
+
+	resp, err := config.TMCConnection.OrganizationHelmChartsResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdChartResourceServiceList(&chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChartSearchScope{
+		Name:              "*",
+		ChartMetadataName: metadataName,
+		RepositoryName:    repositoryName,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	charts, err := searchCharts(resp.Charts, query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(charts) == 0 {
+		return diag.Errorf("No Tanzu Mission Control Helm Chart matches the search, chart metadata name : %s, name contains : %s, repository name : %s, version constraints : %s, kube version : %s",
+			metadataName, query.nameContains, repositoryName, query.versionConstraints, query.kubeVersion)
+	}
+
+	best := charts[0]
+
+	d.SetId(strings.Join([]string{best.FullName.RepositoryName, best.FullName.ChartMetadataName, best.FullName.Name}, "/"))
+
+	bestMatch := map[string]interface{}{
+		ChartMetadataNameKey: best.FullName.ChartMetadataName,
+		RepositoryNameKey:    best.FullName.RepositoryName,
+		VersionKey:           best.FullName.Name,
+	}
+
+	if best.Spec != nil {
+		bestMatch[AppVersionKey] = best.Spec.AppVersion
+		bestMatch[KubeVersionKey] = best.Spec.KubeVersion
+	}
+
+	if err := d.Set(BestMatchKey, []interface{}{bestMatch}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(spec.ChartsKey, spec.FlattenCharts(&chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChartListResponse{Charts: charts})); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,127 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmcharts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chartsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmcharts"
+)
+
+func testChart(repositoryName, chartMetadataName, version, kubeVersion string, deprecated bool) *chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChart {
+	return &chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChart{
+		FullName: &chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChartFullName{
+			ChartMetadataName: chartMetadataName,
+			Name:              version,
+			RepositoryName:    repositoryName,
+		},
+		Spec: &chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChartSpec{
+			KubeVersion: kubeVersion,
+			Deprecated:  deprecated,
+		},
+	}
+}
+
+var testCharts = []*chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChart{
+	testChart("bitnami", "nginx", "15.0.0", ">=1.19.0-0", false),
+	testChart("bitnami", "nginx", "16.0.0", ">=1.27.0-0", false),
+	testChart("bitnami", "nginx", "16.1.0", "", true),
+	testChart("bitnami", "nginx-ingress-controller", "9.3.0", "^1.23.0-0", false),
+	testChart("mirror", "nginx", "16.0.0", ">=1.27.0-0", false),
+	testChart("bitnami", "redis", "18.2.0", "~1.25", false),
+	testChart("bitnami", "legacy", "1.0.0", "not a version", false),
+}
+
+func chartNames(charts []*chartsmodel.VmwareTanzuManageV1alpha1OrganizationFluxcdHelmRepositoryChartmetadataChart) []string {
+	names := make([]string, 0, len(charts))
+
+	for _, chart := range charts {
+		names = append(names, chart.FullName.RepositoryName+"/"+chart.FullName.ChartMetadataName+":"+chart.FullName.Name)
+	}
+
+	return names
+}
+
+func TestSearchCharts(t *testing.T) {
+	cases := []struct {
+		name        string
+		query       *chartQuery
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:  "name substring",
+			query: &chartQuery{nameContains: "NGINX"},
+			expected: []string{
+				"bitnami/nginx:16.0.0", "mirror/nginx:16.0.0", "bitnami/nginx:15.0.0", "bitnami/nginx-ingress-controller:9.3.0",
+			},
+		},
+		{
+			name:     "version constraints",
+			query:    &chartQuery{nameContains: "nginx", versionConstraints: "<16.0.0"},
+			expected: []string{"bitnami/nginx:15.0.0", "bitnami/nginx-ingress-controller:9.3.0"},
+		},
+		{
+			name:     "caret version constraints",
+			query:    &chartQuery{nameContains: "nginx", versionConstraints: "^15.0.0"},
+			expected: []string{"bitnami/nginx:15.0.0"},
+		},
+		{
+			name:     "tilde version constraints",
+			query:    &chartQuery{versionConstraints: "~9.3"},
+			expected: []string{"bitnami/nginx-ingress-controller:9.3.0"},
+		},
+		{
+			name:     "deprecated versions included",
+			query:    &chartQuery{nameContains: "nginx", versionConstraints: ">=16.0.0", includeDeprecated: true},
+			expected: []string{"bitnami/nginx:16.1.0", "bitnami/nginx:16.0.0", "mirror/nginx:16.0.0"},
+		},
+		{
+			name:     "kube version",
+			query:    &chartQuery{nameContains: "nginx", kubeVersion: "v1.24.9+vmware.1"},
+			expected: []string{"bitnami/nginx:15.0.0", "bitnami/nginx-ingress-controller:9.3.0"},
+		},
+		{
+			name:     "kube version outside the ranges",
+			query:    &chartQuery{kubeVersion: "1.26.1"},
+			expected: []string{"bitnami/nginx:15.0.0", "bitnami/nginx-ingress-controller:9.3.0"},
+		},
+		{
+			name:     "kube version within a tilde range",
+			query:    &chartQuery{nameContains: "redis", kubeVersion: "1.25.4"},
+			expected: []string{"bitnami/redis:18.2.0"},
+		},
+		{
+			name:     "no match",
+			query:    &chartQuery{nameContains: "postgresql"},
+			expected: []string{},
+		},
+		{
+			name:        "invalid version constraints",
+			query:       &chartQuery{versionConstraints: ">=latest"},
+			expectError: true,
+		},
+		{
+			name:        "invalid kube version",
+			query:       &chartQuery{kubeVersion: "latest"},
+			expectError: true,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := searchCharts(testCharts, test.query)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, chartNames(actual))
+		})
+	}
+}
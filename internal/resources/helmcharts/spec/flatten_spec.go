@@ -57,6 +57,7 @@ func FlattenCharts(resp *helmchart.VmwareTanzuManageV1alpha1OrganizationFluxcdHe
 
 		data[nameKey] = chart.FullName.Name
 		data[chartMetadataNameKey] = chart.FullName.ChartMetadataName
+		data[repositoryNameKey] = chart.FullName.RepositoryName
 		data[SpecKey] = val
 
 		charts = append(charts, data)
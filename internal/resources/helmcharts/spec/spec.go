@@ -31,6 +31,7 @@ const (
 
 	nameKey              = "name"
 	chartMetadataNameKey = "chart_metadata_name"
+	repositoryNameKey    = "repository_name"
 )
 
 var Charts = &schema.Schema{
@@ -49,6 +50,11 @@ var Charts = &schema.Schema{
 				Computed:    true,
 				Description: "Name of the helm chart.",
 			},
+			repositoryNameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the helm repository of the chart.",
+			},
 			SpecKey: specSchema,
 		},
 	},
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 
@@ -22,6 +23,7 @@ import (
 	releaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/readiness"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/spec"
@@ -45,7 +47,10 @@ func ResourceHelmRelease() *schema.Resource {
 		ReadContext:   dataSourceHelmReleaseRead,
 		UpdateContext: resourceHelmReleaseInPlaceUpdate,
 		DeleteContext: resourceHelmReleaseDelete,
-		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+		CustomizeDiff: customdiff.All(
+			schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+			validateRollback,
+		),
 	}
 }
 
@@ -85,6 +90,10 @@ func getHelmReleaseSchema(isDataSource bool) map[string]*schema.Schema {
 		}
 	}
 
+	if !isDataSource {
+		helmReleaseSchema[readiness.WaitForReadyKey] = readiness.WaitForReadySchema
+	}
+
 	return helmReleaseSchema
 }
 
@@ -169,6 +178,10 @@ func resourceHelmReleaseCreate(ctx context.Context, d *schema.ResourceData, m in
 	// always run
 	d.SetId(UID)
 
+	if err := waitForReady(config, d, scopedFullnameData); err != nil {
+		return append(dataSourceHelmReleaseRead(ctx, d, m), diag.FromErr(err)...)
+	}
+
 	return dataSourceHelmReleaseRead(ctx, d, m)
 }
 
@@ -307,6 +320,10 @@ func resourceHelmReleaseInPlaceUpdate(ctx context.Context, d *schema.ResourceDat
 
 	log.Printf("[INFO] helm release update successful")
 
+	if err := waitForReady(config, d, scopedFullnameData); err != nil {
+		return append(dataSourceHelmReleaseRead(ctx, d, m), diag.FromErr(err)...)
+	}
+
 	return dataSourceHelmReleaseRead(ctx, d, m)
 }
 
@@ -357,6 +374,8 @@ func updateCheckForSpec(d *schema.ResourceData, atomicSpec *releaseclustermodel.
 	atomicSpec.InlineConfiguration = helmreleaseSpec.InlineConfiguration
 	atomicSpec.Interval = helmreleaseSpec.Interval
 	atomicSpec.TargetNamespace = helmreleaseSpec.TargetNamespace
+	atomicSpec.Suspend = helmreleaseSpec.Suspend
+	atomicSpec.RollbackToRevision = helmreleaseSpec.RollbackToRevision
 	atomicSpec.ChartRef.Chart = helmreleaseSpec.ChartRef.Chart
 	atomicSpec.ChartRef.RepositoryName = helmreleaseSpec.ChartRef.RepositoryName
 	atomicSpec.ChartRef.RepositoryNamespace = helmreleaseSpec.ChartRef.RepositoryNamespace
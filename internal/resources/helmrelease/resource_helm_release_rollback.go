@@ -0,0 +1,63 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrelease
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/spec"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/status"
+)
+
+var (
+	rollbackToRevisionPath = helper.GetFirstElementOf(spec.SpecKey, spec.RollbackToRevisionKey)
+	clusterGroupScopePath  = helper.GetFirstElementOf(commonscope.ScopeKey, commonscope.ClusterGroupKey)
+	historyPath            = helper.GetFirstElementOf(status.StatusKey, status.HistoryKey)
+)
+
+// validateRollback validates the revision the helm release is rolled back to against the release history known from the last read.
+// The revisions of a cluster group helm release differ between the member clusters, so rollback is only supported for cluster scope.
+func validateRollback(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.HasChange(rollbackToRevisionPath) || !diff.NewValueKnown(rollbackToRevisionPath) {
+		return nil
+	}
+
+	revision, _ := diff.Get(rollbackToRevisionPath).(int)
+
+	if revision == 0 {
+		return nil
+	}
+
+	if clusterGroup, ok := diff.Get(clusterGroupScopePath).([]interface{}); ok && len(clusterGroup) > 0 {
+		return errors.Errorf("%s is only supported for cluster scope helm releases", spec.RollbackToRevisionKey)
+	}
+
+	// The release history is unknown until the helm release is created.
+	history, _ := diff.Get(historyPath).([]interface{})
+
+	if len(history) == 0 {
+		return nil
+	}
+
+	revisions := make([]int, 0, len(history))
+
+	for _, snapshot := range history {
+		snapshotData, _ := snapshot.(map[string]interface{})
+		historyRevision, _ := snapshotData[status.RevisionKey].(int)
+
+		if historyRevision == revision {
+			return nil
+		}
+
+		revisions = append(revisions, historyRevision)
+	}
+
+	return errors.Errorf("revision %d is not in the release history of the helm release, available revisions: %v", revision, revisions)
+}
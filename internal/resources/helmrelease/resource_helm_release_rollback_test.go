@@ -0,0 +1,153 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrelease
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/spec"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/status"
+)
+
+const testRevisionStatusKey = "status"
+
+var testRollbackResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		commonscope.ScopeKey: {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					commonscope.ClusterKey:      {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					commonscope.ClusterGroupKey: {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+			},
+		},
+		spec.SpecKey: {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					spec.RollbackToRevisionKey: {Type: schema.TypeInt, Optional: true},
+				},
+			},
+		},
+		status.StatusKey: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					status.HistoryKey: {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								status.RevisionKey:    {Type: schema.TypeInt, Computed: true},
+								testRevisionStatusKey: {Type: schema.TypeString, Computed: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	CustomizeDiff: validateRollback,
+}
+
+// testRollbackState is the state of a cluster helm release with revision 3 deployed, rolled back to rollbackToRevision.
+func testRollbackState(rollbackToRevision int) *terraform.InstanceState {
+	attributes := map[string]string{
+		"scope.#":                     "1",
+		"scope.0.cluster.#":           "1",
+		"scope.0.cluster.0":           "c1",
+		"spec.#":                      "1",
+		"spec.0.rollback_to_revision": strconv.Itoa(rollbackToRevision),
+		"status.#":                    "1",
+		"status.0.history.#":          "3",
+		"status.0.history.0.revision": "3",
+		"status.0.history.0.status":   "deployed",
+		"status.0.history.1.revision": "2",
+		"status.0.history.1.status":   "superseded",
+		"status.0.history.2.revision": "1",
+		"status.0.history.2.status":   "superseded",
+	}
+
+	return &terraform.InstanceState{ID: "hr1", Attributes: attributes}
+}
+
+func testRollbackConfig(scopeKey string, rollbackToRevision int) *terraform.ResourceConfig {
+	return terraform.NewResourceConfigRaw(map[string]interface{}{
+		commonscope.ScopeKey: []interface{}{
+			map[string]interface{}{scopeKey: []interface{}{"target"}},
+		},
+		spec.SpecKey: []interface{}{
+			map[string]interface{}{spec.RollbackToRevisionKey: rollbackToRevision},
+		},
+	})
+}
+
+func TestValidateRollback(t *testing.T) {
+	cases := []struct {
+		description   string
+		state         *terraform.InstanceState
+		config        *terraform.ResourceConfig
+		expectedError string
+	}{
+		{
+			description: "valid target revision",
+			state:       testRollbackState(0),
+			config:      testRollbackConfig(commonscope.ClusterKey, 2),
+		},
+		{
+			description: "current revision",
+			state:       testRollbackState(0),
+			config:      testRollbackConfig(commonscope.ClusterKey, 3),
+		},
+		{
+			description:   "missing revision",
+			state:         testRollbackState(0),
+			config:        testRollbackConfig(commonscope.ClusterKey, 7),
+			expectedError: "revision 7 is not in the release history of the helm release, available revisions: [3 2 1]",
+		},
+		{
+			description: "unchanged missing revision",
+			state:       testRollbackState(7),
+			config:      testRollbackConfig(commonscope.ClusterKey, 7),
+		},
+		{
+			description: "new helm release without release history",
+			state:       nil,
+			config:      testRollbackConfig(commonscope.ClusterKey, 2),
+		},
+		{
+			description:   "cluster group helm release",
+			state:         testRollbackState(0),
+			config:        testRollbackConfig(commonscope.ClusterGroupKey, 2),
+			expectedError: "rollback_to_revision is only supported for cluster scope helm releases",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			_, err := testRollbackResource.Diff(context.Background(), test.state, test.config, nil)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrelease
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/readiness"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/scope"
+)
+
+// waitForReady waits until the helm release is reconciled by Flux when wait_for_ready is set.
+func waitForReady(config authctx.TanzuContext, d *schema.ResourceData, scopedFullnameData *scope.ScopedFullname) error {
+	return readiness.WaitForReady(d, scopedFullnameData.Scope, fmt.Sprintf("helm release %s", d.Get(nameKey)), &readiness.StatusFuncs{
+		Cluster: func() (*readiness.ClusterStatus, error) {
+			resp, err := config.TMCConnection.ClusterHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClusterReleaseResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.Release.Status == nil {
+				return nil, nil
+			}
+
+			return &readiness.ClusterStatus{
+				Meta:               resp.Release.Meta,
+				ObservedGeneration: resp.Release.Status.ObservedGeneration,
+				State:              readiness.ConditionState(resp.Release.Status.Conditions),
+			}, nil
+		},
+		ClusterGroup: func() (*readiness.BatchStatus, error) {
+			resp, err := config.TMCConnection.ClusterGroupHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClustergroupReleaseResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.Release.Status == nil {
+				return nil, nil
+			}
+
+			return &readiness.BatchStatus{
+				Meta:               resp.Release.Meta,
+				ObservedGeneration: resp.Release.Status.ObservedGeneration,
+				Phase:              resp.Release.Status.Phase,
+				Details:            resp.Release.Status.Details,
+			}, nil
+		},
+	})
+}
@@ -49,6 +49,14 @@ func ConstructSpecForClusterScope(d *schema.ResourceData) (spec *releaseclusterm
 		helper.SetPrimitiveValue(intervalValue, &spec.Interval, IntervalKey)
 	}
 
+	if suspend, ok := specData[SuspendKey]; ok {
+		helper.SetPrimitiveValue(suspend, &spec.Suspend, SuspendKey)
+	}
+
+	if revision, ok := specData[RollbackToRevisionKey]; ok {
+		helper.SetPrimitiveValue(revision, &spec.RollbackToRevision, RollbackToRevisionKey)
+	}
+
 	if ref, ok := specData[ChartRefKey]; ok {
 		if refData, ok := ref.([]interface{}); ok {
 			spec.ChartRef = expandRef(refData)
@@ -68,6 +76,8 @@ func FlattenSpecForClusterScope(spec *releaseclustermodel.VmwareTanzuManageV1alp
 	flattenSpecData[TargetNamespaceKey] = spec.TargetNamespace
 	flattenSpecData[InlineConfigKey] = spec.InlineConfiguration
 	flattenSpecData[IntervalKey] = spec.Interval
+	flattenSpecData[SuspendKey] = spec.Suspend
+	flattenSpecData[RollbackToRevisionKey] = int(spec.RollbackToRevision)
 
 	var chartRef = make(map[string]interface{})
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	releaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
@@ -25,6 +26,8 @@ const (
 	IntervalKey                = "interval"
 	InlineConfigKey            = "inline_config"
 	TargetNamespaceKey         = "target_namespace"
+	SuspendKey                 = "suspend"
+	RollbackToRevisionKey      = "rollback_to_revision"
 	SpecKey                    = "spec"
 )
 
@@ -72,6 +75,18 @@ var SpecSchema = &schema.Schema{
 					return durationInScript.Seconds() == durationInState.Seconds()
 				},
 			},
+			SuspendKey: {
+				Type:        schema.TypeBool,
+				Description: "Suspend the reconciliation of the Helm release. The release stays at its current revision until the reconciliation is resumed.",
+				Optional:    true,
+				Default:     false,
+			},
+			RollbackToRevisionKey: {
+				Type:         schema.TypeInt,
+				Description:  "Revision of the release history to roll back to. The release is pinned to this revision until the value is removed, without changing the chart version. Only applicable for cluster scope.",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			ChartRefKey: refSchema,
 		},
 	},
@@ -232,6 +247,10 @@ func HasSpecChanged(d *schema.ResourceData) bool {
 		fallthrough
 	case d.HasChange(helper.GetFirstElementOf(SpecKey, TargetNamespaceKey)):
 		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, SuspendKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, RollbackToRevisionKey)):
+		fallthrough
 	case d.HasChange(helper.GetFirstElementOf(SpecKey, ChartRefKey, GitRepositoryKey, RepositoryNameKey)):
 		fallthrough
 	case d.HasChange(helper.GetFirstElementOf(SpecKey, ChartRefKey, GitRepositoryKey, RepositoryNamespaceNameKey)):
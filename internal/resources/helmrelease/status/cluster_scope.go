@@ -4,7 +4,11 @@
 
 package status
 
-import releaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+import (
+	"time"
+
+	releaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+)
 
 func FlattenStatusForClusterScope(status *releaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseStatus) (data interface{}) {
 	if status == nil {
@@ -32,5 +36,32 @@ func FlattenStatusForClusterScope(status *releaseclustermodel.VmwareTanzuManageV
 		},
 	}
 
+	if len(status.History) > 0 {
+		flattenStatusData[HistoryKey] = flattenHistory(status.History)
+	}
+
 	return []interface{}{flattenStatusData}
 }
+
+func flattenHistory(history []*releaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSnapshot) (data []interface{}) {
+	for _, snapshot := range history {
+		if snapshot == nil {
+			continue
+		}
+
+		flattenSnapshotData := make(map[string]interface{})
+
+		flattenSnapshotData[RevisionKey] = int(snapshot.Revision)
+		flattenSnapshotData[chartVersionKey] = snapshot.ChartVersion
+		flattenSnapshotData[appVersionKey] = snapshot.AppVersion
+		flattenSnapshotData[revisionStatusKey] = snapshot.Status
+
+		if !time.Time(snapshot.DeployedTime).IsZero() {
+			flattenSnapshotData[deployedTimeKey] = snapshot.DeployedTime.String()
+		}
+
+		data = append(data, flattenSnapshotData)
+	}
+
+	return data
+}
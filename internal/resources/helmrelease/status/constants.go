@@ -15,4 +15,10 @@ const (
 	roleBindingNameKey    = "role_binding_name"
 	StatusKey             = "status"
 	generatedResourcesKey = "generated_resources"
+	HistoryKey            = "history"
+	RevisionKey           = "revision"
+	chartVersionKey       = "chart_version"
+	appVersionKey         = "app_version"
+	deployedTimeKey       = "deployed_time"
+	revisionStatusKey     = "status"
 )
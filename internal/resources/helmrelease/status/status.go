@@ -18,6 +18,7 @@ var StatusSchema = &schema.Schema{
 				Computed:    true,
 			},
 			generatedResourcesKey: generatedResourcesStatus,
+			HistoryKey:            historyStatus,
 		},
 	},
 }
@@ -46,3 +47,38 @@ var generatedResourcesStatus = &schema.Schema{
 		},
 	},
 }
+
+var historyStatus = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Release history of the helm release on the cluster, latest revision first. Only available for cluster scope.",
+	Computed:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			RevisionKey: {
+				Type:        schema.TypeInt,
+				Description: "Revision number of the release.",
+				Computed:    true,
+			},
+			chartVersionKey: {
+				Type:        schema.TypeString,
+				Description: "Chart version of the revision.",
+				Computed:    true,
+			},
+			appVersionKey: {
+				Type:        schema.TypeString,
+				Description: "App version of the chart of the revision.",
+				Computed:    true,
+			},
+			deployedTimeKey: {
+				Type:        schema.TypeString,
+				Description: "Time at which the revision was deployed.",
+				Computed:    true,
+			},
+			revisionStatusKey: {
+				Type:        schema.TypeString,
+				Description: "Status of the revision, e.g. deployed, superseded or failed.",
+				Computed:    true,
+			},
+		},
+	},
+}
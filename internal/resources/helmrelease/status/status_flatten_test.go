@@ -7,7 +7,9 @@ package status
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/require"
 
 	releaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
@@ -59,6 +61,61 @@ func TestFlattenStatusForClusterScope(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "cluster helm release status with release history",
+			input: &releaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseStatus{
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					conditionReady: {
+						Type:   conditionReady,
+						Status: statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE.Pointer(),
+						Reason: "UpgradeSucceeded",
+					},
+				},
+				GeneratedResources: &releaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseGeneratedResources{},
+				History: []*releaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSnapshot{
+					{
+						Revision:     2,
+						ChartVersion: "1.1.0",
+						AppVersion:   "2.0.0",
+						DeployedTime: strfmt.DateTime(time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)),
+						Status:       "deployed",
+					},
+					{
+						Revision:     1,
+						ChartVersion: "1.0.0",
+						AppVersion:   "1.9.0",
+						Status:       "superseded",
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					phaseKey: "UpgradeSucceeded",
+					generatedResourcesKey: []interface{}{
+						map[string]interface{}{
+							clusterRoleNameKey:    "",
+							roleBindingNameKey:    "",
+							serviceAccountNameKey: "",
+						},
+					},
+					HistoryKey: []interface{}{
+						map[string]interface{}{
+							RevisionKey:       2,
+							chartVersionKey:   "1.1.0",
+							appVersionKey:     "2.0.0",
+							deployedTimeKey:   "2024-05-02T10:00:00.000Z",
+							revisionStatusKey: "deployed",
+						},
+						map[string]interface{}{
+							RevisionKey:       1,
+							chartVersionKey:   "1.0.0",
+							appVersionKey:     "1.9.0",
+							revisionStatusKey: "superseded",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, each := range cases {
@@ -0,0 +1,13 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepository
+
+const (
+	ResourceName = "tanzu-mission-control_helm_repository"
+
+	nameKey          = "name"
+	namespaceNameKey = "namespace_name"
+	statusKey        = "status"
+)
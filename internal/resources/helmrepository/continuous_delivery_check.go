@@ -0,0 +1,52 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepository
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
+	continuousdeliveryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery"
+	continuousdeliveryscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrepository/scope"
+)
+
+// checkContinuousDelivery returns an error unless continuous delivery is enabled for the scope,
+// the helm repository never enables it on its own behalf.
+func checkContinuousDelivery(config *authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname) error {
+	if config == nil || scopedFullnameData == nil {
+		return errors.New("missing variables: error while checking Tanzu Mission Control continuous delivery feature")
+	}
+
+	continuousDeliveryScopedFullname := &continuousdeliveryscope.ScopedFullname{
+		Scope: scopedFullnameData.Scope,
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			continuousDeliveryScopedFullname.FullnameCluster = &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryFullName{
+				ClusterName:           scopedFullnameData.FullnameCluster.ClusterName,
+				ManagementClusterName: scopedFullnameData.FullnameCluster.ManagementClusterName,
+				ProvisionerName:       scopedFullnameData.FullnameCluster.ProvisionerName,
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			continuousDeliveryScopedFullname.FullnameClusterGroup = &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryFullName{
+				ClusterGroupName: scopedFullnameData.FullnameClusterGroup.ClusterGroupName,
+			}
+		}
+	case commonscope.UnknownScope:
+		return fmt.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	return continuousdelivery.RequireEnabled(config, continuousDeliveryScopedFullname, ResourceName)
+}
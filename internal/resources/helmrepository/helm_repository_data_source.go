@@ -16,6 +16,7 @@ import (
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	repositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository"
+	repositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -24,19 +25,12 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrepository/status"
 )
 
-const (
-	ResourceName = "tanzu-mission-control_helm_repository"
-
-	nameKey          = "name"
-	namespaceNameKey = "namespace_name"
-	statusKey        = "status"
-)
-
 type dataFromServer struct {
-	UID                string
-	meta               *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
-	clusterScopeStatus *repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryStatus
-	atomicSpec         *repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec
+	UID                     string
+	meta                    *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	clusterScopeStatus      *repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryStatus
+	clusterGroupScopeStatus *repositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryStatus
+	atomicSpec              *repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec
 }
 
 func DataSourceHelmRepository() *schema.Resource {
@@ -58,9 +52,9 @@ var helmSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Default:     "*",
 	},
-	commonscope.ScopeKey: helmscope.ScopeSchema,
+	commonscope.ScopeKey: helmscope.DataSourceScopeSchema,
 	common.MetaKey:       common.Meta,
-	spec.SpecKey:         spec.SpecSchema,
+	spec.SpecKey:         helper.UpdateDataSourceSchema(spec.SpecSchema),
 	statusKey:            status.StatusSchema,
 }
 
@@ -72,14 +66,12 @@ func dataSourceHelmRepositoryRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Unable to read repository namespace name")
 	}
 
-	scopedFullnameData := helmscope.ConstructScope(d)
+	scopedFullnameData := helmscope.ConstructScope(d, "", namespaceName)
 
 	if scopedFullnameData == nil {
 		return diag.Errorf("Unable to get Tanzu Mission Control helm repository entry; Scope full name is empty")
 	}
 
-	scopedFullnameData.FullnameCluster.NamespaceName = namespaceName
-
 	helmRepoDataFromServer, err := retrieveHelmRepositoryDataFromServer(config, scopedFullnameData, d)
 	if err != nil {
 		if clienterrors.IsNotFoundError(err) && !helper.IsDataRead(ctx) {
@@ -150,7 +142,7 @@ func retrieveHelmRepositoryDataFromServer(config authctx.TanzuContext, scopedFul
 			helmRepoDataFromServer.clusterScopeStatus = repo.Status
 		}
 	case commonscope.UnknownScope:
-		return helmRepoDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(helmscope.ScopesAllowed[:], `, `))
+		return helmRepoDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(helmscope.DataSourceScopesAllowed[:], `, `))
 	}
 
 	fullName, name, namespace := helmscope.FlattenScope(scopedFullnameData)
@@ -16,8 +16,12 @@ import (
 	"github.com/jarcoal/httpmock"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	continuousdeliveryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/cluster"
+	continuousdeliveryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/continuousdelivery/clustergroup"
 	pakageclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository"
+	pakageclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
 )
 
 // nolint: unused
@@ -129,3 +133,140 @@ func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
 	httpmock.RegisterResponder("GET", getPkgEndpoint,
 		bodyInspectingResponder(t, nil, 200, getResponse))
 }
+
+// Register a new responder when the given call is made.
+// nolint: unused
+func changeStateResponder(registerFunc func(), successResponse int, successResponseBody interface{}) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		registerFunc()
+		return httpmock.NewJsonResponse(successResponse, successResponseBody)
+	}
+}
+
+// nolint: unused
+func (testConfig *testAcceptanceConfig) setupResourceHTTPMocks(t *testing.T) {
+	httpmock.Activate()
+	t.Cleanup(httpmock.Deactivate)
+
+	endpoint := os.Getenv("TMC_ENDPOINT")
+
+	const (
+		https                = "https:/"
+		clAPIVersionAndGroup = "v1alpha1/clusters"
+		cgAPIVersionAndGroup = "v1alpha1/clustergroups"
+		apiSubGroup          = "namespaces"
+		cgAPISubGroup        = "namespace"
+		apiKind              = "fluxcd/helm/repositories"
+		cdAPIKind            = "fluxcd/continuousdelivery"
+	)
+
+	meta := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		Description:     "resource with description",
+		UID:             "helmrepo1",
+		ResourceVersion: "v1",
+	}
+
+	// cluster level helm repository resource.
+	clRepository := &pakageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepository{
+		FullName: &pakageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryFullName{
+			ClusterName:           testConfig.ScopeHelperResources.Cluster.Name,
+			ManagementClusterName: "attached",
+			ProvisionerName:       "attached",
+			Name:                  testConfig.RepoName,
+			NamespaceName:         testConfig.Namespace,
+		},
+		Meta: meta,
+		Spec: &pakageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec{
+			URL:      "https://charts.bitnami.com/bitnami",
+			Interval: "5m",
+		},
+	}
+
+	postEndpoint := (helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ScopeHelperResources.Cluster.Name, apiSubGroup, testConfig.Namespace, apiKind)).String()
+	getEndpoint := (helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ScopeHelperResources.Cluster.Name, apiSubGroup, testConfig.Namespace, apiKind, testConfig.RepoName)).String()
+	cdEndpoint := (helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ScopeHelperResources.Cluster.Name, cdAPIKind)).String()
+
+	// Continuous delivery is already enabled on the cluster.
+	httpmock.RegisterResponder("GET", cdEndpoint,
+		bodyInspectingResponder(t, nil, 200, &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryListContinuousDeliveriesResponse{
+			ContinuousDeliveries: []*continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryContinuousDelivery{
+				{
+					Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "cd1"},
+				},
+			},
+		}))
+
+	httpmock.RegisterResponder("POST", postEndpoint,
+		bodyInspectingResponder(t, &pakageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest{Repository: clRepository}, 200,
+			&pakageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryResponse{Repository: clRepository}))
+
+	httpmock.RegisterResponder("GET", getEndpoint,
+		bodyInspectingResponder(t, nil, 200, &pakageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryGetResponse{Repository: clRepository}))
+
+	httpmock.RegisterResponder("DELETE", getEndpoint, changeStateResponder(
+		// Set up the get to return 404 after the helm repository has been 'deleted'.
+		func() {
+			httpmock.RegisterResponder("GET", getEndpoint,
+				httpmock.NewStringResponder(404, "Not found"))
+		},
+		http.StatusOK,
+		nil))
+
+	// cluster group level helm repository resource.
+	cgRepository := &pakageclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository{
+		FullName: &pakageclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName{
+			ClusterGroupName: testConfig.ScopeHelperResources.ClusterGroup.Name,
+			Name:             testConfig.RepoName,
+			NamespaceName:    testConfig.Namespace,
+		},
+		Meta: meta,
+		Spec: &pakageclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec{
+			AtomicSpec: &pakageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec{
+				URL:       "oci://ghcr.io/stefanprodan/charts",
+				Type:      pakageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeOCI.Pointer(),
+				SecretRef: "registry-credentials",
+				Interval:  "5m",
+			},
+		},
+		Status: &pakageclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryStatus{
+			Phase: statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED.Pointer(),
+		},
+	}
+
+	postCGEndpoint := (helper.ConstructRequestURL(https, endpoint, cgAPIVersionAndGroup, testConfig.ScopeHelperResources.ClusterGroup.Name, cgAPISubGroup, apiKind)).String()
+	getCGEndpoint := (helper.ConstructRequestURL(https, endpoint, cgAPIVersionAndGroup, testConfig.ScopeHelperResources.ClusterGroup.Name, cgAPISubGroup, apiKind, testConfig.RepoName)).String()
+
+	testConfig.setupClusterGroupContinuousDeliveryHTTPMock(t, true)
+
+	httpmock.RegisterResponder("POST", postCGEndpoint,
+		bodyInspectingResponder(t, &pakageclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest{Repository: cgRepository}, 200,
+			&pakageclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryResponse{Repository: cgRepository}))
+
+	httpmock.RegisterResponder("GET", getCGEndpoint,
+		bodyInspectingResponder(t, nil, 200, &pakageclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryGetResponse{Repository: cgRepository}))
+
+	httpmock.RegisterResponder("DELETE", getCGEndpoint, changeStateResponder(
+		// Set up the get to return 404 after the helm repository has been 'deleted'.
+		func() {
+			httpmock.RegisterResponder("GET", getCGEndpoint,
+				httpmock.NewStringResponder(404, "Not found"))
+		},
+		http.StatusOK,
+		nil))
+}
+
+// setupClusterGroupContinuousDeliveryHTTPMock sets up whether continuous delivery is enabled on the cluster group.
+func (testConfig *testAcceptanceConfig) setupClusterGroupContinuousDeliveryHTTPMock(t *testing.T, enabled bool) {
+	cdCGEndpoint := (helper.ConstructRequestURL("https:/", os.Getenv("TMC_ENDPOINT"), "v1alpha1/clustergroups", testConfig.ScopeHelperResources.ClusterGroup.Name, "fluxcd/continuousdelivery")).String()
+	response := &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryListContinuousDeliveriesResponse{}
+
+	if enabled {
+		response.ContinuousDeliveries = []*continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryContinuousDelivery{
+			{
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "cd2"},
+			},
+		}
+	}
+
+	httpmock.RegisterResponder("GET", cdCGEndpoint, bodyInspectingResponder(t, nil, 200, response))
+}
@@ -24,6 +24,7 @@ func initTestProvider(t *testing.T) *schema.Provider {
 			cluster.ResourceName:      cluster.ResourceTMCCluster(),
 			helm.ResourceName:         helm.ResourceHelm(),
 			clustergroup.ResourceName: clustergroup.ResourceClusterGroup(),
+			ResourceName:              ResourceHelmRepository(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			ResourceName:         DataSourceHelmRepository(),
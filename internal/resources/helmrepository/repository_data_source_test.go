@@ -12,6 +12,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
@@ -23,6 +24,9 @@ func testGetDefaultAcceptanceConfig(t *testing.T) *testAcceptanceConfig {
 	return &testAcceptanceConfig{
 		Provider:             initTestProvider(t),
 		RepoResource:         repoResource,
+		RepoResourceVar:      repoResourceVar,
+		RepoResourceName:     fmt.Sprintf("%s.%s", repoResource, repoResourceVar),
+		RepoName:             acctest.RandomWithPrefix(repoNamePrefix),
 		ScopeHelperResources: commonscope.NewScopeHelperResources(),
 		RepoDataSourceVar:    repoDataSourceVar,
 		Namespace:            "tanzu-helm-resources",
@@ -0,0 +1,440 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepository
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	repositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository"
+	repositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	helmscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrepository/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrepository/spec"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrepository/status"
+)
+
+func ResourceHelmRepository() *schema.Resource {
+	return &schema.Resource{
+		Schema:        helmRepositoryResourceSchema,
+		CreateContext: resourceHelmRepositoryCreate,
+		ReadContext:   resourceHelmRepositoryRead,
+		UpdateContext: resourceHelmRepositoryInPlaceUpdate,
+		DeleteContext: resourceHelmRepositoryDelete,
+		CustomizeDiff: customdiff.All(
+			schema.CustomizeDiffFunc(commonscope.ValidateScope(helmscope.ScopesAllowed[:])),
+			spec.ValidateInput,
+		),
+	}
+}
+
+var helmRepositoryResourceSchema = map[string]*schema.Schema{
+	nameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the helm repository.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	namespaceNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of Namespace.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	commonscope.ScopeKey: helmscope.ScopeSchema,
+	common.MetaKey:       common.Meta,
+	spec.SpecKey:         spec.SpecSchema,
+	statusKey:            status.StatusSchema,
+}
+
+func resourceHelmRepositoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	helmRepositoryName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read helm repository name")
+	}
+
+	helmRepositoryNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read helm repository namespace name")
+	}
+
+	scopedFullnameData := helmscope.ConstructScope(d, helmRepositoryName, helmRepositoryNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to create Tanzu Mission Control helm repository entry; Scope full name is empty")
+	}
+
+	var (
+		UID  string
+		meta = common.ConstructMeta(d)
+	)
+
+	err := checkContinuousDelivery(&config, scopedFullnameData)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control helm repository entry, name : %s", helmRepositoryName))
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			helmRepositoryReq := &repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest{
+				Repository: &repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepository{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForClusterScope(d),
+				},
+			}
+
+			helmRepositoryResponse, err := config.TMCConnection.ClusterHelmRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceCreate(helmRepositoryReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster helm repository entry, name : %s", helmRepositoryName))
+			}
+
+			UID = helmRepositoryResponse.Repository.Meta.UID
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			helmRepositoryReq := &repositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest{
+				Repository: &repositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForClusterGroupScope(d),
+				},
+			}
+
+			helmRepositoryResponse, err := config.TMCConnection.ClusterGroupHelmRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceCreate(helmRepositoryReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group helm repository entry, name : %s", helmRepositoryName))
+			}
+
+			UID = helmRepositoryResponse.Repository.Meta.UID
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(helmscope.ScopesAllowed[:], `, `))
+	}
+
+	// always run
+	d.SetId(UID)
+
+	return append(diags, resourceHelmRepositoryRead(ctx, d, m)...)
+}
+
+func resourceHelmRepositoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	helmRepositoryName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read helm repository name")
+	}
+
+	helmRepositoryNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read helm repository namespace name")
+	}
+
+	scopedFullnameData := helmscope.ConstructScope(d, helmRepositoryName, helmRepositoryNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to get Tanzu Mission Control helm repository entry; Scope full name is empty")
+	}
+
+	helmRepositoryDataFromServer, err := getHelmRepositoryFromServer(config, scopedFullnameData, d)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(helmRepositoryDataFromServer.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(helmRepositoryDataFromServer.meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var (
+		flattenedSpec   []interface{}
+		flattenedStatus interface{}
+	)
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		flattenedSpec = spec.FlattenSpecForClusterScope(helmRepositoryDataFromServer.atomicSpec)
+		flattenedStatus = status.FlattenStatusForClusterScope(helmRepositoryDataFromServer.clusterScopeStatus)
+	case commonscope.ClusterGroupScope:
+		clusterGroupScopeSpec := &repositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec{
+			AtomicSpec: helmRepositoryDataFromServer.atomicSpec,
+		}
+		flattenedSpec = spec.FlattenSpecForClusterGroupScope(clusterGroupScopeSpec)
+		flattenedStatus = status.FlattenStatusForClusterGroupScope(helmRepositoryDataFromServer.clusterGroupScopeStatus)
+	}
+
+	if err := d.Set(spec.SpecKey, flattenedSpec); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(statusKey, flattenedStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceHelmRepositoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	helmRepositoryName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read helm repository name")
+	}
+
+	helmRepositoryNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read helm repository namespace name")
+	}
+
+	scopedFullnameData := helmscope.ConstructScope(d, helmRepositoryName, helmRepositoryNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to delete Tanzu Mission Control helm repository entry; Scope full name is empty")
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			err := config.TMCConnection.ClusterHelmRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceDelete(scopedFullnameData.FullnameCluster)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster helm repository entry, name : %s", helmRepositoryName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			err := config.TMCConnection.ClusterGroupHelmRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group helm repository entry, name : %s", helmRepositoryName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(helmscope.ScopesAllowed[:], `, `))
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+func resourceHelmRepositoryInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	helmRepositoryName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read helm repository name")
+	}
+
+	helmRepositoryNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read helm repository namespace name")
+	}
+
+	scopedFullnameData := helmscope.ConstructScope(d, helmRepositoryName, helmRepositoryNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to update Tanzu Mission Control helm repository entry; Scope full name is empty")
+	}
+
+	helmRepositoryDataFromServer, err := getHelmRepositoryFromServer(config, scopedFullnameData, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var updateAvailable bool
+
+	if updateCheckForMeta(d, helmRepositoryDataFromServer.meta) {
+		updateAvailable = true
+	}
+
+	// The spec isn't reported when the helm repository has none, the configured spec is set on an empty spec.
+	if helmRepositoryDataFromServer.atomicSpec == nil {
+		helmRepositoryDataFromServer.atomicSpec = &repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec{}
+	}
+
+	if updateCheckForSpec(d, helmRepositoryDataFromServer.atomicSpec, scopedFullnameData.Scope) {
+		updateAvailable = true
+	}
+
+	if !updateAvailable {
+		log.Printf("[INFO] helm repository update is not required")
+		return diags
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			helmRepositoryReq := &repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryRequest{
+				Repository: &repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepository{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     helmRepositoryDataFromServer.meta,
+					Spec:     helmRepositoryDataFromServer.atomicSpec,
+				},
+			}
+
+			_, err = config.TMCConnection.ClusterHelmRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceUpdate(helmRepositoryReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster helm repository entry, name : %s", helmRepositoryName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			helmRepositoryReq := &repositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryRequest{
+				Repository: &repositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepository{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     helmRepositoryDataFromServer.meta,
+					Spec: &repositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec{
+						AtomicSpec: helmRepositoryDataFromServer.atomicSpec,
+					},
+				},
+			}
+
+			_, err = config.TMCConnection.ClusterGroupHelmRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceUpdate(helmRepositoryReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group helm repository entry, name : %s", helmRepositoryName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(helmscope.ScopesAllowed[:], `, `))
+	}
+
+	log.Printf("[INFO] helm repository update successful")
+
+	return resourceHelmRepositoryRead(ctx, d, m)
+}
+
+func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) bool {
+	if !common.HasMetaChanged(d) {
+		return false
+	}
+
+	objectMeta := common.ConstructMeta(d)
+
+	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
+		objectMeta.Labels[common.CreatorLabelKey] = value
+	}
+
+	meta.Labels = objectMeta.Labels
+	meta.Description = objectMeta.Description
+
+	log.Printf("[INFO] updating helm repository meta data")
+
+	return true
+}
+
+func updateCheckForSpec(d *schema.ResourceData, atomicSpec *repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec, scope commonscope.Scope) bool {
+	if !spec.HasSpecChanged(d) {
+		return false
+	}
+
+	var helmRepositorySpec *repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec
+
+	switch scope {
+	case commonscope.ClusterScope:
+		helmRepositorySpec = spec.ConstructSpecForClusterScope(d)
+	case commonscope.ClusterGroupScope:
+		clusterGroupScopeSpec := spec.ConstructSpecForClusterGroupScope(d)
+		helmRepositorySpec = clusterGroupScopeSpec.AtomicSpec
+	}
+
+	atomicSpec.URL = helmRepositorySpec.URL
+	atomicSpec.Type = helmRepositorySpec.Type
+	atomicSpec.SecretRef = helmRepositorySpec.SecretRef
+	atomicSpec.CertSecretRef = helmRepositorySpec.CertSecretRef
+	atomicSpec.PassCredentials = helmRepositorySpec.PassCredentials
+	atomicSpec.Interval = helmRepositorySpec.Interval
+	atomicSpec.Suspend = helmRepositorySpec.Suspend
+
+	log.Printf("[INFO] updating helm repository spec")
+
+	return true
+}
+
+func getHelmRepositoryFromServer(config authctx.TanzuContext, scopedFullnameData *helmscope.ScopedFullname, d *schema.ResourceData) (*dataFromServer, error) {
+	var helmRepoDataFromServer = &dataFromServer{}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			resp, err := config.TMCConnection.ClusterHelmRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmRepositoryResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return helmRepoDataFromServer, err
+				}
+
+				return helmRepoDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster helm repository entry, name : %s", scopedFullnameData.FullnameCluster.Name)
+			}
+
+			scopedFullnameData.FullnameCluster = resp.Repository.FullName
+			helmRepoDataFromServer.UID = resp.Repository.Meta.UID
+			helmRepoDataFromServer.meta = resp.Repository.Meta
+			helmRepoDataFromServer.atomicSpec = resp.Repository.Spec
+			helmRepoDataFromServer.clusterScopeStatus = resp.Repository.Status
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			resp, err := config.TMCConnection.ClusterGroupHelmRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmRepositoryResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return helmRepoDataFromServer, err
+				}
+
+				return helmRepoDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster group helm repository entry, name : %s", scopedFullnameData.FullnameClusterGroup.Name)
+			}
+
+			scopedFullnameData.FullnameClusterGroup = resp.Repository.FullName
+			helmRepoDataFromServer.UID = resp.Repository.Meta.UID
+			helmRepoDataFromServer.meta = resp.Repository.Meta
+			helmRepoDataFromServer.clusterGroupScopeStatus = resp.Repository.Status
+
+			if resp.Repository.Spec != nil {
+				helmRepoDataFromServer.atomicSpec = resp.Repository.Spec.AtomicSpec
+			}
+		}
+	case commonscope.UnknownScope:
+		return helmRepoDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(helmscope.ScopesAllowed[:], `, `))
+	}
+
+	fullName, name, namespace := helmscope.FlattenScope(scopedFullnameData)
+
+	if err := d.Set(nameKey, name); err != nil {
+		return helmRepoDataFromServer, err
+	}
+
+	if err := d.Set(namespaceNameKey, namespace); err != nil {
+		return helmRepoDataFromServer, err
+	}
+
+	if err := d.Set(commonscope.ScopeKey, fullName); err != nil {
+		return helmRepoDataFromServer, err
+	}
+
+	return helmRepoDataFromServer, nil
+}
@@ -0,0 +1,175 @@
+//go:build helmrepository
+
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helmrepository
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	helmscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrepository/scope"
+	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
+)
+
+func TestAcceptanceForHelmRepositoryResource(t *testing.T) {
+	testConfig := testGetDefaultAcceptanceConfig(t)
+
+	// If the flag to execute helm repository tests is not found, run this as a mock test by setting up an http intercept for each endpoint.
+	_, found := os.LookupEnv("ENABLE_HELMREPO_ENV_TEST")
+	if !found {
+		os.Setenv("TF_ACC", "true")
+		os.Setenv("TMC_ENDPOINT", "dummy.tmc.mock.vmware.com")
+		os.Setenv("VMW_CLOUD_API_TOKEN", "dummy")
+		os.Setenv("VMW_CLOUD_ENDPOINT", "console.tanzu.broadcom.com")
+
+		log.Println("Setting up the mock endpoints...")
+
+		testConfig.setupResourceHTTPMocks(t)
+	} else {
+		// Environment variables with non default values required for a successful call to Cluster Config Service.
+		requiredVars := []string{
+			"VMW_CLOUD_ENDPOINT",
+			"TMC_ENDPOINT",
+			"VMW_CLOUD_API_TOKEN",
+			"ORG_ID",
+		}
+
+		// Check if the required environment variables are set.
+		for _, name := range requiredVars {
+			if _, found := os.LookupEnv(name); !found {
+				t.Errorf("required environment variable '%s' missing", name)
+			}
+		}
+	}
+
+	t.Log("start helm repository resource acceptance tests!")
+
+	// Test case for helm repository resource.
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testhelper.TestPreCheck(t),
+		ProviderFactories: testhelper.GetTestProviderFactories(testConfig.Provider),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if testConfig.ScopeHelperResources.Cluster.KubeConfigPath == "" && found {
+						t.Skip("KUBECONFIG env var is not set for cluster scoped helm repository acceptance test")
+					}
+				},
+				Config: testConfig.getTestHelmRepositoryResourceConfigValue(commonscope.ClusterScope, `
+		url = "https://charts.bitnami.com/bitnami"
+	`),
+				Check: resource.ComposeTestCheckFunc(
+					testConfig.verifyHelmRepositoryResourceCreation(),
+					resource.TestCheckResourceAttr(testConfig.RepoResourceName, "name", testConfig.RepoName),
+					resource.TestCheckResourceAttr(testConfig.RepoResourceName, "scope.0.cluster.0.name", testConfig.ScopeHelperResources.Cluster.Name),
+					resource.TestCheckResourceAttr(testConfig.RepoResourceName, "spec.0.type", "DEFAULT"),
+				),
+			},
+			{
+				// Continuous delivery is never enabled on behalf of the helm repository.
+				SkipFunc: func() (bool, error) {
+					return found, nil
+				},
+				PreConfig: func() {
+					testConfig.setupClusterGroupContinuousDeliveryHTTPMock(t, false)
+				},
+				Config: testConfig.getTestHelmRepositoryResourceConfigValue(commonscope.ClusterGroupScope, `
+		url = "oci://ghcr.io/stefanprodan/charts"
+		type = "OCI"
+	`),
+				ExpectError: regexp.MustCompile("continuous delivery is not enabled"),
+			},
+			{
+				PreConfig: func() {
+					if !found {
+						testConfig.setupClusterGroupContinuousDeliveryHTTPMock(t, true)
+					}
+				},
+				Config: testConfig.getTestHelmRepositoryResourceConfigValue(commonscope.ClusterGroupScope, `
+		url        = "oci://ghcr.io/stefanprodan/charts"
+		type       = "OCI"
+		secret_ref = "registry-credentials"
+	`),
+				Check: resource.ComposeTestCheckFunc(
+					testConfig.verifyHelmRepositoryResourceCreation(),
+					resource.TestCheckResourceAttr(testConfig.RepoResourceName, "name", testConfig.RepoName),
+					resource.TestCheckResourceAttr(testConfig.RepoResourceName, "scope.0.cluster_group.0.name", testConfig.ScopeHelperResources.ClusterGroup.Name),
+					resource.TestCheckResourceAttr(testConfig.RepoResourceName, "spec.0.type", "OCI"),
+					resource.TestCheckResourceAttr(testConfig.RepoResourceName, "spec.0.secret_ref", "registry-credentials"),
+				),
+			},
+		},
+	},
+	)
+
+	t.Log("helm repository resource acceptance test complete")
+}
+
+func (testConfig *testAcceptanceConfig) getTestHelmRepositoryResourceConfigValue(scope commonscope.Scope, repoSpec string) string {
+	helperBlock, scopeBlock := testConfig.ScopeHelperResources.GetTestResourceHelperAndScope(scope, helmscope.ScopesAllowed[:])
+
+	if _, found := os.LookupEnv("ENABLE_HELMREPO_ENV_TEST"); !found {
+		helperBlock = ""
+
+		switch scope {
+		case commonscope.ClusterScope:
+			scopeBlock = fmt.Sprintf(`
+	 scope {
+		cluster {
+			name = "%s"
+			management_cluster_name = "attached"
+			provisioner_name = "attached"
+		}
+	 }`, testConfig.ScopeHelperResources.Cluster.Name)
+		case commonscope.ClusterGroupScope:
+			scopeBlock = fmt.Sprintf(`
+	 scope {
+		cluster_group {
+			name = "%s"
+		}
+	 }`, testConfig.ScopeHelperResources.ClusterGroup.Name)
+		}
+	}
+
+	return fmt.Sprintf(`
+	%s
+
+	resource "%s" "%s" {
+	 name = "%s"
+
+	 namespace_name = "%s"
+
+	 %s
+
+	 spec {
+	   %s
+	 }
+	}
+	`, helperBlock, testConfig.RepoResource, testConfig.RepoResourceVar, testConfig.RepoName, testConfig.Namespace, scopeBlock, repoSpec)
+}
+
+func (testConfig *testAcceptanceConfig) verifyHelmRepositoryResourceCreation() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[testConfig.RepoResourceName]
+		if !ok {
+			return fmt.Errorf("not found resource: %s", testConfig.RepoResourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID not set, resource: %s", testConfig.RepoResourceName)
+		}
+
+		return nil
+	}
+}
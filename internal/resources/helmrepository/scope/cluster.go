@@ -10,7 +10,7 @@ import (
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
-func ConstructClusterHelmFullname(data []interface{}) (fullname *helmclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryFullName) {
+func ConstructClusterHelmFullname(data []interface{}, name, namespace string) (fullname *helmclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryFullName) {
 	if len(data) == 0 || data[0] == nil {
 		return fullname
 	}
@@ -31,6 +31,9 @@ func ConstructClusterHelmFullname(data []interface{}) (fullname *helmclustermode
 		helper.SetPrimitiveValue(nameValue, &fullname.ClusterName, commonscope.NameKey)
 	}
 
+	fullname.Name = name
+	fullname.NamespaceName = namespace
+
 	return fullname
 }
 
@@ -0,0 +1,49 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	helmclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func TestFlattenClusterGroupHelmFullname(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *helmclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster group helm repository full name",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster group helm repository full name",
+			input: &helmclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName{
+				ClusterGroupName: "cg",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					commonscope.NameKey: "cg",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenClusterGroupHelmFullname(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
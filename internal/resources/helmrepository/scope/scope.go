@@ -12,23 +12,31 @@ import (
 	"golang.org/x/exp/slices"
 
 	helmrepoclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository"
+	helmrepoclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
 // ScopedFullname is a struct for all types of helm repository full names.
 type ScopedFullname struct {
-	Scope           commonscope.Scope
-	FullnameCluster *helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryFullName
+	Scope                commonscope.Scope
+	FullnameCluster      *helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryFullName
+	FullnameClusterGroup *helmrepoclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName
 }
 
 var (
-	ScopesAllowed = [...]string{commonscope.ClusterKey}
+	ScopesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey}
 	ScopeSchema   = commonscope.GetScopeSchema(
 		commonscope.WithDescription(fmt.Sprintf("Scope for the Helm Repository, having one of the valid scopes: %v.", strings.Join(ScopesAllowed[:], `, `))),
 		commonscope.WithScopes(ScopesAllowed[:]))
+
+	// DataSourceScopesAllowed lists the scopes the helm repository data source can list repositories from.
+	DataSourceScopesAllowed = [...]string{commonscope.ClusterKey}
+	DataSourceScopeSchema   = commonscope.GetScopeSchema(
+		commonscope.WithDescription(fmt.Sprintf("Scope for the Helm Repository, having one of the valid scopes: %v.", strings.Join(DataSourceScopesAllowed[:], `, `))),
+		commonscope.WithScopes(DataSourceScopesAllowed[:]))
 )
 
-func ConstructScope(d *schema.ResourceData) (scopedFullnameData *ScopedFullname) {
+func ConstructScope(d *schema.ResourceData, name, namespace string) (scopedFullnameData *ScopedFullname) {
 	value, ok := d.GetOk(commonscope.ScopeKey)
 
 	if !ok {
@@ -47,7 +55,16 @@ func ConstructScope(d *schema.ResourceData) (scopedFullnameData *ScopedFullname)
 		if clusterValue, ok := clusterData.([]interface{}); ok && len(clusterValue) != 0 {
 			scopedFullnameData = &ScopedFullname{
 				Scope:           commonscope.ClusterScope,
-				FullnameCluster: ConstructClusterHelmFullname(clusterValue),
+				FullnameCluster: ConstructClusterHelmFullname(clusterValue, name, namespace),
+			}
+		}
+	}
+
+	if clusterGroupData, ok := scopeData[commonscope.ClusterGroupKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+		if clusterGroupValue, ok := clusterGroupData.([]interface{}); ok && len(clusterGroupValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:                commonscope.ClusterGroupScope,
+				FullnameClusterGroup: ConstructClusterGroupHelmFullname(clusterGroupValue, name, namespace),
 			}
 		}
 	}
@@ -65,11 +82,19 @@ func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}, name, nam
 	switch scopedFullname.Scope {
 	case commonscope.ClusterScope:
 		if slices.Contains(ScopesAllowed[:], commonscope.ClusterKey) {
+			name = scopedFullname.FullnameCluster.Name
+			namespace = scopedFullname.FullnameCluster.NamespaceName
 			flattenScopeData[commonscope.ClusterKey] = FlattenClusterHelmFullname(scopedFullname.FullnameCluster)
 		}
+	case commonscope.ClusterGroupScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+			name = scopedFullname.FullnameClusterGroup.Name
+			namespace = scopedFullname.FullnameClusterGroup.NamespaceName
+			flattenScopeData[commonscope.ClusterGroupKey] = FlattenClusterGroupHelmFullname(scopedFullname.FullnameClusterGroup)
+		}
 	case commonscope.UnknownScope:
 		fmt.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(ScopesAllowed[:], `, `))
 	}
 
-	return []interface{}{flattenScopeData}, scopedFullname.FullnameCluster.Name, scopedFullname.FullnameCluster.NamespaceName
+	return []interface{}{flattenScopeData}, name, namespace
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	helmclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository"
+	helmclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
@@ -56,6 +57,28 @@ func TestFlattenScope(t *testing.T) {
 			expectedName:      "n",
 			expectedNamespace: "nn",
 		},
+		{
+			description: "normal scenario with complete cluster group scope",
+			input: &ScopedFullname{
+				Scope: commonscope.ClusterGroupScope,
+				FullnameClusterGroup: &helmclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryFullName{
+					ClusterGroupName: "cg",
+					Name:             "n",
+					NamespaceName:    "nn",
+				},
+			},
+			expectedData: []interface{}{
+				map[string]interface{}{
+					commonscope.ClusterGroupKey: []interface{}{
+						map[string]interface{}{
+							commonscope.NameKey: "cg",
+						},
+					},
+				},
+			},
+			expectedName:      "n",
+			expectedNamespace: "nn",
+		},
 	}
 
 	for _, each := range cases {
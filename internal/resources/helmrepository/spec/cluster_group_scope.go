@@ -0,0 +1,37 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	helmrepoclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
+)
+
+func ConstructSpecForClusterGroupScope(d *schema.ResourceData) (spec *helmrepoclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec) {
+	value, ok := d.GetOk(SpecKey)
+	if !ok {
+		return spec
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	spec = &helmrepoclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec{}
+	spec.AtomicSpec = ConstructSpecForClusterScope(d)
+
+	return spec
+}
+
+func FlattenSpecForClusterGroupScope(spec *helmrepoclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec) (data []interface{}) {
+	if spec == nil || spec.AtomicSpec == nil {
+		return data
+	}
+
+	return FlattenSpecForClusterScope(spec.AtomicSpec)
+}
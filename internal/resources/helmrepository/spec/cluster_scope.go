@@ -5,9 +5,59 @@
 package spec
 
 import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	helmrepoclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository"
 )
 
+func ConstructSpecForClusterScope(d *schema.ResourceData) (spec *helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec) {
+	value, ok := d.GetOk(SpecKey)
+	if !ok {
+		return spec
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	specData := data[0].(map[string]interface{})
+
+	spec = &helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec{}
+
+	if URLValue, ok := specData[URLKey]; ok {
+		helper.SetPrimitiveValue(URLValue, &spec.URL, URLKey)
+	}
+
+	if typeValue, ok := specData[typeKey]; ok {
+		spec.Type = helmrepoclustermodel.NewVmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType(helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryType(typeValue.(string)))
+	}
+
+	if secretRefValue, ok := specData[secretRefKey]; ok {
+		helper.SetPrimitiveValue(secretRefValue, &spec.SecretRef, secretRefKey)
+	}
+
+	if certSecretRefValue, ok := specData[certSecretRefKey]; ok {
+		helper.SetPrimitiveValue(certSecretRefValue, &spec.CertSecretRef, certSecretRefKey)
+	}
+
+	if passCredentialsValue, ok := specData[passCredentialsKey]; ok {
+		helper.SetPrimitiveValue(passCredentialsValue, &spec.PassCredentials, passCredentialsKey)
+	}
+
+	if intervalValue, ok := specData[intervalKey]; ok {
+		helper.SetPrimitiveValue(intervalValue, &spec.Interval, intervalKey)
+	}
+
+	if suspendValue, ok := specData[suspendKey]; ok {
+		helper.SetPrimitiveValue(suspendValue, &spec.Suspend, suspendKey)
+	}
+
+	return spec
+}
+
 func FlattenSpecForClusterScope(spec *helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec) (data []interface{}) {
 	if spec == nil {
 		return data
@@ -16,6 +66,18 @@ func FlattenSpecForClusterScope(spec *helmrepoclustermodel.VmwareTanzuManageV1al
 	flattenSpecData := make(map[string]interface{})
 
 	flattenSpecData[URLKey] = spec.URL
+	flattenSpecData[secretRefKey] = spec.SecretRef
+	flattenSpecData[certSecretRefKey] = spec.CertSecretRef
+	flattenSpecData[passCredentialsKey] = spec.PassCredentials
+	flattenSpecData[intervalKey] = spec.Interval
+	flattenSpecData[suspendKey] = spec.Suspend
+
+	// DEFAULT is the zero value of the type enum, so it is dropped from the API response like the git implementation of git repositories.
+	flattenSpecData[typeKey] = string(helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeDEFAULT)
+
+	if spec.Type != nil {
+		flattenSpecData[typeKey] = string(*spec.Type)
+	}
 
 	return []interface{}{flattenSpecData}
 }
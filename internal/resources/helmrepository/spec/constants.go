@@ -0,0 +1,18 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+const (
+	SpecKey            = "spec"
+	URLKey             = "url"
+	typeKey            = "type"
+	secretRefKey       = "secret_ref"
+	certSecretRefKey   = "cert_secret_ref"
+	passCredentialsKey = "pass_credentials"
+	intervalKey        = "interval"
+	suspendKey         = "suspend"
+
+	ociScheme = "oci"
+)
@@ -5,25 +5,143 @@
 package spec
 
 import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-)
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-const (
-	URLKey  = "url"
-	SpecKey = "spec"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmrepoclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository"
 )
 
 var SpecSchema = &schema.Schema{
 	Type:        schema.TypeList,
 	Description: "Spec for the Helm Repository.",
-	Computed:    true,
+	Required:    true,
+	MaxItems:    1,
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			URLKey: {
+				Type:         schema.TypeString,
+				Description:  "URL of the helm repository. HTTP/S repositories serve an index.yaml, OCI registries are addressed with the `oci://` scheme.",
+				Required:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", ociScheme}),
+			},
+			typeKey: {
+				Type:        schema.TypeString,
+				Description: "Type of the helm repository. `DEFAULT` for HTTP/S repositories and `OCI` for OCI registries; `OCI` requires an `oci://` URL.",
+				Optional:    true,
+				Default:     fmt.Sprint(helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeDEFAULT),
+				ValidateFunc: validation.StringInSlice([]string{
+					fmt.Sprint(helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeDEFAULT),
+					fmt.Sprint(helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeOCI),
+				}, false),
+			},
+			secretRefKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the username/password source secret holding the basic-auth credentials of the repository. The source secret must exist in the same scope.",
+				Optional:    true,
+				Default:     "",
+			},
+			certSecretRefKey: {
 				Type:        schema.TypeString,
-				Description: "URL of helm repository.",
-				Computed:    true,
+				Description: "Name of the source secret holding the TLS client certificate, key and CA certificate used to connect to the repository.",
+				Optional:    true,
+				Default:     "",
+			},
+			passCredentialsKey: {
+				Type:        schema.TypeBool,
+				Description: "Pass the credentials of secret_ref to hosts other than the one of the repository URL, e.g. when charts are served from a different domain.",
+				Optional:    true,
+				Default:     false,
+			},
+			intervalKey: {
+				Type:        schema.TypeString,
+				Description: "Interval at which to check the helm repository for updates. If no value is entered, a default interval of 5 minutes will be applied as `5m`.",
+				Optional:    true,
+				Default:     "5m",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					durationInScript, err := time.ParseDuration(old)
+					if err != nil {
+						return false
+					}
+
+					durationInState, err := time.ParseDuration(new)
+					if err != nil {
+						return false
+					}
+
+					return durationInScript.Seconds() == durationInState.Seconds()
+				},
+			},
+			suspendKey: {
+				Type:        schema.TypeBool,
+				Description: "Suspend the reconciliation of the helm repository.",
+				Optional:    true,
+				Default:     false,
 			},
 		},
 	},
 }
+
+func HasSpecChanged(d *schema.ResourceData) bool {
+	updateRequired := false
+
+	switch {
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, URLKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, typeKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, secretRefKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, certSecretRefKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, passCredentialsKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, intervalKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, suspendKey)):
+		updateRequired = true
+	}
+
+	return updateRequired
+}
+
+// ValidateInput checks that the repository type matches the scheme of the repository URL.
+func ValidateInput(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	value, ok := diff.GetOk(SpecKey)
+	if !ok {
+		return nil
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	specData := data[0].(map[string]interface{})
+
+	repositoryURL, _ := specData[URLKey].(string)
+	repositoryType, _ := specData[typeKey].(string)
+
+	// The URL can be unknown during plan when it is computed from another resource.
+	parsedURL, err := url.Parse(repositoryURL)
+	if repositoryURL == "" || err != nil {
+		return nil
+	}
+
+	isOCI := repositoryType == string(helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeOCI)
+
+	switch {
+	case isOCI && parsedURL.Scheme != ociScheme:
+		return fmt.Errorf("spec: url %q is not valid for a helm repository of type %s: OCI repositories require an %s:// URL", repositoryURL, repositoryType, ociScheme)
+	case !isOCI && parsedURL.Scheme == ociScheme:
+		return fmt.Errorf("spec: url %q requires the helm repository type to be %s", repositoryURL, helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeOCI)
+	}
+
+	return nil
+}
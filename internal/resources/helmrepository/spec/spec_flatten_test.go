@@ -0,0 +1,139 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	helmrepoclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository"
+	helmrepoclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
+)
+
+const (
+	testOCIURL            = "oci://ghcr.io/stefanprodan/charts"
+	testURL               = "https://charts.bitnami.com/bitnami"
+	testNameOfTheSecret   = "name-of-the-secret"
+	testNameOfCertSecret  = "name-of-the-cert-secret"
+	testDefaultInterval   = "5m"
+	testRepositoryTypeOCI = "OCI"
+)
+
+func TestFlattenSpecForClusterScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster helm repository spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "scenario with only the url of a cluster helm repository, type falls back to DEFAULT",
+			input: &helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec{
+				URL:      testURL,
+				Interval: testDefaultInterval,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					URLKey:             testURL,
+					typeKey:            "DEFAULT",
+					secretRefKey:       "",
+					certSecretRefKey:   "",
+					passCredentialsKey: false,
+					intervalKey:        testDefaultInterval,
+					suspendKey:         false,
+				},
+			},
+		},
+		{
+			description: "normal scenario with complete cluster OCI helm repository spec",
+			input: &helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec{
+				URL:             testOCIURL,
+				Type:            helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeOCI.Pointer(),
+				SecretRef:       testNameOfTheSecret,
+				CertSecretRef:   testNameOfCertSecret,
+				PassCredentials: true,
+				Interval:        "10m",
+				Suspend:         true,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					URLKey:             testOCIURL,
+					typeKey:            testRepositoryTypeOCI,
+					secretRefKey:       testNameOfTheSecret,
+					certSecretRefKey:   testNameOfCertSecret,
+					passCredentialsKey: true,
+					intervalKey:        "10m",
+					suspendKey:         true,
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenSpecForClusterScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenSpecForClusterGroupScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *helmrepoclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster group helm repository spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "check for nil atomic spec of cluster group helm repository",
+			input:       &helmrepoclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec{},
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster group helm repository spec",
+			input: &helmrepoclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositorySpec{
+				AtomicSpec: &helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositorySpec{
+					URL:       testURL,
+					Type:      helmrepoclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmRepositoryTypeDEFAULT.Pointer(),
+					SecretRef: testNameOfTheSecret,
+					Interval:  testDefaultInterval,
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					URLKey:             testURL,
+					typeKey:            "DEFAULT",
+					secretRefKey:       testNameOfTheSecret,
+					certSecretRefKey:   "",
+					passCredentialsKey: false,
+					intervalKey:        testDefaultInterval,
+					suspendKey:         false,
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenSpecForClusterGroupScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
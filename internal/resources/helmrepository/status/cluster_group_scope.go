@@ -0,0 +1,25 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package status
+
+import (
+	helmrepoclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
+)
+
+func FlattenStatusForClusterGroupScope(status *helmrepoclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryStatus) (data interface{}) {
+	if status == nil {
+		return data
+	}
+
+	if status.Phase == nil {
+		return data
+	}
+
+	flattenStatusData := make(map[string]interface{})
+
+	flattenStatusData[phaseKey] = string(*status.Phase)
+
+	return flattenStatusData
+}
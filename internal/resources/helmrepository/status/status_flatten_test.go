@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	helmclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository"
+	helmclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrepository/clustergroup"
 	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
 )
 
@@ -53,3 +54,41 @@ func TestFlattenStatusForClusterScope(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenStatusForClusterGroupScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *helmclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryStatus
+		expected    interface{}
+	}{
+		{
+			description: "check for nil cluster group helm repository status",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "check for cluster group helm repository status without phase",
+			input:       &helmclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryStatus{},
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster group helm repository status",
+			input: &helmclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmRepositoryStatus{
+				Phase: statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED.Pointer(),
+			},
+			expected: map[string]interface{}{
+				phaseKey: "APPLIED",
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenStatusForClusterGroupScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
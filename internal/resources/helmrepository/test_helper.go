@@ -18,6 +18,8 @@ import (
 // nolint: gosec, unused
 const (
 	repoResource      = ResourceName
+	repoResourceVar   = "test_helm_repository"
+	repoNamePrefix    = "tf-hr-test"
 	repoDataSourceVar = "test_data_source_repo"
 )
 
@@ -25,6 +27,9 @@ const (
 type testAcceptanceConfig struct {
 	Provider             *schema.Provider
 	RepoResource         string
+	RepoResourceVar      string
+	RepoResourceName     string
+	RepoName             string
 	RepoDataSourceVar    string
 	RepoDataSourceName   string
 	Namespace            string
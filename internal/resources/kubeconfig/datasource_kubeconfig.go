@@ -0,0 +1,102 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubeconfig
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	kubeconfigmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubeconfig"
+)
+
+func DataSourceKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubeconfigRead,
+		Schema:      kubeconfigSchema,
+	}
+}
+
+func dataSourceKubeconfigRead(_ context.Context, data *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	var resp *kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponse
+
+	config := m.(authctx.TanzuContext)
+	fn := &kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterFullName{
+		ManagementClusterName: data.Get(ManagementClusterNameKey).(string),
+		ProvisionerName:       data.Get(ProvisionerNameKey).(string),
+		Name:                  data.Get(NameKey).(string),
+	}
+	cliType := kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigCliType(data.Get(CliTypeKey).(string))
+
+	getKubeconfigRetryableFn := func() (retry bool, err error) {
+		resp, err = config.TMCConnection.KubeConfigResourceService.KubeconfigServiceGetForCLI(fn, cliType)
+		if err != nil {
+			if clienterrors.IsUnauthorizedError(err) {
+				authctx.RefreshUserAuthContext(&config, clienterrors.IsUnauthorizedError, err)
+
+				return true, err
+			}
+
+			return false, err
+		}
+
+		if resp.Status != nil && *resp.Status == kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponseStatusREADY {
+			return false, nil
+		}
+
+		log.Printf("[DEBUG] waiting for cluster(%s)'s kubeconfig to be in READY status", fn.Name)
+
+		return true, nil
+	}
+
+	timeout, err := time.ParseDuration(data.Get(WaitTimeoutKey).(string))
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Invalid %s value, please refer to 'https://pkg.go.dev/time#ParseDuration' for providing the right value", WaitTimeoutKey))
+	}
+
+	if timeout > 0 {
+		_, err = helper.RetryUntilTimeout(getKubeconfigRetryableFn, 10*time.Second, timeout)
+	} else {
+		_, err = getKubeconfigRetryableFn()
+	}
+
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Couldn't read kubeconfig for cluster, name : %s", fn.Name))
+	}
+
+	if resp == nil {
+		return diag.Errorf("Couldn't read kubeconfig for cluster, name : %s", fn.Name)
+	}
+
+	if resp.Status == nil || *resp.Status != kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigGetKubeconfigResponseStatusREADY {
+		return diag.Errorf("Kubeconfig for cluster %s is not ready, status : %s, message : %s", fn.Name, helper.PtrString(resp.Status), resp.Msg)
+	}
+
+	parsed, err := parseKubeconfig(resp.Kubeconfig)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Couldn't read kubeconfig for cluster, name : %s", fn.Name))
+	}
+
+	_ = data.Set(KubeconfigKey, resp.Kubeconfig)
+	_ = data.Set(StatusKey, helper.PtrString(resp.Status))
+	_ = data.Set(HostKey, parsed.host)
+	_ = data.Set(ClusterCACertificateKey, parsed.clusterCACertificate)
+
+	if err := data.Set(ExecKey, parsed.exec); err != nil {
+		return diag.FromErr(errors.Wrap(err, "Couldn't set exec configuration"))
+	}
+
+	data.SetId(strings.Join([]string{fn.ManagementClusterName, fn.ProvisionerName, fn.Name, string(cliType)}, "/"))
+
+	return diags
+}
@@ -0,0 +1,85 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubeconfig
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+type parsedKubeconfig struct {
+	host                 string
+	clusterCACertificate string
+	exec                 []interface{}
+}
+
+// parseKubeconfig extracts the connection details of the current context from a raw kubeconfig.
+// When no current context is set, the first context in alphabetical order is used.
+func parseKubeconfig(raw string) (*parsedKubeconfig, error) {
+	config, err := clientcmd.Load([]byte(raw))
+	if err != nil {
+		return nil, errors.Wrap(err, "Couldn't parse kubeconfig")
+	}
+
+	contextName := config.CurrentContext
+
+	if contextName == "" {
+		contextNames := make([]string, 0, len(config.Contexts))
+
+		for name := range config.Contexts {
+			contextNames = append(contextNames, name)
+		}
+
+		sort.Strings(contextNames)
+
+		if len(contextNames) > 0 {
+			contextName = contextNames[0]
+		}
+	}
+
+	kubeContext, ok := config.Contexts[contextName]
+	if !ok {
+		return nil, errors.Errorf("Kubeconfig context '%s' not found", contextName)
+	}
+
+	parsed := &parsedKubeconfig{}
+
+	if cluster, ok := config.Clusters[kubeContext.Cluster]; ok {
+		parsed.host = cluster.Server
+		parsed.clusterCACertificate = string(cluster.CertificateAuthorityData)
+	}
+
+	if authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]; ok && authInfo.Exec != nil {
+		parsed.exec = flattenExecConfig(authInfo.Exec)
+	}
+
+	return parsed, nil
+}
+
+func flattenExecConfig(execConfig *clientcmdapi.ExecConfig) []interface{} {
+	env := make(map[string]interface{})
+
+	for _, e := range execConfig.Env {
+		env[e.Name] = e.Value
+	}
+
+	args := make([]interface{}, 0, len(execConfig.Args))
+
+	for _, a := range execConfig.Args {
+		args = append(args, a)
+	}
+
+	flatExec := map[string]interface{}{
+		APIVersionKey: execConfig.APIVersion,
+		CommandKey:    execConfig.Command,
+		ArgsKey:       args,
+		EnvKey:        env,
+	}
+
+	return []interface{}{flatExec}
+}
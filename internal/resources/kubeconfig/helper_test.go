@@ -0,0 +1,135 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubeconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCnRlc3QKLS0tLS1FTkQgQ0VSVElGSUNBVEUtLS0tLQo=
+    server: https://10.0.0.1:6443
+  name: test-cluster
+contexts:
+- context:
+    cluster: test-cluster
+    user: tanzu-cli-test-cluster
+  name: tanzu-cli-test-cluster@test-cluster
+current-context: tanzu-cli-test-cluster@test-cluster
+users:
+- name: tanzu-cli-test-cluster
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: tanzu
+      args:
+      - pinniped-auth
+      - login
+      env:
+      - name: PINNIPED_DEBUG
+        value: "true"
+`
+
+func TestParseKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       string
+		expected    *parsedKubeconfig
+		expectErr   bool
+	}{
+		{
+			description: "kubeconfig with exec plugin",
+			input:       testKubeconfig,
+			expected: &parsedKubeconfig{
+				host:                 "https://10.0.0.1:6443",
+				clusterCACertificate: "-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----\n",
+				exec: []interface{}{
+					map[string]interface{}{
+						APIVersionKey: "client.authentication.k8s.io/v1beta1",
+						CommandKey:    "tanzu",
+						ArgsKey:       []interface{}{"pinniped-auth", "login"},
+						EnvKey:        map[string]interface{}{"PINNIPED_DEBUG": "true"},
+					},
+				},
+			},
+		},
+		{
+			description: "kubeconfig without current context",
+			input: `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: https://10.0.0.2:6443
+  name: test-cluster
+contexts:
+- context:
+    cluster: test-cluster
+    user: admin
+  name: admin@test-cluster
+users:
+- name: admin
+  user:
+    token: abc
+`,
+			expected: &parsedKubeconfig{
+				host: "https://10.0.0.2:6443",
+			},
+		},
+		{
+			description: "invalid kubeconfig",
+			input:       "not: [valid",
+			expectErr:   true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := parseKubeconfig(test.input)
+			if test.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestValidateWaitTimeout(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       string
+		valid       bool
+	}{
+		{description: "no wait", input: "0", valid: true},
+		{description: "one second", input: "1s", valid: true},
+		{description: "minutes", input: "10m", valid: true},
+		{description: "under a second", input: "500ms", valid: false},
+		{description: "negative", input: "-1m", valid: false},
+		{description: "not a duration", input: "ten minutes", valid: false},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			_, errs := validateWaitTimeout(test.input, WaitTimeoutKey)
+			require.Equal(t, test.valid, len(errs) == 0)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubeconfig
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	kubeconfigmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kubeconfig"
+)
+
+const (
+	ResourceName = "tanzu-mission-control_kubeconfig"
+
+	// Root Keys.
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+	NameKey                  = "name"
+	CliTypeKey               = "cli_type"
+	WaitTimeoutKey           = "ready_wait_timeout"
+
+	// Computed Keys.
+	KubeconfigKey           = "kubeconfig"
+	StatusKey               = "status"
+	HostKey                 = "host"
+	ClusterCACertificateKey = "cluster_ca_certificate"
+	ExecKey                 = "exec"
+	APIVersionKey           = "api_version"
+	CommandKey              = "command"
+	ArgsKey                 = "args"
+	EnvKey                  = "env"
+
+	attachedValue      = "attached"
+	defaultWaitTimeout = "5m"
+)
+
+var cliTypes = []string{
+	string(kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigCliTypeTANZUCLI),
+	string(kubeconfigmodels.VmwareTanzuManageV1alpha1ClusterKubeconfigCliTypeTMCCLI),
+}
+
+var kubeconfigSchema = map[string]*schema.Schema{
+	ManagementClusterNameKey: managementClusterNameSchema,
+	ProvisionerNameKey:       provisionerNameSchema,
+	NameKey:                  nameSchema,
+	CliTypeKey:               cliTypeSchema,
+	WaitTimeoutKey:           waitTimeoutSchema,
+	KubeconfigKey:            kubeconfigRawSchema,
+	StatusKey:                statusSchema,
+	HostKey:                  hostSchema,
+	ClusterCACertificateKey:  clusterCACertificateSchema,
+	ExecKey:                  execSchema,
+}
+
+var managementClusterNameSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Name of the management cluster, defaults to 'attached' for attached clusters",
+	Default:     attachedValue,
+	Optional:    true,
+}
+
+var provisionerNameSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Provisioner of the cluster, defaults to 'attached' for attached clusters",
+	Default:     attachedValue,
+	Optional:    true,
+}
+
+var nameSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Name of the cluster",
+	Required:    true,
+}
+
+var cliTypeSchema = &schema.Schema{
+	Type: schema.TypeString,
+	Description: fmt.Sprintf("The CLI the kubeconfig is generated for. %s kubeconfigs authenticate through the Tanzu CLI pinniped-auth plugin, "+
+		"%s kubeconfigs authenticate through the TMC CLI.\nValid values are: %v", cliTypes[0], cliTypes[1], cliTypes),
+	Default:      cliTypes[0],
+	Optional:     true,
+	ValidateFunc: validation.StringInSlice(cliTypes, false),
+}
+
+var waitTimeoutSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Description:  "Wait timeout duration until the kubeconfig reaches READY status. Accepted timeout duration values like 5s, 45m, or 3h, of at least 1s. Set to 0 to not wait.",
+	Default:      defaultWaitTimeout,
+	Optional:     true,
+	ValidateFunc: validateWaitTimeout,
+}
+
+// validateWaitTimeout accepts 0 or a duration of at least a second, the waits are counted in whole seconds.
+func validateWaitTimeout(i interface{}, k string) (warnings []string, errs []error) {
+	value, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, []error{fmt.Errorf("invalid %s value %q, please refer to 'https://pkg.go.dev/time#ParseDuration' for providing the right value", k, value)}
+	}
+
+	if timeout < 0 || (timeout > 0 && timeout < time.Second) {
+		return nil, []error{fmt.Errorf("%s must be 0 or at least 1s, got %s", k, value)}
+	}
+
+	return nil, nil
+}
+
+var kubeconfigRawSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Raw kubeconfig of the cluster",
+	Computed:    true,
+	Sensitive:   true,
+}
+
+var statusSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Status of the kubeconfig",
+	Computed:    true,
+}
+
+var hostSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Kubernetes API server endpoint of the current context in the kubeconfig",
+	Computed:    true,
+}
+
+var clusterCACertificateSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "PEM encoded certificate authority data of the current context cluster",
+	Computed:    true,
+}
+
+var execSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Exec credential plugin configuration of the current context user",
+	Computed:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			APIVersionKey: {
+				Type:        schema.TypeString,
+				Description: "API version of the exec credential plugin",
+				Computed:    true,
+			},
+			CommandKey: {
+				Type:        schema.TypeString,
+				Description: "Command to execute",
+				Computed:    true,
+			},
+			ArgsKey: {
+				Type:        schema.TypeList,
+				Description: "Arguments passed to the command",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			EnvKey: {
+				Type:        schema.TypeMap,
+				Description: "Environment variables set when executing the command",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	},
+}
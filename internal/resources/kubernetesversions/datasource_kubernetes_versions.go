@@ -0,0 +1,264 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetesversions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	aksmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/akscluster"
+	clusterclassmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clusterclass"
+	eksmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/ekscluster"
+	provisionermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/provisioner"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+	tanzukubernetesreleasemodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetesrelease"
+)
+
+// clusterClassReadyCondition is the condition reporting whether a cluster class can be used by clusters.
+const clusterClassReadyCondition = "Ready"
+
+func DataSourceKubernetesVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesVersionsRead,
+		Schema:      kubernetesVersionsSchema,
+	}
+}
+
+func dataSourceKubernetesVersionsRead(_ context.Context, data *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	var (
+		versions []*kubernetesVersion
+		idFields []string
+		err      error
+	)
+
+	config := m.(authctx.TanzuContext)
+
+	switch {
+	case isSourceSet(data, TanzuKubernetesReleaseKey):
+		source := getSource(data, TanzuKubernetesReleaseKey)
+		idFields = []string{TanzuKubernetesReleaseKey, source[ManagementClusterNameKey], source[ProvisionerNameKey], source[ClusterClassKey]}
+		versions, err = listTanzuKubernetesReleaseVersions(config, source)
+	case isSourceSet(data, EKSKey):
+		source := getSource(data, EKSKey)
+		idFields = []string{EKSKey, source[CredentialNameKey], source[RegionKey]}
+		versions, err = listEKSVersions(config, source)
+	case isSourceSet(data, AKSKey):
+		source := getSource(data, AKSKey)
+		idFields = []string{AKSKey, source[CredentialNameKey], source[SubscriptionIDKey], source[LocationKey]}
+		versions, err = listAKSVersions(config, source)
+	}
+
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "Couldn't read kubernetes versions"))
+	}
+
+	sortVersions(versions)
+	computeUpgrades(versions)
+
+	defaultVersion := ""
+
+	for _, v := range versions {
+		if v.isDefault {
+			defaultVersion = v.version
+
+			break
+		}
+	}
+
+	if isSourceSet(data, TanzuKubernetesReleaseKey) {
+		if err := setAvailableVersions(data, versions); err != nil {
+			return diag.FromErr(errors.Wrap(err, "Couldn't set available versions"))
+		}
+	}
+
+	if err := data.Set(VersionsKey, flattenVersions(versions)); err != nil {
+		return diag.FromErr(errors.Wrap(err, "Couldn't set kubernetes versions"))
+	}
+
+	_ = data.Set(DefaultVersionKey, defaultVersion)
+
+	if minor, ok := data.GetOk(LatestPatchOfKey); ok {
+		latestPatch := latestPatchOf(versions, minor.(string))
+		if latestPatch == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "No version found for " + minor.(string),
+			})
+		}
+
+		_ = data.Set(LatestPatchVersionKey, latestPatch)
+	}
+
+	data.SetId(strings.Join(idFields, "/"))
+
+	return diags
+}
+
+func isSourceSet(data *schema.ResourceData, sourceKey string) bool {
+	_, ok := data.GetOk(sourceKey)
+
+	return ok
+}
+
+func getSource(data *schema.ResourceData, sourceKey string) map[string]string {
+	source := make(map[string]string)
+
+	for k, v := range data.Get(sourceKey).([]interface{})[0].(map[string]interface{}) {
+		source[k], _ = v.(string)
+	}
+
+	return source
+}
+
+// setAvailableVersions sets the versions of the compatible Tanzu Kubernetes Releases in the source block.
+func setAvailableVersions(data *schema.ResourceData, versions []*kubernetesVersion) error {
+	source := data.Get(TanzuKubernetesReleaseKey).([]interface{})[0].(map[string]interface{})
+	availableVersions := make([]interface{}, 0, len(versions))
+
+	for _, v := range versions {
+		availableVersions = append(availableVersions, v.version)
+	}
+
+	source[AvailableVersionsKey] = availableVersions
+
+	return data.Set(TanzuKubernetesReleaseKey, []interface{}{source})
+}
+
+func listTanzuKubernetesReleaseVersions(config authctx.TanzuContext, source map[string]string) ([]*kubernetesVersion, error) {
+	managementClusterName := source[ManagementClusterNameKey]
+	provisionerName := source[ProvisionerNameKey]
+
+	if err := verifyProvisioner(config, managementClusterName, provisionerName); err != nil {
+		return nil, err
+	}
+
+	if clusterClassName := source[ClusterClassKey]; clusterClassName != "" {
+		if err := verifyClusterClass(config, managementClusterName, provisionerName, clusterClassName); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := config.TMCConnection.TanzuKubernetesReleaseResourceService.TanzuKubernetesReleaseResourceServiceList(
+		&tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseFullName{
+			ManagementClusterName: managementClusterName,
+			ProvisionerName:       provisionerName,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Couldn't list Tanzu Kubernetes Releases.\nManagement Cluster Name: %s, Provisioner: %s", managementClusterName, provisionerName)
+	}
+
+	return tanzuKubernetesReleaseVersions(resp.TanzuKubernetesReleases), nil
+}
+
+func listEKSVersions(config authctx.TanzuContext, source map[string]string) ([]*kubernetesVersion, error) {
+	resp, err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGetKubernetesVersions(
+		&eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName{
+			CredentialName: source[CredentialNameKey],
+			Region:         source[RegionKey],
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*kubernetesVersion, 0, len(resp.KubernetesVersions))
+
+	for _, v := range resp.KubernetesVersions {
+		versions = append(versions, &kubernetesVersion{
+			version:           v.Version,
+			kubernetesVersion: v.Version,
+			isDefault:         v.IsDefault,
+			upgrades:          v.Upgrades,
+		})
+	}
+
+	return versions, nil
+}
+
+func listAKSVersions(config authctx.TanzuContext, source map[string]string) ([]*kubernetesVersion, error) {
+	resp, err := config.TMCConnection.AKSClusterResourceService.AksClusterResourceServiceGetKubernetesVersions(
+		&aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName{
+			CredentialName: source[CredentialNameKey],
+			SubscriptionID: source[SubscriptionIDKey],
+		},
+		source[LocationKey],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*kubernetesVersion, 0, len(resp.KubernetesVersions))
+
+	for _, v := range resp.KubernetesVersions {
+		versions = append(versions, &kubernetesVersion{
+			version:           v.Version,
+			kubernetesVersion: v.Version,
+			isDefault:         v.IsDefault,
+			upgrades:          v.Upgrades,
+		})
+	}
+
+	return versions, nil
+}
+
+// verifyProvisioner checks whether the provisioner exists in the management cluster.
+func verifyProvisioner(config authctx.TanzuContext, managementClusterName, provisionerName string) error {
+	resp, err := config.TMCConnection.ProvisionerResourceService.ProvisionerResourceServiceList(
+		&provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName{
+			ManagementClusterName: managementClusterName,
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Couldn't list provisioners.\nManagement Cluster Name: %s", managementClusterName)
+	}
+
+	for _, p := range resp.Provisioners {
+		if p.FullName != nil && p.FullName.Name == provisionerName {
+			return nil
+		}
+	}
+
+	return errors.Errorf("Couldn't find provisioner.\nManagement Cluster Name: %s, Provisioner: %s", managementClusterName, provisionerName)
+}
+
+// verifyClusterClass checks whether the cluster class exists and is ready to be used by clusters.
+func verifyClusterClass(config authctx.TanzuContext, managementClusterName, provisionerName, clusterClassName string) error {
+	clusterClassFn := &clusterclassmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerClusterClassFullName{
+		ManagementClusterName: managementClusterName,
+		ProvisionerName:       provisionerName,
+		Name:                  clusterClassName,
+	}
+
+	resp, err := config.TMCConnection.ClusterClassResourceService.ClusterClassResourceServiceGet(clusterClassFn)
+	if err != nil {
+		return errors.Wrapf(err, "Couldn't find cluster class.\nManagement Cluster Name: %s, Provisioner: %s, Cluster Class Name: %s",
+			managementClusterName, provisionerName, clusterClassName)
+	} else if len(resp.ClusterClasses) == 0 {
+		return errors.Errorf("Couldn't find cluster class.\nManagement Cluster Name: %s, Provisioner: %s, Cluster Class Name: %s",
+			managementClusterName, provisionerName, clusterClassName)
+	}
+
+	return clusterClassReadiness(clusterClassName, resp.ClusterClasses[0])
+}
+
+// clusterClassReadiness returns an error when the cluster class reports that it is not ready.
+func clusterClassReadiness(name string, clusterClass *clusterclassmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerClusterClass) error {
+	if clusterClass.Status == nil {
+		return nil
+	}
+
+	condition, ok := clusterClass.Status.Conditions[clusterClassReadyCondition]
+	if ok && condition.Status != nil && *condition.Status != statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE {
+		return errors.Errorf("Cluster class %s is not ready: %s", name, condition.Message)
+	}
+
+	return nil
+}
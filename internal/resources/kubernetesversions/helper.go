@@ -0,0 +1,159 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetesversions
+
+import (
+	"sort"
+
+	goversion "github.com/hashicorp/go-version"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	tanzukubernetesreleasemodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetesrelease"
+)
+
+type kubernetesVersion struct {
+	version           string
+	kubernetesVersion string
+	isDefault         bool
+	upgrades          []string
+}
+
+// IsValidUpgrade checks whether a cluster can be upgraded from one version to another.
+// Kubernetes only allows upgrading to a newer version of the same minor version or of the next minor version, minor versions can't be skipped.
+func IsValidUpgrade(from, to string) bool {
+	fromVersion, err := goversion.NewVersion(from)
+	if err != nil {
+		return false
+	}
+
+	toVersion, err := goversion.NewVersion(to)
+	if err != nil {
+		return false
+	}
+
+	fromSegments := fromVersion.Segments()
+	toSegments := toVersion.Segments()
+
+	if fromSegments[0] != toSegments[0] || toSegments[1] > fromSegments[1]+1 {
+		return false
+	}
+
+	return helper.CompareVersions(to, from) > 0
+}
+
+// isPatchOf checks whether a version is a patch version of a minor version, e.g. 1.28.3 is a patch version of 1.28.
+func isPatchOf(v, minor string) bool {
+	patchVersion, err := goversion.NewVersion(v)
+	if err != nil {
+		return false
+	}
+
+	minorVersion, err := goversion.NewVersion(minor)
+	if err != nil {
+		return false
+	}
+
+	patchSegments := patchVersion.Segments()
+	minorSegments := minorVersion.Segments()
+
+	return patchSegments[0] == minorSegments[0] && patchSegments[1] == minorSegments[1]
+}
+
+// sortVersions sorts versions from the newest to the oldest.
+func sortVersions(versions []*kubernetesVersion) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return helper.CompareVersions(versions[i].version, versions[j].version) > 0
+	})
+}
+
+// computeUpgrades sets the upgrade paths of the versions which don't have any from the available versions.
+func computeUpgrades(versions []*kubernetesVersion) {
+	for _, v := range versions {
+		if len(v.upgrades) > 0 {
+			continue
+		}
+
+		for _, candidate := range versions {
+			if IsValidUpgrade(v.version, candidate.version) {
+				v.upgrades = append(v.upgrades, candidate.version)
+			}
+		}
+	}
+}
+
+// kubernetesVersionOf returns the Kubernetes version of a version without its build metadata, e.g. 1.28.3 for v1.28.3+vmware.1-tkg.1.
+func kubernetesVersionOf(v string) string {
+	parsed, err := goversion.NewVersion(v)
+	if err != nil {
+		return v
+	}
+
+	return parsed.Core().String()
+}
+
+// tanzuKubernetesReleaseVersions returns the versions of the compatible Tanzu Kubernetes Releases.
+// Tanzu Kubernetes Releases don't have a default, the newest compatible release is used as default.
+func tanzuKubernetesReleaseVersions(tkrs []*tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesRelease) []*kubernetesVersion {
+	versions := make([]*kubernetesVersion, 0, len(tkrs))
+
+	for _, tkr := range tkrs {
+		if tkr == nil || tkr.Spec == nil || tkr.Status == nil || !tkr.Status.Compatible || tkr.Spec.Version == "" {
+			continue
+		}
+
+		k8sVersion := tkr.Spec.KubernetesVersion
+		if k8sVersion == "" {
+			k8sVersion = kubernetesVersionOf(tkr.Spec.Version)
+		}
+
+		versions = append(versions, &kubernetesVersion{
+			version:           tkr.Spec.Version,
+			kubernetesVersion: k8sVersion,
+			upgrades:          tkr.Status.UpdatesAvailable,
+		})
+	}
+
+	sortVersions(versions)
+
+	if len(versions) > 0 {
+		versions[0].isDefault = true
+	}
+
+	return versions
+}
+
+// latestPatchOf returns the newest version which is a patch version of the minor version.
+func latestPatchOf(versions []*kubernetesVersion, minor string) string {
+	latest := ""
+
+	for _, v := range versions {
+		if isPatchOf(v.version, minor) && (latest == "" || helper.CompareVersions(v.version, latest) > 0) {
+			latest = v.version
+		}
+	}
+
+	return latest
+}
+
+func flattenVersions(versions []*kubernetesVersion) []interface{} {
+	flatVersions := make([]interface{}, 0, len(versions))
+
+	for _, v := range versions {
+		upgrades := make([]interface{}, 0, len(v.upgrades))
+
+		for _, u := range v.upgrades {
+			upgrades = append(upgrades, u)
+		}
+
+		flatVersions = append(flatVersions, map[string]interface{}{
+			VersionKey:           v.version,
+			KubernetesVersionKey: v.kubernetesVersion,
+			DefaultKey:           v.isDefault,
+			UpgradesKey:          upgrades,
+		})
+	}
+
+	return flatVersions
+}
@@ -0,0 +1,105 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetesversions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tanzukubernetesreleasemodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetesrelease"
+)
+
+func TestIsValidUpgrade(t *testing.T) {
+	cases := []struct {
+		name     string
+		from     string
+		to       string
+		expected bool
+	}{
+		{name: "patch upgrade", from: "1.28.3", to: "1.28.5", expected: true},
+		{name: "minor upgrade", from: "1.28.3", to: "1.29.1", expected: true},
+		{name: "skipping a minor version", from: "1.27.3", to: "1.29.1", expected: false},
+		{name: "downgrade", from: "1.28.5", to: "1.28.3", expected: false},
+		{name: "same version", from: "1.28.3", to: "1.28.3", expected: false},
+		{name: "major upgrade", from: "1.28.3", to: "2.0.0", expected: false},
+		{name: "tkr build upgrade", from: "v1.28.3+vmware.1-tkg.1", to: "v1.28.3+vmware.1-tkg.2", expected: true},
+		{name: "invalid version", from: "latest", to: "1.28.3", expected: false},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, IsValidUpgrade(test.from, test.to))
+		})
+	}
+}
+
+func TestComputeUpgrades(t *testing.T) {
+	versions := []*kubernetesVersion{
+		{version: "1.27.9"},
+		{version: "1.29.1"},
+		{version: "1.28.5", upgrades: []string{"1.29.1"}},
+		{version: "1.28.3"},
+	}
+
+	sortVersions(versions)
+	computeUpgrades(versions)
+
+	actual := make(map[string][]string)
+
+	for _, v := range versions {
+		actual[v.version] = v.upgrades
+	}
+
+	require.Equal(t, []string{"1.29.1", "1.28.5", "1.28.3", "1.27.9"}, []string{versions[0].version, versions[1].version, versions[2].version, versions[3].version})
+	require.Empty(t, actual["1.29.1"])
+	require.Equal(t, []string{"1.29.1"}, actual["1.28.5"])
+	require.Equal(t, []string{"1.29.1", "1.28.5"}, actual["1.28.3"])
+	require.Equal(t, []string{"1.28.5", "1.28.3"}, actual["1.27.9"])
+}
+
+func TestLatestPatchOf(t *testing.T) {
+	versions := []*kubernetesVersion{
+		{version: "v1.28.3+vmware.1-tkg.1"},
+		{version: "v1.28.10+vmware.1-tkg.1"},
+		{version: "v1.29.1+vmware.1-tkg.1"},
+	}
+
+	require.Equal(t, "v1.28.10+vmware.1-tkg.1", latestPatchOf(versions, "1.28"))
+	require.Equal(t, "v1.29.1+vmware.1-tkg.1", latestPatchOf(versions, "v1.29"))
+	require.Empty(t, latestPatchOf(versions, "1.30"))
+}
+
+func TestTanzuKubernetesReleaseVersions(t *testing.T) {
+	tkr := func(version, kubernetesVersion string, compatible bool, updates ...string) *tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesRelease {
+		return &tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesRelease{
+			Spec: &tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseSpec{
+				Version:           version,
+				KubernetesVersion: kubernetesVersion,
+			},
+			Status: &tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseStatus{
+				Compatible:       compatible,
+				UpdatesAvailable: updates,
+			},
+		}
+	}
+
+	versions := tanzuKubernetesReleaseVersions([]*tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesRelease{
+		tkr("v1.28.3+vmware.1-tkg.1", "v1.28.3", true, "v1.29.1+vmware.1-tkg.1"),
+		tkr("v1.30.1+vmware.1-tkg.1", "v1.30.1", false),
+		nil,
+		tkr("v1.29.1+vmware.1-tkg.1", "", true),
+		{Spec: &tanzukubernetesreleasemodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzuKubernetesReleaseSpec{Version: "v1.27.9+vmware.1-tkg.1"}},
+	})
+
+	require.Len(t, versions, 2)
+	require.Equal(t, "v1.29.1+vmware.1-tkg.1", versions[0].version)
+	require.Equal(t, "1.29.1", versions[0].kubernetesVersion)
+	require.True(t, versions[0].isDefault)
+	require.Equal(t, "v1.28.3+vmware.1-tkg.1", versions[1].version)
+	require.Equal(t, "v1.28.3", versions[1].kubernetesVersion)
+	require.False(t, versions[1].isDefault)
+	require.Equal(t, []string{"v1.29.1+vmware.1-tkg.1"}, versions[1].upgrades)
+}
@@ -0,0 +1,189 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetesversions
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	ResourceName = "tanzu-mission-control_kubernetes_versions"
+
+	// Root Keys.
+	TanzuKubernetesReleaseKey = "tanzu_kubernetes_release"
+	EKSKey                    = "eks"
+	AKSKey                    = "aks"
+	LatestPatchOfKey          = "latest_patch_of"
+
+	// Source Keys.
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+	ClusterClassKey          = "cluster_class"
+	AvailableVersionsKey     = "available_versions"
+	CredentialNameKey        = "credential_name"
+	RegionKey                = "region"
+	SubscriptionIDKey        = "subscription_id"
+	LocationKey              = "location"
+
+	// Computed Keys.
+	VersionsKey           = "versions"
+	VersionKey            = "version"
+	KubernetesVersionKey  = "kubernetes_version"
+	DefaultKey            = "default"
+	UpgradesKey           = "upgrades"
+	DefaultVersionKey     = "default_version"
+	LatestPatchVersionKey = "latest_patch_version"
+)
+
+var sourceKeys = []string{TanzuKubernetesReleaseKey, EKSKey, AKSKey}
+
+var kubernetesVersionsSchema = map[string]*schema.Schema{
+	TanzuKubernetesReleaseKey: tanzuKubernetesReleaseSchema,
+	EKSKey:                    eksSchema,
+	AKSKey:                    aksSchema,
+	LatestPatchOfKey:          latestPatchOfSchema,
+	VersionsKey:               versionsSchema,
+	DefaultVersionKey:         defaultVersionSchema,
+	LatestPatchVersionKey:     latestPatchVersionSchema,
+}
+
+var tanzuKubernetesReleaseSchema = &schema.Schema{
+	Type:         schema.TypeList,
+	Description:  "Lists the Tanzu Kubernetes Releases compatible with a provisioner, the versions can be used for tanzu_kubernetes_cluster resources",
+	Optional:     true,
+	MaxItems:     1,
+	ExactlyOneOf: sourceKeys,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			ManagementClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Management cluster name",
+				Required:    true,
+			},
+			ProvisionerNameKey: {
+				Type:        schema.TypeString,
+				Description: "Cluster provisioner name",
+				Required:    true,
+			},
+			ClusterClassKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the cluster class the versions are used with, the cluster class must be ready",
+				Optional:    true,
+			},
+			AvailableVersionsKey: {
+				Type:        schema.TypeList,
+				Description: "Versions of the Tanzu Kubernetes Releases compatible with the provisioner (e.g. v1.28.3+vmware.1-tkg.1)",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	},
+}
+
+var eksSchema = &schema.Schema{
+	Type:         schema.TypeList,
+	Description:  "Lists the Kubernetes versions supported by EKS, the versions can be used for ekscluster resources",
+	Optional:     true,
+	MaxItems:     1,
+	ExactlyOneOf: sourceKeys,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			CredentialNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the AWS credential",
+				Required:    true,
+			},
+			RegionKey: {
+				Type:        schema.TypeString,
+				Description: "AWS region",
+				Required:    true,
+			},
+		},
+	},
+}
+
+var aksSchema = &schema.Schema{
+	Type:         schema.TypeList,
+	Description:  "Lists the Kubernetes versions supported by AKS, the versions can be used for akscluster resources",
+	Optional:     true,
+	MaxItems:     1,
+	ExactlyOneOf: sourceKeys,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			CredentialNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the Azure credential",
+				Required:    true,
+			},
+			SubscriptionIDKey: {
+				Type:        schema.TypeString,
+				Description: "Azure subscription ID",
+				Required:    true,
+			},
+			LocationKey: {
+				Type:        schema.TypeString,
+				Description: "Azure location",
+				Required:    true,
+			},
+		},
+	},
+}
+
+var latestPatchOfSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Description:  "Kubernetes minor version (e.g. 1.28) to get the latest patch version of",
+	Optional:     true,
+	ValidateFunc: validation.StringMatch(regexp.MustCompile(`^v?\d+\.\d+$`), "must be a Kubernetes minor version, e.g. 1.28"),
+}
+
+var versionsSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Supported versions sorted from the newest to the oldest",
+	Computed:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			VersionKey: {
+				Type:        schema.TypeString,
+				Description: "Version to be used in the cluster resource",
+				Computed:    true,
+			},
+			KubernetesVersionKey: {
+				Type:        schema.TypeString,
+				Description: "Kubernetes version",
+				Computed:    true,
+			},
+			DefaultKey: {
+				Type:        schema.TypeBool,
+				Description: "Whether the version is the default version",
+				Computed:    true,
+			},
+			UpgradesKey: {
+				Type:        schema.TypeList,
+				Description: "Versions the version can be upgraded to",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	},
+}
+
+var defaultVersionSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Default version, for Tanzu Kubernetes Releases the newest compatible version",
+	Computed:    true,
+}
+
+var latestPatchVersionSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Latest patch version of the minor version set in latest_patch_of",
+	Computed:    true,
+}
@@ -19,7 +19,9 @@ import (
 	kustomizationclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/clustergroup"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/readiness"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kustomization/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kustomization/spec"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kustomization/status"
@@ -57,10 +59,11 @@ var kustomizationSchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	commonscope.ScopeKey: scope.ScopeSchema,
-	common.MetaKey:       common.Meta,
-	spec.SpecKey:         spec.SpecSchema,
-	statusKey:            status.StatusSchema,
+	commonscope.ScopeKey:      scope.ScopeSchema,
+	common.MetaKey:            common.Meta,
+	spec.SpecKey:              spec.SpecSchema,
+	statusKey:                 status.StatusSchema,
+	readiness.WaitForReadyKey: readiness.WaitForReadySchema,
 }
 
 func resourceKustomizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -214,11 +217,15 @@ func resourceKustomizationCreate(ctx context.Context, d *schema.ResourceData, m
 		meta = common.ConstructMeta(d)
 	)
 
-	err := enableContinuousDelivery(&config, scopedFullnameData, meta)
+	implicitlyEnabled, err := enableContinuousDelivery(&config, scopedFullnameData, meta)
 	if err != nil {
 		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control kustomization entry, name : %s", kustomizationName))
 	}
 
+	if implicitlyEnabled {
+		diags = append(diags, continuousdelivery.ImplicitEnablementWarning(ResourceName))
+	}
+
 	switch scopedFullnameData.Scope {
 	case commonscope.ClusterScope:
 		if scopedFullnameData.FullnameCluster != nil {
@@ -261,7 +268,12 @@ func resourceKustomizationCreate(ctx context.Context, d *schema.ResourceData, m
 	// always run
 	d.SetId(UID)
 
-	return resourceKustomizationRead(ctx, d, m)
+	if err := waitForReady(config, d, scopedFullnameData); err != nil {
+		diags = append(diags, resourceKustomizationRead(ctx, d, m)...)
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceKustomizationRead(ctx, d, m)...)
 }
 
 func resourceKustomizationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -388,6 +400,10 @@ func resourceKustomizationInPlaceUpdate(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[INFO] kustomization update successful")
 
+	if err := waitForReady(config, d, scopedFullnameData); err != nil {
+		return append(resourceKustomizationRead(ctx, d, m), diag.FromErr(err)...)
+	}
+
 	return resourceKustomizationRead(ctx, d, m)
 }
 
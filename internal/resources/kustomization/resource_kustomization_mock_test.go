@@ -282,6 +282,10 @@ func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
 
 	postContinuousDeliveryEndpoint := (helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ScopeHelperResources.Cluster.Name, cdAPIKind)).String()
 
+	// Continuous delivery is not enabled yet, so it gets enabled implicitly.
+	httpmock.RegisterResponder("GET", postContinuousDeliveryEndpoint,
+		bodyInspectingResponder(t, nil, 200, &continuousdeliveryclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdContinuousdeliveryListContinuousDeliveriesResponse{}))
+
 	httpmock.RegisterResponder("POST", postContinuousDeliveryEndpoint,
 		bodyInspectingResponder(t, postContinuousDeliveryRequest, 200, postContinuousDeliveryResponse))
 
@@ -349,6 +353,10 @@ func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
 
 	postCDContinuousDeliveryEndpoint := (helper.ConstructRequestURL(https, endpoint, cgAPIVersionAndGroup, testConfig.ScopeHelperResources.ClusterGroup.Name, cdAPIKind)).String()
 
+	// Continuous delivery is not enabled yet, so it gets enabled implicitly.
+	httpmock.RegisterResponder("GET", postCDContinuousDeliveryEndpoint,
+		bodyInspectingResponder(t, nil, 200, &continuousdeliveryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdContinuousdeliveryListContinuousDeliveriesResponse{}))
+
 	httpmock.RegisterResponder("POST", postCDContinuousDeliveryEndpoint,
 		bodyInspectingResponder(t, postCGContinuousDeliveryRequest, 200, postCGContinuousDeliveryResponse))
 
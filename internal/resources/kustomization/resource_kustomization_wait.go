@@ -0,0 +1,54 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kustomization
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/readiness"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kustomization/scope"
+)
+
+// waitForReady waits until the kustomization is reconciled by Flux when wait_for_ready is set.
+func waitForReady(config authctx.TanzuContext, d *schema.ResourceData, scopedFullnameData *scope.ScopedFullname) error {
+	return readiness.WaitForReady(d, scopedFullnameData.Scope, fmt.Sprintf("kustomization %s", d.Get(nameKey)), &readiness.StatusFuncs{
+		Cluster: func() (*readiness.ClusterStatus, error) {
+			resp, err := config.TMCConnection.ClusterKustomizationResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdKustomizationResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.Kustomization.Status == nil {
+				return nil, nil
+			}
+
+			return &readiness.ClusterStatus{
+				Meta:               resp.Kustomization.Meta,
+				ObservedGeneration: resp.Kustomization.Status.ObservedGeneration,
+				State:              readiness.ConditionState(resp.Kustomization.Status.Conditions),
+			}, nil
+		},
+		ClusterGroup: func() (*readiness.BatchStatus, error) {
+			resp, err := config.TMCConnection.ClusterGroupKustomizationResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdKustomizationResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.Kustomization.Status == nil {
+				return nil, nil
+			}
+
+			return &readiness.BatchStatus{
+				Meta:               resp.Kustomization.Meta,
+				ObservedGeneration: resp.Kustomization.Status.ObservedGeneration,
+				Phase:              resp.Kustomization.Status.Phase,
+				Details:            resp.Kustomization.Status.Details,
+			}, nil
+		},
+	})
+}
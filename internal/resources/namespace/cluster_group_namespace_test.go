@@ -0,0 +1,243 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func TestIsNamespaceSynced(t *testing.T) {
+	t.Parallel()
+
+	meta := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		Labels:      map[string]string{"team": "platform"},
+		Annotations: map[string]string{"owner": "platform-team"},
+		Description: "shared namespace",
+	}
+	removed := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		Labels:      map[string]string{"tier": "gold"},
+		Annotations: map[string]string{"contact": "ops"},
+	}
+	spec := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: "platform"}
+
+	cases := []struct {
+		description string
+		namespace   *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace
+		expected    bool
+	}{
+		{
+			description: "missing namespace",
+			namespace:   nil,
+			expected:    false,
+		},
+		{
+			description: "namespace with the labels, annotations, description and workspace of the resource",
+			namespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+					Labels:      map[string]string{"team": "platform", common.CreatorLabelKey: "someone"},
+					Annotations: map[string]string{"owner": "platform-team", "tmc.cloud.vmware.com/managed": "true"},
+					Description: "shared namespace",
+				},
+				Spec: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: "platform"},
+			},
+			expected: true,
+		},
+		{
+			description: "namespace in another workspace",
+			namespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+					Labels:      map[string]string{"team": "platform"},
+					Description: "shared namespace",
+				},
+				Spec: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: "default"},
+			},
+			expected: false,
+		},
+		{
+			description: "namespace with a different label value",
+			namespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+					Labels:      map[string]string{"team": "data"},
+					Annotations: map[string]string{"owner": "platform-team"},
+					Description: "shared namespace",
+				},
+				Spec: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: "platform"},
+			},
+			expected: false,
+		},
+		{
+			description: "namespace with a removed label",
+			namespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+					Labels:      map[string]string{"team": "platform", "tier": "gold"},
+					Annotations: map[string]string{"owner": "platform-team"},
+					Description: "shared namespace",
+				},
+				Spec: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: "platform"},
+			},
+			expected: false,
+		},
+		{
+			description: "namespace with a removed annotation",
+			namespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+					Labels:      map[string]string{"team": "platform"},
+					Annotations: map[string]string{"owner": "platform-team", "contact": "ops"},
+					Description: "shared namespace",
+				},
+				Spec: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: "platform"},
+			},
+			expected: false,
+		},
+		{
+			description: "namespace without the annotation",
+			namespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+					Labels:      map[string]string{"team": "platform"},
+					Description: "shared namespace",
+				},
+				Spec: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: "platform"},
+			},
+			expected: false,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, isNamespaceSynced(test.namespace, meta, removed, spec))
+		})
+	}
+}
+
+func TestMergeManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	actual := map[string]string{"team": "data", "tier": "gold", common.CreatorLabelKey: "someone"}
+	configured := map[string]string{"team": "platform"}
+	removed := map[string]string{"tier": "gold"}
+
+	require.Equal(t, map[string]string{"team": "platform", common.CreatorLabelKey: "someone"}, mergeManagedKeys(actual, configured, removed))
+}
+
+func TestRemovedMemberMeta(t *testing.T) {
+	t.Parallel()
+
+	resource := ResourceClusterGroupNamespace()
+	state := &terraform.InstanceState{
+		ID: "group/shared",
+		Attributes: map[string]string{
+			"id":                         "group/shared",
+			NameKey:                      "shared",
+			ClusterGroupNameKey:          "group",
+			"meta.#":                     "1",
+			"meta.0.labels.%":            "2",
+			"meta.0.labels.team":         "platform",
+			"meta.0.labels.tier":         "gold",
+			"meta.0.annotations.%":       "1",
+			"meta.0.annotations.contact": "ops",
+		},
+	}
+
+	diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		NameKey:             "shared",
+		ClusterGroupNameKey: "group",
+		common.MetaKey: []interface{}{
+			map[string]interface{}{
+				common.LabelsKey:      map[string]interface{}{"team": "platform"},
+				common.AnnotationsKey: map[string]interface{}{"owner": "platform-team"},
+			},
+		},
+	}), nil)
+	require.NoError(t, err)
+
+	d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+	require.NoError(t, err)
+
+	removed := removedMemberMeta(d)
+	require.Equal(t, map[string]string{"tier": "gold"}, removed.Labels)
+	require.Equal(t, map[string]string{"contact": "ops"}, removed.Annotations)
+}
+
+func TestFlattenClusterStatus(t *testing.T) {
+	t.Parallel()
+
+	member := &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{
+		Name:                  "cluster-1",
+		ManagementClusterName: attachedValue,
+		ProvisionerName:       attachedValue,
+	}
+	meta := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	spec := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: workspaceNameDefaultValue}
+
+	status := flattenClusterStatus(member, nil, meta, meta, spec, "")
+	require.Equal(t, phaseMissing, status[phaseKey])
+	require.Equal(t, false, status[syncedKey])
+
+	status = flattenClusterStatus(member, nil, meta, meta, spec, "cluster is disconnected")
+	require.Equal(t, phaseUnknown, status[phaseKey])
+	require.Equal(t, "cluster is disconnected", status[phaseInfoKey])
+	require.Equal(t, false, status[syncedKey])
+
+	status = flattenClusterStatus(member, &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+		Spec: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{WorkspaceName: workspaceNameDefaultValue},
+		Status: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceStatus{
+			Phase: namespacemodel.NewVmwareTanzuManageV1alpha1ClusterNamespaceStatusPhase(namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceStatusPhaseREADY),
+		},
+	}, meta, meta, spec, "")
+	require.Equal(t, map[string]interface{}{
+		ClusterNameKey:           "cluster-1",
+		ManagementClusterNameKey: attachedValue,
+		ProvisionerNameKey:       attachedValue,
+		phaseKey:                 "READY",
+		phaseInfoKey:             "",
+		syncedKey:                true,
+	}, status)
+}
+
+func TestHasUnsyncedClusters(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, hasUnsyncedClusters(nil))
+	require.False(t, hasUnsyncedClusters([]interface{}{map[string]interface{}{syncedKey: true}}))
+	require.True(t, hasUnsyncedClusters([]interface{}{
+		map[string]interface{}{syncedKey: true},
+		map[string]interface{}{syncedKey: false},
+	}))
+}
+
+func TestCreatedNamespaces(t *testing.T) {
+	t.Parallel()
+
+	existing := &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{Name: "cluster-1", ManagementClusterName: attachedValue, ProvisionerName: attachedValue}
+	created := &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{Name: "cluster-2", ManagementClusterName: attachedValue, ProvisionerName: attachedValue}
+
+	d := schema.TestResourceDataRaw(t, clusterGroupNamespaceSchema, map[string]interface{}{
+		NameKey:             "shared",
+		ClusterGroupNameKey: "group",
+	})
+
+	require.Empty(t, createdNamespaces(d))
+
+	require.NoError(t, recordCreatedNamespaces(d, []*clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{created}))
+	require.Equal(t, map[string]bool{memberKey(created): true}, createdNamespaces(d))
+
+	// The namespaces which existed on the member clusters aren't recorded, they are kept on destroy.
+	require.NoError(t, d.Set(clustersKey, []interface{}{
+		flattenClusterStatus(existing, nil, &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}, &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}, &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{}, ""),
+		map[string]interface{}{ClusterNameKey: created.Name, ManagementClusterNameKey: created.ManagementClusterName, ProvisionerNameKey: created.ProvisionerName, createdKey: true},
+	}))
+	require.Equal(t, map[string]bool{memberKey(created): true}, createdNamespaces(d))
+}
@@ -16,4 +16,14 @@ const (
 	workspaceNameDefaultValue = "default"
 	attachKey                 = "attach"
 	ResourceName              = "tanzu-mission-control_namespace"
+
+	ClusterGroupResourceName = "tanzu-mission-control_cluster_group_namespace"
+	ClusterGroupNameKey      = "cluster_group_name"
+	clustersKey              = "clusters"
+	phaseKey                 = "phase"
+	phaseInfoKey             = "phase_info"
+	syncedKey                = "synced"
+	createdKey               = "created"
+	phaseMissing             = "MISSING"
+	phaseUnknown             = "UNKNOWN"
 )
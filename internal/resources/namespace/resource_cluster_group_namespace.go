@@ -0,0 +1,574 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+// ResourceClusterGroupNamespace ensures a namespace exists on every member cluster of a cluster group.
+// TMC has no cluster group scoped namespaces, the namespace is created on each member cluster instead.
+func ResourceClusterGroupNamespace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterGroupNamespaceCreate,
+		ReadContext:   resourceClusterGroupNamespaceRead,
+		UpdateContext: resourceClusterGroupNamespaceUpdate,
+		DeleteContext: resourceClusterGroupNamespaceDelete,
+		CustomizeDiff: planClusterGroupNamespaceSync,
+		Schema:        clusterGroupNamespaceSchema,
+	}
+}
+
+var clusterGroupNamespaceSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the namespace.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ClusterGroupNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the cluster group whose member clusters the namespace is created on.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	specKey:        namespaceSpec,
+	clustersKey: {
+		Type:        schema.TypeList,
+		Description: "Status of the namespace on each member cluster of the cluster group.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ClusterNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the cluster.",
+					Computed:    true,
+				},
+				ManagementClusterNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the management cluster of the cluster.",
+					Computed:    true,
+				},
+				ProvisionerNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the provisioner of the cluster.",
+					Computed:    true,
+				},
+				phaseKey: {
+					Type:        schema.TypeString,
+					Description: "Phase of the namespace on the cluster, MISSING when the namespace doesn't exist on the cluster yet.",
+					Computed:    true,
+				},
+				phaseInfoKey: {
+					Type:        schema.TypeString,
+					Description: "Additional info about the phase.",
+					Computed:    true,
+				},
+				syncedKey: {
+					Type:        schema.TypeBool,
+					Description: "Whether the namespace exists on the cluster with the labels, annotations, description and workspace of the resource.",
+					Computed:    true,
+				},
+				createdKey: {
+					Type:        schema.TypeBool,
+					Description: "Whether the namespace was created on the cluster by the resource, only those namespaces are deleted when the resource is destroyed.",
+					Computed:    true,
+				},
+			},
+		},
+	},
+}
+
+func resourceClusterGroupNamespaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	clusterGroupName, _ := d.Get(ClusterGroupNameKey).(string)
+	namespaceName, _ := d.Get(NameKey).(string)
+
+	members, err := listClusterGroupMembers(config, clusterGroupName)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to list the clusters of Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
+	}
+
+	created, ensureErr := ensureNamespaces(config, d, members)
+
+	d.SetId(strings.Join([]string{clusterGroupName, namespaceName}, "/"))
+
+	if err := recordCreatedNamespaces(d, created); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags = resourceClusterGroupNamespaceRead(ctx, d, m)
+
+	if ensureErr != nil {
+		return append(diags, diag.FromErr(ensureErr)...)
+	}
+
+	return diags
+}
+
+func resourceClusterGroupNamespaceRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	clusterGroupName, _ := d.Get(ClusterGroupNameKey).(string)
+	namespaceName, _ := d.Get(NameKey).(string)
+
+	members, err := listClusterGroupMembers(config, clusterGroupName)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "unable to list the clusters of Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
+	}
+
+	meta := constructMemberMeta(d)
+	removed := removedMemberMeta(d)
+	spec := constructSpec(d)
+	created := createdNamespaces(d)
+	clusters := make([]interface{}, 0, len(members))
+
+	// Clusters which left the cluster group are no longer reported, their namespace is kept.
+	for _, member := range members {
+		var status map[string]interface{}
+
+		resp, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceGet(memberNamespaceFullname(member, namespaceName))
+
+		switch {
+		case clienterrors.IsNotFoundError(err):
+			status = flattenClusterStatus(member, nil, meta, removed, spec, "")
+		case err != nil:
+			status = flattenClusterStatus(member, nil, meta, removed, spec, err.Error())
+		default:
+			status = flattenClusterStatus(member, resp.Namespace, meta, removed, spec, "")
+		}
+
+		status[createdKey] = created[memberKey(member)]
+		clusters = append(clusters, status)
+	}
+
+	if err := d.Set(clustersKey, clusters); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceClusterGroupNamespaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	clusterGroupName, _ := d.Get(ClusterGroupNameKey).(string)
+
+	members, err := listClusterGroupMembers(config, clusterGroupName)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to list the clusters of Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
+	}
+
+	created, ensureErr := ensureNamespaces(config, d, members)
+
+	if err := recordCreatedNamespaces(d, created); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags = resourceClusterGroupNamespaceRead(ctx, d, m)
+
+	if ensureErr != nil {
+		return append(diags, diag.FromErr(ensureErr)...)
+	}
+
+	return diags
+}
+
+func resourceClusterGroupNamespaceDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	clusterGroupName, _ := d.Get(ClusterGroupNameKey).(string)
+	namespaceName, _ := d.Get(NameKey).(string)
+
+	members, err := listClusterGroupMembers(config, clusterGroupName)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return diag.FromErr(errors.Wrapf(err, "unable to list the clusters of Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
+	}
+
+	created := createdNamespaces(d)
+
+	var failures []string
+
+	// The namespaces which existed before the resource, e.g. default or kube-system, are kept.
+	for _, member := range members {
+		if !created[memberKey(member)] {
+			continue
+		}
+
+		err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceDelete(memberNamespaceFullname(member, namespaceName))
+		if err != nil && !clienterrors.IsNotFoundError(err) {
+			failures = append(failures, fmt.Sprintf("cluster %s: %v", member.Name, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return diag.Errorf("unable to delete Tanzu Mission Control namespace entry, name : %s, from the clusters of cluster group %s:\n%s",
+			namespaceName, clusterGroupName, strings.Join(failures, "\n"))
+	}
+
+	_ = schema.RemoveFromState(d, m)
+
+	return diags
+}
+
+// planClusterGroupNamespaceSync plans an update when the namespace is missing or out of sync on a member cluster,
+// e.g. when a cluster joined the cluster group since the last apply.
+func planClusterGroupNamespaceSync(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	clusters, _ := diff.Get(clustersKey).([]interface{})
+
+	if hasUnsyncedClusters(clusters) || diff.HasChange(specKey) || diff.HasChange(common.MetaKey) {
+		return diff.SetNewComputed(clustersKey)
+	}
+
+	return nil
+}
+
+func hasUnsyncedClusters(clusters []interface{}) bool {
+	for _, cluster := range clusters {
+		clusterData, _ := cluster.(map[string]interface{})
+
+		if synced, _ := clusterData[syncedKey].(bool); !synced {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listClusterGroupMembers returns the full names of the member clusters of a cluster group sorted by name.
+func listClusterGroupMembers(config authctx.TanzuContext, clusterGroupName string) ([]*clustermodel.VmwareTanzuManageV1alpha1ClusterFullName, error) {
+	resp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceList(&clustermodel.VmwareTanzuManageV1alpha1ClusterSearchScope{
+		ClusterGroupName: clusterGroupName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*clustermodel.VmwareTanzuManageV1alpha1ClusterFullName, 0, len(resp.Clusters))
+
+	for _, cluster := range resp.Clusters {
+		if cluster == nil || cluster.FullName == nil {
+			continue
+		}
+
+		members = append(members, cluster.FullName)
+	}
+
+	sort.SliceStable(members, func(i, j int) bool {
+		if members[i].Name != members[j].Name {
+			return members[i].Name < members[j].Name
+		}
+
+		if members[i].ManagementClusterName != members[j].ManagementClusterName {
+			return members[i].ManagementClusterName < members[j].ManagementClusterName
+		}
+
+		return members[i].ProvisionerName < members[j].ProvisionerName
+	})
+
+	return members, nil
+}
+
+// ensureNamespaces creates the namespace on the member clusters where it is missing and updates it where it is out of sync.
+// It returns the member clusters the namespace was created on, all the member clusters are attempted and the failures are returned together.
+func ensureNamespaces(config authctx.TanzuContext, d *schema.ResourceData, members []*clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) ([]*clustermodel.VmwareTanzuManageV1alpha1ClusterFullName, error) {
+	namespaceName, _ := d.Get(NameKey).(string)
+	clusterGroupName, _ := d.Get(ClusterGroupNameKey).(string)
+	meta := constructMemberMeta(d)
+	removed := removedMemberMeta(d)
+	spec := constructSpec(d)
+
+	var (
+		created  []*clustermodel.VmwareTanzuManageV1alpha1ClusterFullName
+		failures []string
+	)
+
+	for _, member := range members {
+		isCreated, err := ensureNamespace(config, memberNamespaceFullname(member, namespaceName), meta, removed, spec)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("cluster %s: %v", member.Name, err))
+		}
+
+		if isCreated {
+			created = append(created, member)
+		}
+	}
+
+	if len(failures) > 0 {
+		return created, errors.Errorf("unable to create or update Tanzu Mission Control namespace entry, name : %s, on the clusters of cluster group %s:\n%s",
+			namespaceName, clusterGroupName, strings.Join(failures, "\n"))
+	}
+
+	return created, nil
+}
+
+// ensureNamespace creates the namespace when it is missing from the cluster and updates it when it is out of sync,
+// it returns whether the namespace was created. The removed labels and annotations are deleted from the namespace.
+func ensureNamespace(config authctx.TanzuContext, fullname *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName,
+	meta, removed *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, spec *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec) (bool, error) {
+	getResp, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceGet(fullname)
+	if err != nil {
+		if !clienterrors.IsNotFoundError(err) {
+			return false, err
+		}
+
+		_, err = config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceCreate(&namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceRequest{
+			Namespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+				FullName: fullname,
+				Meta:     meta,
+				Spec:     spec,
+			},
+		})
+
+		return err == nil, err
+	}
+
+	namespace := getResp.Namespace
+
+	if isNamespaceSynced(namespace, meta, removed, spec) {
+		return false, nil
+	}
+
+	if namespace.Meta == nil {
+		namespace.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	// The labels and annotations which aren't managed by the resource, e.g. the ones of TMC, are kept.
+	namespace.Meta.Labels = mergeManagedKeys(namespace.Meta.Labels, meta.Labels, removed.Labels)
+	namespace.Meta.Annotations = mergeManagedKeys(namespace.Meta.Annotations, meta.Annotations, removed.Annotations)
+	namespace.Meta.Description = meta.Description
+
+	if namespace.Spec == nil {
+		namespace.Spec = &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{}
+	}
+
+	namespace.Spec.WorkspaceName = spec.WorkspaceName
+
+	_, err = config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceUpdate(&namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceRequest{
+		Namespace: namespace,
+	})
+
+	return false, err
+}
+
+// isNamespaceSynced checks whether a namespace has the labels, annotations, description and workspace of the resource,
+// and none of the removed labels and annotations.
+// Labels and annotations which aren't managed by the resource, e.g. the creator label, are ignored.
+func isNamespaceSynced(namespace *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace,
+	meta, removed *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, spec *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec) bool {
+	if namespace == nil || namespace.Spec == nil || namespace.Spec.WorkspaceName != spec.WorkspaceName {
+		return false
+	}
+
+	actualMeta := namespace.Meta
+	if actualMeta == nil {
+		actualMeta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	if actualMeta.Description != meta.Description {
+		return false
+	}
+
+	for key, value := range meta.Labels {
+		if actual, ok := actualMeta.Labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+
+	for key, value := range meta.Annotations {
+		if actual, ok := actualMeta.Annotations[key]; !ok || actual != value {
+			return false
+		}
+	}
+
+	for key := range removed.Labels {
+		if _, ok := actualMeta.Labels[key]; ok {
+			return false
+		}
+	}
+
+	for key := range removed.Annotations {
+		if _, ok := actualMeta.Annotations[key]; ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeManagedKeys returns the actual labels or annotations of a namespace without the removed keys and with the configured ones.
+func mergeManagedKeys(actual, configured, removed map[string]string) map[string]string {
+	result := make(map[string]string, len(actual)+len(configured))
+
+	for key, value := range actual {
+		if _, ok := removed[key]; !ok {
+			result[key] = value
+		}
+	}
+
+	for key, value := range configured {
+		result[key] = value
+	}
+
+	return result
+}
+
+// createdNamespaces returns the keys of the member clusters the namespace was created on by the resource, as recorded in the state.
+func createdNamespaces(d *schema.ResourceData) map[string]bool {
+	created := make(map[string]bool)
+	clusters, _ := d.Get(clustersKey).([]interface{})
+
+	for _, cluster := range clusters {
+		clusterData, _ := cluster.(map[string]interface{})
+
+		if isCreated, _ := clusterData[createdKey].(bool); isCreated {
+			created[memberKey(&clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{
+				Name:                  clusterData[ClusterNameKey].(string),
+				ManagementClusterName: clusterData[ManagementClusterNameKey].(string),
+				ProvisionerName:       clusterData[ProvisionerNameKey].(string),
+			})] = true
+		}
+	}
+
+	return created
+}
+
+// recordCreatedNamespaces records in the state the member clusters the namespace was just created on,
+// so that it is deleted from them even when reading the namespace fails.
+func recordCreatedNamespaces(d *schema.ResourceData, created []*clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) error {
+	if len(created) == 0 {
+		return nil
+	}
+
+	clusters, _ := d.Get(clustersKey).([]interface{})
+
+	for _, member := range created {
+		clusters = append(clusters, map[string]interface{}{
+			ClusterNameKey:           member.Name,
+			ManagementClusterNameKey: member.ManagementClusterName,
+			ProvisionerNameKey:       member.ProvisionerName,
+			createdKey:               true,
+		})
+	}
+
+	return d.Set(clustersKey, clusters)
+}
+
+func memberKey(member *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) string {
+	return strings.Join([]string{member.ManagementClusterName, member.ProvisionerName, member.Name}, "/")
+}
+
+// constructMemberMeta returns the metadata of the namespace on a member cluster, without the identifiers of an object.
+func constructMemberMeta(d *schema.ResourceData) *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta {
+	meta := common.ConstructMeta(d)
+
+	return &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		Annotations: meta.Annotations,
+		Labels:      meta.Labels,
+		Description: meta.Description,
+	}
+}
+
+// removedMemberMeta returns the labels and annotations of the prior state which are no longer configured,
+// they were managed by the resource and are deleted from the namespace on the member clusters.
+func removedMemberMeta(d *schema.ResourceData) *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta {
+	removed := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		Annotations: make(map[string]string),
+		Labels:      make(map[string]string),
+	}
+
+	prior, _ := d.GetChange(common.MetaKey)
+	priorData, _ := prior.([]interface{})
+
+	if len(priorData) == 0 || priorData[0] == nil {
+		return removed
+	}
+
+	priorMeta, _ := priorData[0].(map[string]interface{})
+	priorLabels, _ := priorMeta[common.LabelsKey].(map[string]interface{})
+	priorAnnotations, _ := priorMeta[common.AnnotationsKey].(map[string]interface{})
+	meta := common.ConstructMeta(d)
+
+	for key, value := range common.GetTypeStringMapData(priorLabels) {
+		if _, ok := meta.Labels[key]; !ok {
+			removed.Labels[key] = value
+		}
+	}
+
+	for key, value := range common.GetTypeStringMapData(priorAnnotations) {
+		if _, ok := meta.Annotations[key]; !ok {
+			removed.Annotations[key] = value
+		}
+	}
+
+	return removed
+}
+
+func memberNamespaceFullname(member *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName, namespaceName string) *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName {
+	return &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+		ClusterName:           member.Name,
+		ManagementClusterName: member.ManagementClusterName,
+		ProvisionerName:       member.ProvisionerName,
+		Name:                  namespaceName,
+	}
+}
+
+// flattenClusterStatus flattens the status of the namespace on a member cluster, a nil namespace is missing from the cluster
+// unless getting it failed with the given error.
+func flattenClusterStatus(member *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName, namespace *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace,
+	meta, removed *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, spec *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec, getError string) map[string]interface{} {
+	status := map[string]interface{}{
+		ClusterNameKey:           member.Name,
+		ManagementClusterNameKey: member.ManagementClusterName,
+		ProvisionerNameKey:       member.ProvisionerName,
+		phaseKey:                 phaseMissing,
+		phaseInfoKey:             "",
+		syncedKey:                false,
+	}
+
+	switch {
+	case getError != "":
+		status[phaseKey] = phaseUnknown
+		status[phaseInfoKey] = getError
+	case namespace != nil:
+		status[phaseKey] = ""
+		status[syncedKey] = isNamespaceSynced(namespace, meta, removed, spec)
+
+		if namespace.Status != nil {
+			if namespace.Status.Phase != nil {
+				status[phaseKey] = string(*namespace.Status.Phase)
+			}
+
+			status[phaseInfoKey] = namespace.Status.PhaseInfo
+		}
+	}
+
+	return status
+}
@@ -0,0 +1,17 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package packageversions
+
+const (
+	ResourceName = "tanzu-mission-control_package_versions"
+
+	namespaceKey         = "namespace_name"
+	metadataNameKey      = "metadata_name"
+	constraintsKey       = "constraints"
+	currentVersionKey    = "current_version"
+	versionsKey          = "versions"
+	resolvedVersionKey   = "resolved_version"
+	availableUpgradesKey = "available_upgrades"
+)
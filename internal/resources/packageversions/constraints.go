@@ -0,0 +1,69 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package packageversions
+
+import (
+	"sort"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+)
+
+// IsExactVersion checks whether the constraints select a single version, e.g. "1.9.5+vmware.1-tkg.1".
+func IsExactVersion(constraints string) bool {
+	parsed, err := helper.ParseConstraints(constraints)
+
+	return err == nil && parsed.IsExact()
+}
+
+// MatchingVersions returns the versions which satisfy the constraints, sorted from the newest to the oldest.
+func MatchingVersions(constraints string, versions []string) ([]string, error) {
+	parsed, err := helper.ParseConstraints(constraints)
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make([]string, 0, len(versions))
+
+	for _, version := range versions {
+		if parsed.Check(version) {
+			matching = append(matching, version)
+		}
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		return helper.CompareVersions(matching[i], matching[j]) > 0
+	})
+
+	return matching, nil
+}
+
+// ResolveVersion returns the newest version which satisfies the constraints, the same version kapp-controller installs.
+// An empty string is returned when none of the versions satisfy the constraints.
+func ResolveVersion(constraints string, versions []string) (string, error) {
+	matching, err := MatchingVersions(constraints, versions)
+	if err != nil || len(matching) == 0 {
+		return "", err
+	}
+
+	return matching[0], nil
+}
+
+// AvailableUpgrades returns the versions newer than the current version which satisfy the constraints, sorted from the newest to the oldest.
+func AvailableUpgrades(constraints, current string, versions []string) ([]string, error) {
+	matching, err := MatchingVersions(constraints, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrades := make([]string, 0, len(matching))
+
+	for _, version := range matching {
+		if current == "" || helper.CompareVersions(version, current) > 0 {
+			upgrades = append(upgrades, version)
+		}
+	}
+
+	return upgrades, nil
+}
@@ -0,0 +1,114 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package packageversions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testVersions = []string{
+	"1.1.0+vmware.1-tkg.2",
+	"1.7.2+vmware.1-tkg.1",
+	"1.7.2+vmware.3-tkg.1",
+	"1.9.5+vmware.1-tkg.1",
+	"1.10.0-rc.1+vmware.1-tkg.1",
+	"2.0.0+vmware.1-tkg.1",
+}
+
+func TestMatchingVersions(t *testing.T) {
+	cases := []struct {
+		name        string
+		constraints string
+		expected    []string
+	}{
+		{
+			name:        "exact version",
+			constraints: "1.9.5+vmware.1-tkg.1",
+			expected:    []string{"1.9.5+vmware.1-tkg.1"},
+		},
+		{
+			name:        "exact version with the build metadata",
+			constraints: "1.7.2+vmware.1-tkg.1",
+			expected:    []string{"1.7.2+vmware.1-tkg.1"},
+		},
+		{
+			name:        "exact version without the build metadata",
+			constraints: "v1.7.2",
+			expected:    []string{"1.7.2+vmware.3-tkg.1", "1.7.2+vmware.1-tkg.1"},
+		},
+		{
+			name:        "range",
+			constraints: ">=1.7.0 <2.0.0",
+			expected:    []string{"1.9.5+vmware.1-tkg.1", "1.7.2+vmware.3-tkg.1", "1.7.2+vmware.1-tkg.1"},
+		},
+		{
+			name:        "alternatives",
+			constraints: "<1.7.0 || >=2.0.0",
+			expected:    []string{"2.0.0+vmware.1-tkg.1", "1.1.0+vmware.1-tkg.2"},
+		},
+		{
+			name:        "wildcard",
+			constraints: "1.x",
+			expected:    []string{"1.9.5+vmware.1-tkg.1", "1.7.2+vmware.3-tkg.1", "1.7.2+vmware.1-tkg.1", "1.1.0+vmware.1-tkg.2"},
+		},
+		{
+			name:        "excluded version",
+			constraints: ">=1.7.0 != 1.9.5",
+			expected:    []string{"2.0.0+vmware.1-tkg.1", "1.7.2+vmware.3-tkg.1", "1.7.2+vmware.1-tkg.1"},
+		},
+		{
+			name:        "pre-release version",
+			constraints: ">=1.10.0-0",
+			expected:    []string{"2.0.0+vmware.1-tkg.1", "1.10.0-rc.1+vmware.1-tkg.1"},
+		},
+		{
+			name:        "no matching version",
+			constraints: ">2.0.0",
+			expected:    []string{},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := MatchingVersions(test.constraints, testVersions)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	resolved, err := ResolveVersion("1.7.2", testVersions)
+	require.NoError(t, err)
+	require.Equal(t, "1.7.2+vmware.3-tkg.1", resolved)
+
+	resolved, err = ResolveVersion("", testVersions)
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0+vmware.1-tkg.1", resolved)
+
+	resolved, err = ResolveVersion("3.x", testVersions)
+	require.NoError(t, err)
+	require.Empty(t, resolved)
+}
+
+func TestAvailableUpgrades(t *testing.T) {
+	upgrades, err := AvailableUpgrades("<2.0.0", "1.7.2+vmware.1-tkg.1", testVersions)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.9.5+vmware.1-tkg.1", "1.7.2+vmware.3-tkg.1"}, upgrades)
+
+	upgrades, err = AvailableUpgrades("1.9.5", "1.9.5+vmware.1-tkg.1", testVersions)
+	require.NoError(t, err)
+	require.Empty(t, upgrades)
+}
+
+func TestIsExactVersion(t *testing.T) {
+	require.True(t, IsExactVersion("1.9.5+vmware.1-tkg.1"))
+	require.True(t, IsExactVersion("=1.9.5"))
+	require.False(t, IsExactVersion(">=1.9.5"))
+	require.False(t, IsExactVersion("1.9.x"))
+	require.False(t, IsExactVersion("1.1.0 || 1.9.5"))
+}
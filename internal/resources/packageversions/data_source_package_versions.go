@@ -0,0 +1,148 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package packageversions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packageclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/package/cluster"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/package/scope"
+)
+
+func DataSourcePackageVersions() *schema.Resource {
+	return &schema.Resource{
+		Schema:      packageVersionsSchema,
+		ReadContext: dataSourcePackageVersionsRead,
+	}
+}
+
+var packageVersionsSchema = map[string]*schema.Schema{
+	metadataNameKey: {
+		Type:        schema.TypeString,
+		Description: "Metadata name of package.",
+		Required:    true,
+	},
+	constraintsKey: {
+		Type:        schema.TypeString,
+		Description: "Version constraints to evaluate against the versions of the package, in the same format as the version selection of a package install. Example: '>=1.2.0 <2.0.0', '1.9.x'. By default, all released versions are matched.",
+		Optional:    true,
+	},
+	currentVersionKey: {
+		Type:        schema.TypeString,
+		Description: "Currently installed version of the package, used to compute the available upgrades. By default, all matching versions are available upgrades.",
+		Optional:    true,
+	},
+	commonscope.ScopeKey: scope.ScopeSchema,
+	namespaceKey: {
+		Type:        schema.TypeString,
+		Description: "Namespace of the package repositories in the cluster.",
+		Computed:    true,
+	},
+	versionsKey: {
+		Type:        schema.TypeList,
+		Description: "Versions of the package satisfying the constraints, sorted from the newest to the oldest.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	resolvedVersionKey: {
+		Type:        schema.TypeString,
+		Description: "Newest version satisfying the constraints, i.e. the version a package install with these constraints resolves to.",
+		Computed:    true,
+	},
+	availableUpgradesKey: {
+		Type:        schema.TypeList,
+		Description: "Versions satisfying the constraints which are newer than the current version, sorted from the newest to the oldest.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func dataSourcePackageVersionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	metadataName, _ := d.Get(metadataNameKey).(string)
+	constraints, _ := d.Get(constraintsKey).(string)
+	currentVersion, _ := d.Get(currentVersionKey).(string)
+
+	if _, err := helper.ParseConstraints(constraints); err != nil {
+		return diag.FromErr(err)
+	}
+
+	scopedFullnameData, scopesFound := scope.ConstructScope(d)
+
+	if len(scopesFound) == 0 {
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v", strings.Join(scope.ScopeAllowed[:], `, `))
+	} else if len(scopesFound) > 1 {
+		return diag.Errorf("found scopes: %v are not valid: maximum one valid scope type block is allowed", strings.Join(scopesFound, `, `))
+	}
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to get Tanzu Mission Control package versions; Scope full name is empty")
+	}
+
+	searchScope := &packageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope{
+		ClusterName:           scopedFullnameData.FullnameCluster.ClusterName,
+		ManagementClusterName: scopedFullnameData.FullnameCluster.ManagementClusterName,
+		ProvisionerName:       scopedFullnameData.FullnameCluster.ProvisionerName,
+		MetadataName:          metadataName,
+	}
+
+	versions, err := ListVersions(config, searchScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(versions) == 0 {
+		return diag.Errorf("Tanzu Mission Control no package entry found with metadata name : %s", metadataName)
+	}
+
+	matching, err := MatchingVersions(constraints, versions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	upgrades, err := AvailableUpgrades(constraints, currentVersion, versions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resolvedVersion := ""
+
+	if len(matching) > 0 {
+		resolvedVersion = matching[0]
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", searchScope.ClusterName, searchScope.NamespaceName, metadataName, constraints))
+
+	if err := d.Set(namespaceKey, searchScope.NamespaceName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(versionsKey, matching); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(resolvedVersionKey, resolvedVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(availableUpgradesKey, upgrades); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(commonscope.ScopeKey, scope.FlattenScope(scopedFullnameData)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
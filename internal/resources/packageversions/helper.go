@@ -0,0 +1,87 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package packageversions
+
+import (
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	packageclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/package/cluster"
+	tanzupakageclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackage"
+	packagehelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/package"
+)
+
+// ListVersions lists the versions of a package available in the package repositories of a cluster.
+// The namespace of the search scope is set to the global package repository namespace of the cluster.
+func ListVersions(config authctx.TanzuContext, searchScope *packageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope) ([]string, error) {
+	if err := setGlobalNamespace(config, searchScope); err != nil {
+		return nil, err
+	}
+
+	resp, err := config.TMCConnection.TanzupackageResourceService.ManageV1alpha1ClusterPackageResourceServiceList(searchScope)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to list the versions of package : %s", searchScope.MetadataName)
+	}
+
+	versions := make([]string, 0, len(resp.Packages))
+
+	for _, pkg := range resp.Packages {
+		if pkg.FullName != nil {
+			versions = append(versions, pkg.FullName.Name)
+		}
+	}
+
+	return versions, nil
+}
+
+// GetValuesSchema gets the OpenAPI values schema of a version of a package, nil is returned when the package doesn't define one.
+func GetValuesSchema(config authctx.TanzuContext, searchScope *packageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope, version string) (map[string]interface{}, error) {
+	if searchScope.NamespaceName == "" {
+		if err := setGlobalNamespace(config, searchScope); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := config.TMCConnection.TanzupackageResourceService.ManageV1alpha1ClusterPackageResourceServiceGet(&packageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageFullName{
+		ClusterName:           searchScope.ClusterName,
+		ManagementClusterName: searchScope.ManagementClusterName,
+		ProvisionerName:       searchScope.ProvisionerName,
+		MetadataName:          searchScope.MetadataName,
+		Name:                  version,
+		NamespaceName:         searchScope.NamespaceName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get version %s of package : %s", version, searchScope.MetadataName)
+	}
+
+	if resp.Package == nil || resp.Package.Spec == nil || resp.Package.Spec.ValuesSchema == nil ||
+		resp.Package.Spec.ValuesSchema.Template == nil || len(resp.Package.Spec.ValuesSchema.Template.Raw) == 0 {
+		return nil, nil
+	}
+
+	valuesSchema := make(map[string]interface{})
+
+	if err := yaml.Unmarshal(resp.Package.Spec.ValuesSchema.Template.Raw, &valuesSchema); err != nil {
+		return nil, errors.Wrapf(err, "Unable to read the values schema of version %s of package : %s", version, searchScope.MetadataName)
+	}
+
+	return valuesSchema, nil
+}
+
+func setGlobalNamespace(config authctx.TanzuContext, searchScope *packageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope) error {
+	globalNs, err := packagehelper.GetGlobalNamespace(config, &tanzupakageclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageSearchScope{
+		ClusterName:           searchScope.ClusterName,
+		ManagementClusterName: searchScope.ManagementClusterName,
+		ProvisionerName:       searchScope.ProvisionerName,
+	})
+	if err != nil {
+		return err
+	}
+
+	searchScope.NamespaceName = globalNs
+
+	return nil
+}
@@ -0,0 +1,36 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package packageversions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster"
+	packagerepository "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackagerepository"
+)
+
+func initTestProvider(t *testing.T) *schema.Provider {
+	testProvider := &schema.Provider{
+		Schema: authctx.ProviderAuthSchema(),
+		ResourcesMap: map[string]*schema.Resource{
+			cluster.ResourceName:           cluster.ResourceTMCCluster(),
+			packagerepository.ResourceName: packagerepository.ResourcePackageRepository(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			ResourceName:         DataSourcePackageVersions(),
+			cluster.ResourceName: cluster.DataSourceTMCCluster(),
+		},
+		ConfigureContextFunc: getConfigureContextFunc(),
+	}
+	if err := testProvider.InternalValidate(); err != nil {
+		require.NoError(t, err)
+	}
+
+	return testProvider
+}
@@ -0,0 +1,384 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ChangeSummaryKey = "change_summary"
+
+	// RecipeKey identifies the change of the recipe of a policy in the change summary and in the loosening rules.
+	RecipeKey = "recipe"
+
+	loosened  = "loosened"
+	tightened = "tightened"
+)
+
+var ChangeSummarySchema = &schema.Schema{
+	Type: schema.TypeList,
+	Description: "Human-readable summary of the changes of the policy computed during the plan against the state refreshed from Tanzu Mission Control, e.g. rules added or removed and attributes changed. " +
+		"The changes which loosen a security or image policy are marked as loosened and reported as a warning when applied.",
+	Computed: true,
+	Elem:     &schema.Schema{Type: schema.TypeString},
+}
+
+// LooseningRules tell which changes of the attributes of a policy loosen it, the attributes are identified by their key.
+// The opposite changes tighten the policy.
+type LooseningRules struct {
+	// Enabled are the boolean attributes which loosen the policy when enabled, e.g. allow_privileged_containers.
+	Enabled []string
+	// Disabled are the boolean attributes which loosen the policy when disabled, e.g. read_only_root_file_system.
+	Disabled []string
+	// Added are the list attributes which loosen the policy when items are added to them, e.g. allowed_volumes.
+	Added []string
+	// Removed are the list attributes which loosen the policy when items are removed from them, e.g. required_drop_capabilities.
+	Removed []string
+	// Orders are the values of the attributes from the strictest to the most permissive, e.g. the recipes of the security policy.
+	Orders map[string][]string
+}
+
+// PlanChangeSummary sets the change summary of a policy during the plan, from the difference between its state and its configuration.
+// When the policy is replaced, the diff is planned again without the state, which is then read from the raw state.
+func PlanChangeSummary(specSchema *schema.Schema, rules *LooseningRules) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+		rawState := diff.GetRawState()
+
+		if diff.Id() == "" && (rawState.IsNull() || !rawState.IsKnown()) {
+			return diff.SetNew(ChangeSummaryKey, []string{})
+		}
+
+		if !diff.NewValueKnown(SpecKey) || !diff.NewValueKnown(EnforcementActionKey) {
+			return diff.SetNewComputed(ChangeSummaryKey)
+		}
+
+		oldSpec, newSpec := diff.GetChange(SpecKey)
+		oldAction, newAction := diff.GetChange(EnforcementActionKey)
+
+		if diff.Id() == "" {
+			oldSpec, oldAction = priorSpecAndEnforcementAction(specSchema, rawState)
+		}
+
+		summary := SummarizeChanges(specSchema, rules, oldSpec, newSpec, oldAction.(string), newAction.(string))
+
+		if oldSummary, _ := diff.GetChange(ChangeSummaryKey); len(summary) == 0 && len(toList(oldSummary)) == 0 {
+			return nil
+		}
+
+		return diff.SetNew(ChangeSummaryKey, summary)
+	}
+}
+
+// priorSpecAndEnforcementAction reads the spec and the enforcement action of a policy from its raw state.
+func priorSpecAndEnforcementAction(specSchema *schema.Schema, rawState cty.Value) (spec interface{}, action interface{}) {
+	priorResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			SpecKey:              specSchema,
+			EnforcementActionKey: EnforcementActionSchema,
+		},
+	}
+
+	state, err := priorResource.ShimInstanceStateFromValue(rawState)
+	if err != nil {
+		return nil, ""
+	}
+
+	d := priorResource.Data(state)
+
+	return d.Get(SpecKey), d.Get(EnforcementActionKey)
+}
+
+// SummarizeChanges returns one line per change of the spec and of the enforcement action of a policy,
+// with the changes loosening or tightening the policy according to the rules marked as such.
+func SummarizeChanges(specSchema *schema.Schema, rules *LooseningRules, oldSpec, newSpec interface{}, oldAction, newAction string) []string {
+	summary := make([]string, 0)
+
+	if oldAction != "" && newAction != "" && oldAction != newAction {
+		summary = append(summary, summaryLine(EnforcementActionKey, fmt.Sprintf("%s -> %s", oldAction, newAction), rules.valueDirection(EnforcementActionKey, oldAction, newAction)))
+	}
+
+	specResource, ok := specSchema.Elem.(*schema.Resource)
+	if !ok {
+		return summary
+	}
+
+	skipped := make([]string, 0)
+
+	// The inputs of two different recipes have nothing in common, the change of recipe is summarized instead.
+	if oldRecipe, newRecipe := ConfiguredRecipe(oldSpec), ConfiguredRecipe(newSpec); oldRecipe != newRecipe {
+		summary = append(summary, summaryLine(RecipeKey, fmt.Sprintf("%s -> %s", formatValue(oldRecipe), formatValue(newRecipe)), rules.valueDirection(RecipeKey, oldRecipe, newRecipe)))
+		skipped = append(skipped, InputKey)
+	}
+
+	return append(summary, summarizeBlock("", specResource.Schema, firstElement(oldSpec), firstElement(newSpec), rules, skipped)...)
+}
+
+// LooseningChanges returns the lines of the change summary which loosen the policy.
+func LooseningChanges(summary interface{}) []string {
+	changes := make([]string, 0)
+
+	for _, raw := range toList(summary) {
+		if line, ok := raw.(string); ok && strings.HasSuffix(line, fmt.Sprintf(" (%s)", loosened)) {
+			changes = append(changes, line)
+		}
+	}
+
+	return changes
+}
+
+// LooseningWarning returns a warning when the planned changes of the policy loosen it.
+func LooseningWarning(d *schema.ResourceData, rn string) diag.Diagnostics {
+	changes := LooseningChanges(d.Get(ChangeSummaryKey))
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s %s is loosened", rn, d.Get(NameKey)),
+			Detail:   fmt.Sprintf("The following changes loosen the policy:\n%s", strings.Join(changes, "\n")),
+		},
+	}
+}
+
+// nolint: gocognit
+func summarizeBlock(path string, blockSchema map[string]*schema.Schema, oldData, newData map[string]interface{}, rules *LooseningRules, skipped []string) []string {
+	summary := make([]string, 0)
+	keys := make([]string, 0, len(blockSchema))
+
+	for key := range blockSchema {
+		if !containsString(skipped, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		attribute := blockSchema[key]
+		attributePath := key
+
+		if path != "" {
+			attributePath = fmt.Sprintf("%s.%s", path, key)
+		}
+
+		oldValue, newValue := oldData[key], newData[key]
+
+		switch attribute.Type {
+		case schema.TypeList, schema.TypeSet:
+			oldItems, newItems := toList(oldValue), toList(newValue)
+
+			if resource, ok := attribute.Elem.(*schema.Resource); ok && attribute.MaxItems == 1 {
+				if len(oldItems) == 0 && len(newItems) == 0 {
+					continue
+				}
+
+				// The lists of an added or removed block are summarized as added or removed items, its other attributes are not.
+				blockSummary := summarizeBlock(attributePath, resource.Schema, firstElement(oldItems), firstElement(newItems), rules, nil)
+
+				switch {
+				case len(blockSummary) != 0:
+					summary = append(summary, blockSummary...)
+				case len(oldItems) == 0:
+					summary = append(summary, summaryLine(attributePath, "added", ""))
+				case len(newItems) == 0:
+					summary = append(summary, summaryLine(attributePath, "removed", ""))
+				}
+
+				continue
+			}
+
+			added, removed := diffItems(oldItems, newItems)
+			if len(added) == 0 && len(removed) == 0 {
+				continue
+			}
+
+			_, isBlock := attribute.Elem.(*schema.Resource)
+			summary = append(summary, summaryLine(attributePath, describeItems(added, removed, isBlock), rules.listDirection(key, len(added), len(removed))))
+		case schema.TypeMap:
+			oldMap, _ := oldValue.(map[string]interface{})
+			newMap, _ := newValue.(map[string]interface{})
+
+			if (len(oldMap) != 0 || len(newMap) != 0) && !reflect.DeepEqual(oldMap, newMap) {
+				summary = append(summary, summaryLine(attributePath, "changed", ""))
+			}
+		case schema.TypeBool, schema.TypeInt, schema.TypeFloat, schema.TypeString, schema.TypeInvalid:
+			if oldValue != nil && newValue != nil && !reflect.DeepEqual(oldValue, newValue) {
+				summary = append(summary, summaryLine(attributePath, fmt.Sprintf("%s -> %s", formatValue(oldValue), formatValue(newValue)), rules.valueDirection(key, oldValue, newValue)))
+			}
+		}
+	}
+
+	return summary
+}
+
+func (rules *LooseningRules) valueDirection(key string, oldValue, newValue interface{}) string {
+	if rules == nil {
+		return ""
+	}
+
+	if enabled, ok := newValue.(bool); ok {
+		switch {
+		case containsString(rules.Enabled, key) && enabled, containsString(rules.Disabled, key) && !enabled:
+			return loosened
+		case containsString(rules.Enabled, key), containsString(rules.Disabled, key):
+			return tightened
+		}
+
+		return ""
+	}
+
+	order, ok := rules.Orders[key]
+	if !ok {
+		return ""
+	}
+
+	oldIndex, newIndex := indexOf(order, fmt.Sprintf("%v", oldValue)), indexOf(order, fmt.Sprintf("%v", newValue))
+
+	switch {
+	case oldIndex == -1 || newIndex == -1:
+		return ""
+	case newIndex > oldIndex:
+		return loosened
+	case newIndex < oldIndex:
+		return tightened
+	}
+
+	return ""
+}
+
+// listDirection returns whether adding and removing items of a list loosen the policy, a change both loosening and tightening it is a loosening.
+func (rules *LooseningRules) listDirection(key string, added, removed int) string {
+	if rules == nil {
+		return ""
+	}
+
+	switch {
+	case containsString(rules.Added, key) && added != 0, containsString(rules.Removed, key) && removed != 0:
+		return loosened
+	case containsString(rules.Added, key), containsString(rules.Removed, key):
+		return tightened
+	}
+
+	return ""
+}
+
+// diffItems returns the items added to and removed from a list, each item of a list matching at most one item of the other list.
+func diffItems(oldItems, newItems []interface{}) (added, removed []interface{}) {
+	matched := make([]bool, len(oldItems))
+
+	for _, newItem := range newItems {
+		found := false
+
+		for i, oldItem := range oldItems {
+			if !matched[i] && reflect.DeepEqual(oldItem, newItem) {
+				matched[i] = true
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			added = append(added, newItem)
+		}
+	}
+
+	for i, oldItem := range oldItems {
+		if !matched[i] {
+			removed = append(removed, oldItem)
+		}
+	}
+
+	return added, removed
+}
+
+func describeItems(added, removed []interface{}, isBlock bool) string {
+	descriptions := make([]string, 0, 2)
+
+	describe := func(items []interface{}, verb string) {
+		if len(items) == 0 {
+			return
+		}
+
+		if isBlock {
+			descriptions = append(descriptions, fmt.Sprintf("%d %s", len(items), verb))
+
+			return
+		}
+
+		values := make([]string, 0, len(items))
+
+		for _, item := range items {
+			values = append(values, formatValue(item))
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf("%s [%s]", verb, strings.Join(values, ", ")))
+	}
+
+	describe(added, "added")
+	describe(removed, "removed")
+
+	return strings.Join(descriptions, ", ")
+}
+
+func summaryLine(path, description, direction string) string {
+	if direction == "" {
+		return fmt.Sprintf("%s: %s", path, description)
+	}
+
+	return fmt.Sprintf("%s: %s (%s)", path, description, direction)
+}
+
+func formatValue(value interface{}) string {
+	if value == nil || value == "" {
+		return `""`
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+func toList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+
+	return nil
+}
+
+func firstElement(value interface{}) map[string]interface{} {
+	items := toList(value)
+
+	if len(items) == 0 || items[0] == nil {
+		return map[string]interface{}{}
+	}
+
+	data, _ := items[0].(map[string]interface{})
+
+	return data
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+
+	return -1
+}
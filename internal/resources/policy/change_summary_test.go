@@ -0,0 +1,321 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRecipeStrict   = "strict"
+	testRecipeBaseline = "baseline"
+	testAllowKey       = "allow_host_network"
+	testAllowedKey     = "allowed_volumes"
+	testRulesKey       = "rules"
+)
+
+var testRecipeSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			auditKey:       {Type: schema.TypeBool, Optional: true},
+			testAllowKey:   {Type: schema.TypeBool, Optional: true},
+			testAllowedKey: {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			testRulesKey: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						NameKey: {Type: schema.TypeString, Optional: true},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testSpecSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Required: true,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			InputKey: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						testRecipeStrict:   testRecipeSchema,
+						testRecipeBaseline: testRecipeSchema,
+					},
+				},
+			},
+			NamespaceSelectorKey: NamespaceSelector,
+		},
+	},
+}
+
+var testLooseningRules = &LooseningRules{
+	Enabled: []string{auditKey, testAllowKey},
+	Added:   []string{MatchExpressionsKey, testAllowedKey, testRulesKey},
+	Orders: map[string][]string{
+		RecipeKey:            {testRecipeStrict, testRecipeBaseline},
+		EnforcementActionKey: {EnforcementActionDeny, EnforcementActionDryRun},
+	},
+}
+
+func testSpec(recipe string, input map[string]interface{}, matchExpressions ...interface{}) []interface{} {
+	spec := map[string]interface{}{
+		InputKey: []interface{}{
+			map[string]interface{}{
+				recipe: []interface{}{input},
+			},
+		},
+	}
+
+	if len(matchExpressions) != 0 {
+		spec[NamespaceSelectorKey] = []interface{}{
+			map[string]interface{}{
+				MatchExpressionsKey: matchExpressions,
+			},
+		}
+	}
+
+	return []interface{}{spec}
+}
+
+func testInput(audit, allow bool, allowed []interface{}, rules ...string) map[string]interface{} {
+	ruleItems := make([]interface{}, 0)
+
+	for _, rule := range rules {
+		ruleItems = append(ruleItems, map[string]interface{}{NameKey: rule})
+	}
+
+	return map[string]interface{}{
+		auditKey:       audit,
+		testAllowKey:   allow,
+		testAllowedKey: allowed,
+		testRulesKey:   ruleItems,
+	}
+}
+
+func TestSummarizeChanges(t *testing.T) {
+	t.Parallel()
+
+	excludeKubeSystem := map[string]interface{}{KeyKey: "kubernetes.io/metadata.name", OperatorKey: "NotIn", ValuesKey: []interface{}{"kube-system"}}
+
+	cases := []struct {
+		description string
+		rules       *LooseningRules
+		oldSpec     []interface{}
+		newSpec     []interface{}
+		oldAction   string
+		newAction   string
+		expected    []string
+	}{
+		{
+			description: "no change",
+			rules:       testLooseningRules,
+			oldSpec:     testSpec(testRecipeStrict, testInput(false, false, []interface{}{"configMap"}, "r1")),
+			newSpec:     testSpec(testRecipeStrict, testInput(false, false, []interface{}{"configMap"}, "r1")),
+			oldAction:   EnforcementActionDeny,
+			newAction:   EnforcementActionDeny,
+			expected:    []string{},
+		},
+		{
+			description: "loosening changes",
+			rules:       testLooseningRules,
+			oldSpec:     testSpec(testRecipeStrict, testInput(false, false, []interface{}{"configMap"}, "r1")),
+			newSpec:     testSpec(testRecipeStrict, testInput(true, true, []interface{}{"configMap", "hostPath"}, "r1", "r2"), excludeKubeSystem),
+			oldAction:   EnforcementActionDeny,
+			newAction:   EnforcementActionDryRun,
+			expected: []string{
+				"enforcement_action: deny -> dryrun (loosened)",
+				"input.strict.allow_host_network: false -> true (loosened)",
+				"input.strict.allowed_volumes: added [hostPath] (loosened)",
+				"input.strict.audit: false -> true (loosened)",
+				"input.strict.rules: 1 added (loosened)",
+				"namespace_selector.match_expressions: 1 added (loosened)",
+			},
+		},
+		{
+			description: "tightening changes",
+			rules:       testLooseningRules,
+			oldSpec:     testSpec(testRecipeStrict, testInput(true, true, []interface{}{"configMap", "hostPath"}, "r1", "r2"), excludeKubeSystem),
+			newSpec:     testSpec(testRecipeStrict, testInput(true, false, []interface{}{"configMap"}, "r1", "r3")),
+			expected: []string{
+				"input.strict.allow_host_network: true -> false (tightened)",
+				"input.strict.allowed_volumes: removed [hostPath] (tightened)",
+				"input.strict.rules: 1 added, 1 removed (loosened)",
+				"namespace_selector.match_expressions: 1 removed (tightened)",
+			},
+		},
+		{
+			description: "change of recipe",
+			rules:       testLooseningRules,
+			oldSpec:     testSpec(testRecipeStrict, testInput(false, false, nil)),
+			newSpec:     testSpec(testRecipeBaseline, testInput(false, true, nil)),
+			expected: []string{
+				"recipe: strict -> baseline (loosened)",
+			},
+		},
+		{
+			description: "match expressions of the namespace selector",
+			rules:       testLooseningRules,
+			oldSpec:     testSpec(testRecipeStrict, testInput(false, false, nil), excludeKubeSystem),
+			newSpec:     testSpec(testRecipeStrict, testInput(false, false, nil)),
+			expected: []string{
+				"namespace_selector.match_expressions: 1 removed (tightened)",
+			},
+		},
+		{
+			description: "changes of a kind of policy without loosening rules",
+			rules:       nil,
+			oldSpec:     testSpec(testRecipeStrict, testInput(false, false, nil)),
+			newSpec:     testSpec(testRecipeStrict, testInput(true, false, nil, "r1")),
+			expected: []string{
+				"input.strict.audit: false -> true",
+				"input.strict.rules: 1 added",
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := SummarizeChanges(testSpecSchema, test.rules, test.oldSpec, test.newSpec, test.oldAction, test.newAction)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestLooseningChanges(t *testing.T) {
+	t.Parallel()
+
+	summary := []interface{}{
+		"enforcement_action: deny -> dryrun (loosened)",
+		"input.strict.allow_host_network: true -> false (tightened)",
+		"namespace_selector: removed",
+	}
+
+	require.Equal(t, []string{"enforcement_action: deny -> dryrun (loosened)"}, LooseningChanges(summary))
+	require.Equal(t, []string{}, LooseningChanges(nil))
+}
+
+func TestPlanChangeSummary(t *testing.T) {
+	t.Parallel()
+
+	// The inputs of the recipes of the policies are replaced when changed.
+	replacedRecipeSchema := *testRecipeSchema
+	replacedRecipeSchema.ForceNew = true
+
+	replacedSpecSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				InputKey: {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							testRecipeStrict:   &replacedRecipeSchema,
+							testRecipeBaseline: &replacedRecipeSchema,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			SpecKey:              replacedSpecSchema,
+			EnforcementActionKey: EnforcementActionSchema,
+			ChangeSummaryKey:     ChangeSummarySchema,
+		},
+		CustomizeDiff: PlanChangeSummary(replacedSpecSchema, testLooseningRules),
+	}
+
+	policyData := func(id, recipe string) map[string]interface{} {
+		data := map[string]interface{}{
+			SpecKey:              testSpec(recipe, map[string]interface{}{auditKey: false}),
+			EnforcementActionKey: EnforcementActionDeny,
+		}
+
+		if id != "" {
+			data["id"] = id
+			data[ChangeSummaryKey] = []interface{}{}
+		}
+
+		return data
+	}
+
+	cases := []struct {
+		description string
+		prior       map[string]interface{}
+		config      map[string]interface{}
+		requiresNew bool
+		expected    []string
+	}{
+		{
+			description: "policy replaced with a looser recipe",
+			prior:       policyData("p1", testRecipeStrict),
+			config:      policyData("", testRecipeBaseline),
+			requiresNew: true,
+			expected:    []string{"recipe: strict -> baseline (loosened)"},
+		},
+		{
+			description: "policy replaced with a stricter recipe",
+			prior:       policyData("p1", testRecipeBaseline),
+			config:      policyData("", testRecipeStrict),
+			requiresNew: true,
+			expected:    []string{"recipe: baseline -> strict (tightened)"},
+		},
+		{
+			description: "new policy",
+			config:      policyData("", testRecipeBaseline),
+			requiresNew: true,
+			expected:    []string{},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			attributes := testPlan(t, r, test.prior, test.config)
+			actual := make([]string, 0)
+			requiresNew := false
+
+			for key, attribute := range attributes {
+				requiresNew = requiresNew || attribute.RequiresNew
+
+				if key != ChangeSummaryKey+".#" && strings.HasPrefix(key, ChangeSummaryKey+".") {
+					actual = append(actual, attribute.New)
+				}
+			}
+
+			require.Equal(t, test.requiresNew, requiresNew)
+			if count, ok := attributes[ChangeSummaryKey+".#"]; ok {
+				require.Equal(t, strconv.Itoa(len(test.expected)), count.New)
+			}
+
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+const (
+	EnforcementActionKey = "enforcement_action"
+
+	// Enforcement actions as reported by the policy insights of TMC.
+	EnforcementActionDeny   = "deny"
+	EnforcementActionDryRun = "dryrun"
+
+	// auditKey is the key of the input of the recipes which can be audited instead of enforced.
+	auditKey        = "audit"
+	enforcementDesc = "Enforcement action of the policy, valid values are: [%s]. '%s' enforces the policy and '%s' only audits the violations. " +
+		"The enforcement actions supported by the recipe are validated at plan time. " +
+		"When set, it takes precedence over the audit attribute of the recipe, otherwise it is derived from it."
+)
+
+var EnforcementActions = []string{EnforcementActionDeny, EnforcementActionDryRun}
+
+// DenyOnly are the enforcement actions of the recipes which can't be audited.
+var DenyOnly = []string{EnforcementActionDeny}
+
+// DenyAndDryRun are the enforcement actions of the recipes with an audit input.
+var DenyAndDryRun = []string{EnforcementActionDeny, EnforcementActionDryRun}
+
+var EnforcementActionSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Description:  fmt.Sprintf(enforcementDesc, strings.Join(EnforcementActions, ", "), EnforcementActionDeny, EnforcementActionDryRun),
+	Optional:     true,
+	Computed:     true,
+	ValidateFunc: validation.StringInSlice(EnforcementActions, false),
+}
+
+// ValidateEnforcementAction validates the enforcement action of a policy against the enforcement actions supported by its recipe.
+// The recipes missing from the supported enforcement actions only support deny.
+func ValidateEnforcementAction(supported map[string][]string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+		action := configuredEnforcementAction(diff.GetRawConfig())
+
+		if action == "" || !diff.NewValueKnown(SpecKey) {
+			return nil
+		}
+
+		recipe := ConfiguredRecipe(diff.Get(SpecKey))
+		if recipe == "" {
+			return nil
+		}
+
+		actions := SupportedEnforcementActions(supported, recipe)
+
+		if !containsString(actions, action) {
+			return fmt.Errorf("enforcement action %q is not supported by recipe %s: supported enforcement actions are [%s]", action, recipe, strings.Join(actions, ", "))
+		}
+
+		if audit, ok := configuredAudit(diff.GetRawConfig(), recipe); ok && audit != (action == EnforcementActionDryRun) {
+			return fmt.Errorf("%s of recipe %s is %t, which conflicts with enforcement action %q: remove %s or set it to %t",
+				auditKey, recipe, audit, action, auditKey, action == EnforcementActionDryRun)
+		}
+
+		return nil
+	}
+}
+
+// PlanEnforcementAction derives the enforcement action of a policy from the audit attribute of its recipe when it isn't configured,
+// so that removing the enforcement action from the configuration doesn't keep the one of the state.
+func PlanEnforcementAction(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if configuredEnforcementAction(diff.GetRawConfig()) != "" {
+		return nil
+	}
+
+	if !diff.NewValueKnown(SpecKey) {
+		return diff.SetNewComputed(EnforcementActionKey)
+	}
+
+	action := EnforcementActionDeny
+
+	if plannedAudit(diff.Get(SpecKey)) {
+		action = EnforcementActionDryRun
+	}
+
+	if diff.Get(EnforcementActionKey) == action {
+		return nil
+	}
+
+	return diff.SetNew(EnforcementActionKey, action)
+}
+
+// SuppressAuditDiff suppresses the difference of the audit attribute of a recipe when the configured enforcement action
+// sets it to its value in the state, the audit attribute defaulting to false when the enforcement action is dryrun.
+func SuppressAuditDiff(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	action := configuredEnforcementAction(d.GetRawConfig())
+	if action == "" {
+		return false
+	}
+
+	return oldValue == fmt.Sprint(action == EnforcementActionDryRun)
+}
+
+// SupportedEnforcementActions returns the enforcement actions supported by a recipe.
+func SupportedEnforcementActions(supported map[string][]string, recipe string) []string {
+	if actions, ok := supported[recipe]; ok {
+		return actions
+	}
+
+	return DenyOnly
+}
+
+// ConfiguredRecipe returns the recipe of the policy, which is the key of the input block set in the spec.
+func ConfiguredRecipe(specValue interface{}) string {
+	data, _ := specValue.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return UnknownRecipe
+	}
+
+	specData, _ := data[0].(map[string]interface{})
+	inputs, _ := specData[InputKey].([]interface{})
+
+	if len(inputs) == 0 || inputs[0] == nil {
+		return UnknownRecipe
+	}
+
+	inputData, _ := inputs[0].(map[string]interface{})
+	recipes := make([]string, 0, len(inputData))
+
+	for recipe, v := range inputData {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			recipes = append(recipes, recipe)
+		}
+	}
+
+	if len(recipes) != 1 {
+		return UnknownRecipe
+	}
+
+	return recipes[0]
+}
+
+// plannedAudit returns the audit attribute of the recipe of the policy, false for the recipes without audit attribute.
+func plannedAudit(specValue interface{}) bool {
+	recipe := ConfiguredRecipe(specValue)
+	if recipe == UnknownRecipe {
+		return false
+	}
+
+	specData, _ := specValue.([]interface{})[0].(map[string]interface{})
+	inputData, _ := specData[InputKey].([]interface{})[0].(map[string]interface{})
+	recipeData, _ := inputData[recipe].([]interface{})
+
+	if len(recipeData) == 0 {
+		return false
+	}
+
+	recipeInput, _ := recipeData[0].(map[string]interface{})
+	audit, _ := recipeInput[auditKey].(bool)
+
+	return audit
+}
+
+// ApplyEnforcementAction sets the audit input of the recipe of a policy from its enforcement action.
+// The input of the recipes which can't be audited is left unchanged.
+func ApplyEnforcementAction(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec, action string, supported []string) error {
+	if spec == nil || action == "" || !containsString(supported, EnforcementActionDryRun) {
+		return nil
+	}
+
+	input := make(map[string]interface{})
+
+	if spec.Input != nil {
+		inputJSON, err := json.Marshal(spec.Input)
+		if err != nil {
+			return errors.Wrapf(err, "Couldn't marshal the input of recipe %s.", spec.Recipe)
+		}
+
+		if err := json.Unmarshal(inputJSON, &input); err != nil {
+			return errors.Wrapf(err, "Couldn't unmarshal the input of recipe %s.", spec.Recipe)
+		}
+	}
+
+	input[auditKey] = action == EnforcementActionDryRun
+	spec.Input = input
+
+	return nil
+}
+
+// FlattenEnforcementAction returns the enforcement action of a policy from the audit input of its recipe.
+func FlattenEnforcementAction(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) string {
+	if spec == nil {
+		return EnforcementActionDeny
+	}
+
+	input, _ := spec.Input.(map[string]interface{})
+
+	if audit, _ := input[auditKey].(bool); audit {
+		return EnforcementActionDryRun
+	}
+
+	return EnforcementActionDeny
+}
+
+func configuredEnforcementAction(config cty.Value) string {
+	value := getAttr(config, EnforcementActionKey)
+
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return ""
+	}
+
+	return value.AsString()
+}
+
+// configuredAudit returns the audit attribute of the recipe when it is set in the configuration.
+func configuredAudit(config cty.Value, recipe string) (audit bool, ok bool) {
+	value := getAttr(firstBlock(getAttr(firstBlock(getAttr(firstBlock(getAttr(config, SpecKey)), InputKey)), recipe)), auditKey)
+
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.Bool) {
+		return false, false
+	}
+
+	return value.True(), true
+}
+
+func getAttr(value cty.Value, name string) cty.Value {
+	if value.IsNull() || !value.IsKnown() || !value.Type().IsObjectType() || !value.Type().HasAttribute(name) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	return value.GetAttr(name)
+}
+
+func firstBlock(value cty.Value) cty.Value {
+	if value.IsNull() || !value.IsKnown() || !(value.Type().IsListType() || value.Type().IsSetType() || value.Type().IsTupleType()) || value.LengthInt() == 0 {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	blocks := value.AsValueSlice()
+
+	return blocks[0]
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
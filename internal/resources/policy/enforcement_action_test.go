@@ -0,0 +1,336 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+const (
+	testBaseline = "baseline"
+)
+
+func TestConfiguredRecipe(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		spec        interface{}
+		expected    string
+	}{
+		{
+			description: "recipe block set",
+			spec: []interface{}{map[string]interface{}{
+				InputKey: []interface{}{map[string]interface{}{
+					testBaseline: []interface{}{map[string]interface{}{auditKey: true}},
+					"strict":     []interface{}{},
+				}},
+			}},
+			expected: testBaseline,
+		},
+		{
+			description: "no recipe block set",
+			spec:        []interface{}{map[string]interface{}{InputKey: []interface{}{}}},
+			expected:    UnknownRecipe,
+		},
+		{
+			description: "no spec",
+			spec:        []interface{}{},
+			expected:    UnknownRecipe,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, ConfiguredRecipe(test.spec))
+		})
+	}
+}
+
+func TestApplyEnforcementAction(t *testing.T) {
+	t.Parallel()
+
+	type baselineInput struct {
+		Audit            bool `json:"audit,omitempty"`
+		DisableNativePsp bool `json:"disableNativePsp,omitempty"`
+	}
+
+	spec := &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+		Recipe: testBaseline,
+		Input:  baselineInput{DisableNativePsp: true},
+	}
+
+	require.NoError(t, ApplyEnforcementAction(spec, EnforcementActionDryRun, DenyAndDryRun))
+	require.Equal(t, map[string]interface{}{auditKey: true, "disableNativePsp": true}, spec.Input)
+	require.Equal(t, EnforcementActionDryRun, FlattenEnforcementAction(spec))
+
+	require.NoError(t, ApplyEnforcementAction(spec, EnforcementActionDeny, DenyAndDryRun))
+	require.Equal(t, map[string]interface{}{auditKey: false, "disableNativePsp": true}, spec.Input)
+	require.Equal(t, EnforcementActionDeny, FlattenEnforcementAction(spec))
+
+	// The input of the recipes which can't be audited is left unchanged.
+	quotaSpec := &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+		Recipe: "small",
+		Input:  map[string]interface{}{"requestsCpu": "2"},
+	}
+
+	require.NoError(t, ApplyEnforcementAction(quotaSpec, EnforcementActionDeny, DenyOnly))
+	require.Equal(t, map[string]interface{}{"requestsCpu": "2"}, quotaSpec.Input)
+	require.Equal(t, EnforcementActionDeny, FlattenEnforcementAction(quotaSpec))
+
+	// Nothing is changed when the enforcement action isn't set.
+	require.NoError(t, ApplyEnforcementAction(quotaSpec, "", DenyAndDryRun))
+	require.Equal(t, map[string]interface{}{"requestsCpu": "2"}, quotaSpec.Input)
+}
+
+func TestSupportedEnforcementActions(t *testing.T) {
+	t.Parallel()
+
+	supported := map[string][]string{testBaseline: DenyAndDryRun}
+
+	require.Equal(t, DenyAndDryRun, SupportedEnforcementActions(supported, testBaseline))
+	require.Equal(t, DenyOnly, SupportedEnforcementActions(supported, "small"))
+	require.Equal(t, DenyOnly, SupportedEnforcementActions(nil, testBaseline))
+}
+
+func TestConfiguredAudit(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(action cty.Value, audit cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			EnforcementActionKey: action,
+			SpecKey: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				InputKey: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					testBaseline: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{auditKey: audit})}),
+				})}),
+			})}),
+		})
+	}
+
+	config := newConfig(cty.StringVal(EnforcementActionDryRun), cty.True)
+	require.Equal(t, EnforcementActionDryRun, configuredEnforcementAction(config))
+
+	audit, ok := configuredAudit(config, testBaseline)
+	require.True(t, ok)
+	require.True(t, audit)
+
+	config = newConfig(cty.NullVal(cty.String), cty.NullVal(cty.Bool))
+	require.Empty(t, configuredEnforcementAction(config))
+
+	_, ok = configuredAudit(config, testBaseline)
+	require.False(t, ok)
+
+	_, ok = configuredAudit(config, "strict")
+	require.False(t, ok)
+
+	_, ok = configuredAudit(cty.NullVal(cty.DynamicPseudoType), testBaseline)
+	require.False(t, ok)
+}
+
+// testPlan plans the change of a resource from its prior state to its configuration like Terraform does,
+// with the raw state and configuration available to its customize diff functions.
+func testPlan(t *testing.T, r *schema.Resource, prior, config map[string]interface{}) map[string]*terraform.ResourceAttrDiff {
+	toValue := func(data map[string]interface{}) cty.Value {
+		if data == nil {
+			return cty.NullVal(r.CoreConfigSchema().ImpliedType())
+		}
+
+		dataJSON, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		value, err := ctyjson.Unmarshal(dataJSON, r.CoreConfigSchema().ImpliedType())
+		require.NoError(t, err)
+
+		return value
+	}
+
+	priorValue, configValue := toValue(prior), toValue(config)
+
+	state := &terraform.InstanceState{}
+
+	if prior != nil {
+		var err error
+
+		state, err = r.ShimInstanceStateFromValue(priorValue)
+		require.NoError(t, err)
+	}
+
+	state.RawState = priorValue
+	state.RawConfig = configValue
+	state.RawPlan = configValue
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(configValue, r.CoreConfigSchema()), nil)
+	require.NoError(t, err)
+
+	if diff == nil {
+		return map[string]*terraform.ResourceAttrDiff{}
+	}
+
+	return diff.Attributes
+}
+
+func testBaselineData(id, action string, audit interface{}) map[string]interface{} {
+	recipe := map[string]interface{}{}
+
+	if audit != nil {
+		recipe[auditKey] = audit
+	}
+
+	data := map[string]interface{}{
+		SpecKey: []interface{}{map[string]interface{}{
+			InputKey: []interface{}{map[string]interface{}{
+				testBaseline: []interface{}{recipe},
+			}},
+		}},
+	}
+
+	if id != "" {
+		data["id"] = id
+	}
+
+	if action != "" {
+		data[EnforcementActionKey] = action
+	}
+
+	return data
+}
+
+func TestPlanEnforcementAction(t *testing.T) {
+	t.Parallel()
+
+	const auditPath = "spec.0.input.0.baseline.0.audit"
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			SpecKey: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						InputKey: {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									testBaseline: {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												auditKey: {
+													Type:             schema.TypeBool,
+													Optional:         true,
+													Default:          false,
+													DiffSuppressFunc: SuppressAuditDiff,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			EnforcementActionKey: EnforcementActionSchema,
+		},
+		CustomizeDiff: customdiff.All(
+			ValidateEnforcementAction(map[string][]string{testBaseline: DenyAndDryRun}),
+			PlanEnforcementAction,
+		),
+	}
+
+	cases := []struct {
+		description string
+		prior       map[string]interface{}
+		config      map[string]interface{}
+		expected    map[string][2]string
+	}{
+		{
+			description: "audit removed from the configuration",
+			prior:       testBaselineData("p1", EnforcementActionDryRun, true),
+			config:      testBaselineData("", "", nil),
+			expected: map[string][2]string{
+				auditPath:            {"true", "false"},
+				EnforcementActionKey: {EnforcementActionDryRun, EnforcementActionDeny},
+			},
+		},
+		{
+			description: "enforcement action removed from the configuration",
+			prior:       testBaselineData("p1", EnforcementActionDryRun, true),
+			config:      testBaselineData("", "", false),
+			expected: map[string][2]string{
+				auditPath:            {"true", "false"},
+				EnforcementActionKey: {EnforcementActionDryRun, EnforcementActionDeny},
+			},
+		},
+		{
+			description: "audit set in the configuration",
+			prior:       testBaselineData("p1", EnforcementActionDeny, false),
+			config:      testBaselineData("", "", true),
+			expected: map[string][2]string{
+				auditPath:            {"false", "true"},
+				EnforcementActionKey: {EnforcementActionDeny, EnforcementActionDryRun},
+			},
+		},
+		{
+			description: "enforcement action applied to the audit of the state",
+			prior:       testBaselineData("p1", EnforcementActionDryRun, true),
+			config:      testBaselineData("", EnforcementActionDryRun, nil),
+			expected:    map[string][2]string{},
+		},
+		{
+			description: "enforcement action changed",
+			prior:       testBaselineData("p1", EnforcementActionDryRun, true),
+			config:      testBaselineData("", EnforcementActionDeny, nil),
+			expected: map[string][2]string{
+				auditPath:            {"true", "false"},
+				EnforcementActionKey: {EnforcementActionDryRun, EnforcementActionDeny},
+			},
+		},
+		{
+			description: "new policy",
+			config:      testBaselineData("", "", true),
+			expected: map[string][2]string{
+				auditPath:            {"", "true"},
+				EnforcementActionKey: {"", EnforcementActionDryRun},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			actual := make(map[string][2]string)
+
+			for key, attribute := range testPlan(t, r, test.prior, test.config) {
+				if key == auditPath || key == EnforcementActionKey {
+					actual[key] = [2]string{attribute.Old, attribute.New}
+				}
+			}
+
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+// ExemptionAnnotationPrefix is the prefix of the annotations recording the match expressions injected by a policy exemption
+// in the namespace selector of a policy, the name of the exemption follows the prefix.
+// The annotations contain tmc.cloud.vmware.com so that their differences are suppressed in the meta of the policies.
+const ExemptionAnnotationPrefix = "policy-exemption.tmc.cloud.vmware.com/"
+
+// ExemptionAnnotationKey returns the key of the annotation recording the match expressions injected by an exemption.
+func ExemptionAnnotationKey(exemptionName string) string {
+	return ExemptionAnnotationPrefix + exemptionName
+}
+
+// ExemptedMatchExpressions returns the match expressions injected by the exemptions in the namespace selector of a policy, sorted by exemption name.
+func ExemptedMatchExpressions(meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) (matchExpressions []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement) {
+	if meta == nil {
+		return matchExpressions
+	}
+
+	keys := make([]string, 0)
+
+	for key := range meta.Annotations {
+		if strings.HasPrefix(key, ExemptionAnnotationPrefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		matchExpressions = append(matchExpressions, DecodeExemptedMatchExpressions(meta.Annotations[key])...)
+	}
+
+	return matchExpressions
+}
+
+// EncodeExemptedMatchExpressions encodes the match expressions injected by an exemption as the value of its annotation.
+func EncodeExemptedMatchExpressions(matchExpressions []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement) string {
+	value, _ := json.Marshal(matchExpressions)
+
+	return string(value)
+}
+
+// DecodeExemptedMatchExpressions decodes the value of the annotation of an exemption, invalid values decode to no match expressions.
+func DecodeExemptedMatchExpressions(value string) (matchExpressions []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement) {
+	if err := json.Unmarshal([]byte(value), &matchExpressions); err != nil {
+		return nil
+	}
+
+	return matchExpressions
+}
+
+// AddMatchExpressions returns the namespace selector with the match expressions appended.
+func AddMatchExpressions(namespaceSelector *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector, matchExpressions []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement) *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector {
+	if len(matchExpressions) == 0 {
+		return namespaceSelector
+	}
+
+	selector := &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+		MatchExpressions: make([]*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement, 0),
+	}
+
+	if namespaceSelector != nil {
+		selector.MatchExpressions = append(selector.MatchExpressions, namespaceSelector.MatchExpressions...)
+	}
+
+	selector.MatchExpressions = append(selector.MatchExpressions, matchExpressions...)
+
+	return selector
+}
+
+// RemoveMatchExpressions returns the namespace selector without one occurrence of each of the match expressions.
+// The namespace selector is removed when no match expression is left, as the exemptions inject one when the policy has none.
+func RemoveMatchExpressions(namespaceSelector *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector, matchExpressions []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement) *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector {
+	if namespaceSelector == nil || len(matchExpressions) == 0 {
+		return namespaceSelector
+	}
+
+	remaining := make([]*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement, 0)
+	removed := make([]bool, len(matchExpressions))
+
+	for _, me := range namespaceSelector.MatchExpressions {
+		found := false
+
+		for i, exempted := range matchExpressions {
+			if !removed[i] && isSameRequirement(me, exempted) {
+				removed[i] = true
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			remaining = append(remaining, me)
+		}
+	}
+
+	if len(remaining) == 0 {
+		return nil
+	}
+
+	return &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+		MatchExpressions: remaining,
+	}
+}
+
+func isSameRequirement(a, b *policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	if a.Key != b.Key || a.Operator != b.Operator || len(a.Values) != len(b.Values) {
+		return false
+	}
+
+	for i := range a.Values {
+		if a.Values[i] != b.Values[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,53 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyexemption
+
+import (
+	policykindcustom "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
+	policykindimage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
+	policykindmutation "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
+	policykindnetwork "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network"
+	policykindquota "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
+	policykindsecurity "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
+)
+
+const (
+	ResourceName = "tanzu-mission-control_policy_exemption"
+
+	PolicyKindsKey      = "policy_kinds"
+	NamespacesKey       = "namespaces"
+	NamespaceLabelsKey  = "namespace_labels"
+	AffectedPoliciesKey = "affected_policies"
+
+	// namespaceNameLabelKey is the label set by Kubernetes on every namespace to its name.
+	namespaceNameLabelKey = "kubernetes.io/metadata.name"
+	notInOperator         = "NotIn"
+)
+
+// Kinds of policies which can be exempted.
+const (
+	CustomKind         = "custom"
+	SecurityKind       = "security"
+	ImageKind          = "image"
+	NetworkKind        = "network"
+	NamespaceQuotaKind = "namespace_quota"
+	MutationKind       = "mutation"
+)
+
+type policyKind struct {
+	resourceName string
+	policyType   string // Type of Policy as defined in API
+}
+
+var policyKinds = map[string]policyKind{
+	CustomKind:         {resourceName: policykindcustom.ResourceName, policyType: "custom-policy"},
+	SecurityKind:       {resourceName: policykindsecurity.ResourceName, policyType: "security-policy"},
+	ImageKind:          {resourceName: policykindimage.ResourceName, policyType: "image-policy"},
+	NetworkKind:        {resourceName: policykindnetwork.ResourceName, policyType: "network-policy"},
+	NamespaceQuotaKind: {resourceName: policykindquota.ResourceName, policyType: "namespace-quota-policy"},
+	MutationKind:       {resourceName: policykindmutation.ResourceName, policyType: "mutation-policy"},
+}
+
+var PolicyKinds = []string{CustomKind, SecurityKind, ImageKind, NetworkKind, NamespaceQuotaKind, MutationKind}
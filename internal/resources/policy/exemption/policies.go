@@ -0,0 +1,199 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyexemption
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/clustergroup"
+	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
+	policyworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/workspace"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+// scopedPolicy is a policy at the scope of an exemption.
+type scopedPolicy struct {
+	kind     string
+	fullname *scope.ScopedFullname
+	meta     *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	spec     *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec
+}
+
+func (p *scopedPolicy) name() string {
+	var name string
+
+	switch p.fullname.Scope {
+	case scope.ClusterGroupScope:
+		name = p.fullname.FullnameClusterGroup.Name
+	case scope.WorkspaceScope:
+		name = p.fullname.FullnameWorkspace.Name
+	case scope.OrganizationScope:
+		name = p.fullname.FullnameOrganization.Name
+	case scope.ClusterScope, scope.UnknownScope:
+	}
+
+	return fmt.Sprintf("%s/%s", p.kind, name)
+}
+
+// isExempted returns whether the namespace selector of the policy has exclusions injected by the exemption.
+func (p *scopedPolicy) isExempted(exemptionName string) bool {
+	if p.meta == nil {
+		return false
+	}
+
+	_, ok := p.meta.Annotations[policy.ExemptionAnnotationKey(exemptionName)]
+
+	return ok
+}
+
+// setExemption replaces the match expressions injected by the exemption in the namespace selector of the policy,
+// no match expressions removes the exemption from the policy. It returns whether the policy was changed.
+func (p *scopedPolicy) setExemption(exemptionName string, matchExpressions []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement) bool {
+	key := policy.ExemptionAnnotationKey(exemptionName)
+	value := policy.EncodeExemptedMatchExpressions(matchExpressions)
+
+	if p.meta == nil {
+		p.meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	recorded, ok := p.meta.Annotations[key]
+
+	switch {
+	case !ok && len(matchExpressions) == 0:
+		return false
+	case ok && len(matchExpressions) != 0 && recorded == value:
+		return false
+	}
+
+	if p.spec == nil {
+		p.spec = &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{}
+	}
+
+	p.spec.NamespaceSelector = policy.RemoveMatchExpressions(p.spec.NamespaceSelector, policy.DecodeExemptedMatchExpressions(recorded))
+
+	if len(matchExpressions) == 0 {
+		delete(p.meta.Annotations, key)
+
+		return true
+	}
+
+	if p.meta.Annotations == nil {
+		p.meta.Annotations = make(map[string]string)
+	}
+
+	p.meta.Annotations[key] = value
+	p.spec.NamespaceSelector = policy.AddMatchExpressions(p.spec.NamespaceSelector, matchExpressions)
+
+	return true
+}
+
+// policyKindOf returns the kind of exemptable policy having the type, if any.
+func policyKindOf(policyType string) (string, bool) {
+	for kind, pk := range policyKinds {
+		if pk.policyType == policyType {
+			return kind, true
+		}
+	}
+
+	return "", false
+}
+
+// listPolicies lists the policies of the exemptable kinds at the scope, sorted by kind and name.
+// nolint: dupl
+func listPolicies(config authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname) ([]*scopedPolicy, error) {
+	policies := make([]*scopedPolicy, 0)
+
+	add := func(fullname *scope.ScopedFullname, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) {
+		if spec == nil {
+			return
+		}
+
+		if kind, ok := policyKindOf(spec.Type); ok {
+			policies = append(policies, &scopedPolicy{kind: kind, fullname: fullname, meta: meta, spec: spec})
+		}
+	}
+
+	switch scopedFullnameData.Scope {
+	case scope.ClusterGroupScope:
+		resp, err := config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceList(scopedFullnameData.FullnameClusterGroup)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to list Tanzu Mission Control cluster group policies, cluster group : %s", scopedFullnameData.FullnameClusterGroup.ClusterGroupName)
+		}
+
+		for _, p := range resp.Policies {
+			add(&scope.ScopedFullname{Scope: scope.ClusterGroupScope, FullnameClusterGroup: p.FullName}, p.Meta, p.Spec)
+		}
+	case scope.WorkspaceScope:
+		resp, err := config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceList(scopedFullnameData.FullnameWorkspace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to list Tanzu Mission Control workspace policies, workspace : %s", scopedFullnameData.FullnameWorkspace.WorkspaceName)
+		}
+
+		for _, p := range resp.Policies {
+			add(&scope.ScopedFullname{Scope: scope.WorkspaceScope, FullnameWorkspace: p.FullName}, p.Meta, p.Spec)
+		}
+	case scope.OrganizationScope:
+		resp, err := config.TMCConnection.OrganizationPolicyResourceService.ManageV1alpha1OrganizationPolicyResourceServiceList(scopedFullnameData.FullnameOrganization)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to list Tanzu Mission Control organization policies, organization : %s", scopedFullnameData.FullnameOrganization.OrgID)
+		}
+
+		for _, p := range resp.Policies {
+			add(&scope.ScopedFullname{Scope: scope.OrganizationScope, FullnameOrganization: p.FullName}, p.Meta, p.Spec)
+		}
+	case scope.ClusterScope, scope.UnknownScope:
+		return nil, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v, %v, %v", scope.ClusterGroupKey, scope.WorkspaceKey, scope.OrganizationKey)
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].name() < policies[j].name()
+	})
+
+	return policies, nil
+}
+
+// updatePolicy updates the meta and the spec of the policy.
+func updatePolicy(config authctx.TanzuContext, p *scopedPolicy) (err error) {
+	switch p.fullname.Scope {
+	case scope.ClusterGroupScope:
+		_, err = config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceUpdate(&policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyPolicyRequest{
+			Policy: &policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyPolicy{
+				FullName: p.fullname.FullnameClusterGroup,
+				Meta:     p.meta,
+				Spec:     p.spec,
+			},
+		})
+	case scope.WorkspaceScope:
+		_, err = config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceUpdate(&policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyPolicyRequest{
+			Policy: &policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyPolicy{
+				FullName: p.fullname.FullnameWorkspace,
+				Meta:     p.meta,
+				Spec:     p.spec,
+			},
+		})
+	case scope.OrganizationScope:
+		_, err = config.TMCConnection.OrganizationPolicyResourceService.ManageV1alpha1OrganizationPolicyResourceServiceUpdate(&policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyPolicyRequest{
+			Policy: &policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyPolicy{
+				FullName: p.fullname.FullnameOrganization,
+				Meta:     p.meta,
+				Spec:     p.spec,
+			},
+		})
+	case scope.ClusterScope, scope.UnknownScope:
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "Unable to update Tanzu Mission Control %s policy entry, name : %s", p.kind, p.name())
+	}
+
+	return nil
+}
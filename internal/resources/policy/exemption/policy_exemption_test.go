@@ -0,0 +1,124 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyexemption
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+const testExemption = "system-namespaces"
+
+func TestConstructMatchExpressions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		namespaces  []interface{}
+		labels      map[string]interface{}
+		expected    []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement
+	}{
+		{
+			description: "namespaces only",
+			namespaces:  []interface{}{"kube-system", "team-x"},
+			expected: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+				{Key: namespaceNameLabelKey, Operator: notInOperator, Values: []string{"kube-system", "team-x"}},
+			},
+		},
+		{
+			description: "namespaces and labels, sorted",
+			namespaces:  []interface{}{"team-x", "kube-system"},
+			labels:      map[string]interface{}{"vmware-system": "true", "policy-exempt": "true"},
+			expected: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+				{Key: namespaceNameLabelKey, Operator: notInOperator, Values: []string{"kube-system", "team-x"}},
+				{Key: "policy-exempt", Operator: notInOperator, Values: []string{"true"}},
+				{Key: "vmware-system", Operator: notInOperator, Values: []string{"true"}},
+			},
+		},
+		{
+			description: "labels only",
+			labels:      map[string]interface{}{"policy-exempt": "true"},
+			expected: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+				{Key: "policy-exempt", Operator: notInOperator, Values: []string{"true"}},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := constructMatchExpressions(schema.NewSet(schema.HashString, test.namespaces), test.labels)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestSetExemption(t *testing.T) {
+	t.Parallel()
+
+	configured := &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+		Key:      "environment",
+		Operator: "In",
+		Values:   []string{"production"},
+	}
+	exclusions := []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+		{Key: namespaceNameLabelKey, Operator: notInOperator, Values: []string{"kube-system"}},
+	}
+	updatedExclusions := []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+		{Key: namespaceNameLabelKey, Operator: notInOperator, Values: []string{"kube-system", "team-x"}},
+	}
+
+	p := &scopedPolicy{
+		kind: SecurityKind,
+		fullname: &scope.ScopedFullname{
+			Scope:                scope.OrganizationScope,
+			FullnameOrganization: &policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyFullName{OrgID: "o1", Name: "baseline"},
+		},
+		meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{},
+		spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+			NamespaceSelector: &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+				MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{configured},
+			},
+		},
+	}
+
+	require.Equal(t, "security/baseline", p.name())
+	require.False(t, p.isExempted(testExemption))
+	require.False(t, p.setExemption(testExemption, nil), "removing an exemption which was never applied doesn't change the policy")
+
+	require.True(t, p.setExemption(testExemption, exclusions))
+	require.True(t, p.isExempted(testExemption))
+	require.Equal(t, append([]*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{configured}, exclusions...), p.spec.NamespaceSelector.MatchExpressions)
+	require.False(t, p.setExemption(testExemption, exclusions), "applying the same exemption twice doesn't change the policy")
+
+	require.True(t, p.setExemption(testExemption, updatedExclusions))
+	require.Equal(t, append([]*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{configured}, updatedExclusions...), p.spec.NamespaceSelector.MatchExpressions)
+	require.Equal(t, updatedExclusions, policy.ExemptedMatchExpressions(p.meta))
+
+	require.True(t, p.setExemption(testExemption, nil))
+	require.False(t, p.isExempted(testExemption))
+	require.Equal(t, []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{configured}, p.spec.NamespaceSelector.MatchExpressions)
+}
+
+func TestPolicyKindOf(t *testing.T) {
+	t.Parallel()
+
+	for _, kind := range PolicyKinds {
+		actual, ok := policyKindOf(policyKinds[kind].policyType)
+		require.True(t, ok)
+		require.Equal(t, kind, actual)
+	}
+
+	_, ok := policyKindOf("unknown-policy")
+	require.False(t, ok)
+}
@@ -0,0 +1,378 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyexemption
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	policyoperations "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/operations"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+var scopesAllowed = []string{scope.ClusterGroupKey, scope.WorkspaceKey, scope.OrganizationKey}
+
+var (
+	// The name of the exemption is the name of the annotations recording its exclusions in the policies.
+	exemptionNameRegex = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?$`)
+	namespaceNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+)
+
+func ResourcePolicyExemption() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyExemptionCreate,
+		ReadContext:   resourcePolicyExemptionRead,
+		UpdateContext: resourcePolicyExemptionUpdate,
+		DeleteContext: resourcePolicyExemptionDelete,
+		Schema:        policyExemptionSchema,
+		CustomizeDiff: customdiff.All(
+			schema.CustomizeDiffFunc(scope.ValidateScope(scopesAllowed)),
+			validatePolicyKinds,
+			planAffectedPolicies,
+		),
+	}
+}
+
+var policyExemptionSchema = map[string]*schema.Schema{
+	policy.NameKey: {
+		Type:         schema.TypeString,
+		Description:  "Name of the policy exemption",
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringMatch(exemptionNameRegex, "must be at most 63 characters, start and end with an alphanumeric character and only contain alphanumeric characters, '-', '_' or '.'"),
+	},
+	scope.ScopeKey: scope.ScopeSchema,
+	PolicyKindsKey: {
+		Type:        schema.TypeSet,
+		Description: fmt.Sprintf("Kinds of the policies at the scope which honor the exemption, valid values are: [%s]", strings.Join(PolicyKinds, ", ")),
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(PolicyKinds, false),
+		},
+	},
+	NamespacesKey: {
+		Type:         schema.TypeSet,
+		Description:  "Names of the namespaces exempted from the policies. Wildcards are not supported by the namespace selectors of the policies, label the namespaces and exempt them with namespace_labels instead.",
+		Optional:     true,
+		AtLeastOneOf: []string{NamespacesKey, NamespaceLabelsKey},
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.All(
+				validation.StringDoesNotContainAny("*"),
+				validation.StringMatch(namespaceNameRegex, "must be a valid namespace name"),
+			),
+		},
+	},
+	NamespaceLabelsKey: {
+		Type:         schema.TypeMap,
+		Description:  "Labels of the namespaces exempted from the policies, a namespace having any of the labels is exempted",
+		Optional:     true,
+		AtLeastOneOf: []string{NamespacesKey, NamespaceLabelsKey},
+		Elem:         &schema.Schema{Type: schema.TypeString},
+	},
+	AffectedPoliciesKey: {
+		Type:        schema.TypeList,
+		Description: "Policies honoring the exemption, as kind/name",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func resourcePolicyExemptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := applyExemption(m.(authctx.TanzuContext), d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(exemptionID(d))
+
+	log.Printf("[INFO] policy exemption created")
+
+	return resourcePolicyExemptionRead(ctx, d, m)
+}
+
+func resourcePolicyExemptionRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	exemptionName, _ := d.Get(policy.NameKey).(string)
+
+	scopedFullnameData := scope.ConstructScope(d, "")
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to read Tanzu Mission Control policy exemption entry; Scope full name is empty")
+	}
+
+	policies, err := listPolicies(config, scopedFullnameData)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	affectedPolicies := make([]string, 0)
+
+	for _, p := range policies {
+		if p.isExempted(exemptionName) {
+			affectedPolicies = append(affectedPolicies, p.name())
+		}
+	}
+
+	if err := d.Set(AffectedPoliciesKey, affectedPolicies); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourcePolicyExemptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if err := applyExemption(m.(authctx.TanzuContext), d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] policy exemption update successful")
+
+	return resourcePolicyExemptionRead(ctx, d, m)
+}
+
+func resourcePolicyExemptionDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	exemptionName, _ := d.Get(policy.NameKey).(string)
+
+	scopedFullnameData := scope.ConstructScope(d, "")
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to delete Tanzu Mission Control policy exemption entry; Scope full name is empty")
+	}
+
+	policies, err := listPolicies(config, scopedFullnameData)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	for _, p := range policies {
+		if p.setExemption(exemptionName, nil) {
+			if err := updatePolicy(config, p); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// applyExemption injects the exclusions of the exemption in the policies of the selected kinds at its scope,
+// and removes them from the policies of the other kinds.
+func applyExemption(config authctx.TanzuContext, d *schema.ResourceData) error {
+	exemptionName, _ := d.Get(policy.NameKey).(string)
+
+	scopedFullnameData := scope.ConstructScope(d, "")
+	if scopedFullnameData == nil {
+		return errors.New("Unable to apply Tanzu Mission Control policy exemption; Scope full name is empty")
+	}
+
+	policies, err := listPolicies(config, scopedFullnameData)
+	if err != nil {
+		return err
+	}
+
+	kinds := constructPolicyKinds(d.Get(PolicyKindsKey))
+	matchExpressions := constructMatchExpressions(d.Get(NamespacesKey), d.Get(NamespaceLabelsKey))
+
+	for _, p := range policies {
+		var exemptedMatchExpressions []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement
+
+		if slices.Contains(kinds, p.kind) {
+			exemptedMatchExpressions = matchExpressions
+		}
+
+		if p.setExemption(exemptionName, exemptedMatchExpressions) {
+			if err := updatePolicy(config, p); err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] updated exemption %s of %s policy", exemptionName, p.name())
+		}
+	}
+
+	return nil
+}
+
+// constructMatchExpressions constructs the match expressions excluding the namespaces and the namespaces having any of the labels,
+// sorted so that they don't change between two applies of the same exemption.
+func constructMatchExpressions(namespacesData, labelsData interface{}) []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement {
+	matchExpressions := make([]*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement, 0)
+
+	namespaces := make([]string, 0)
+
+	if set, ok := namespacesData.(*schema.Set); ok {
+		for _, raw := range set.List() {
+			namespaces = append(namespaces, raw.(string))
+		}
+	}
+
+	if len(namespaces) != 0 {
+		sort.Strings(namespaces)
+
+		matchExpressions = append(matchExpressions, &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+			Key:      namespaceNameLabelKey,
+			Operator: notInOperator,
+			Values:   namespaces,
+		})
+	}
+
+	labels, _ := labelsData.(map[string]interface{})
+	keys := make([]string, 0, len(labels))
+
+	for key := range labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		matchExpressions = append(matchExpressions, &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+			Key:      key,
+			Operator: notInOperator,
+			Values:   []string{fmt.Sprintf("%v", labels[key])},
+		})
+	}
+
+	return matchExpressions
+}
+
+func constructPolicyKinds(data interface{}) []string {
+	kinds := make([]string, 0)
+
+	if set, ok := data.(*schema.Set); ok {
+		for _, raw := range set.List() {
+			kinds = append(kinds, raw.(string))
+		}
+	}
+
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+// exemptionID returns the ID of the exemption, which has no server side counterpart.
+func exemptionID(d *schema.ResourceData) string {
+	exemptionName, _ := d.Get(policy.NameKey).(string)
+	scopedFullnameData := scope.ConstructScope(d, "")
+
+	var scopeID string
+
+	switch scopedFullnameData.Scope {
+	case scope.ClusterGroupScope:
+		scopeID = fmt.Sprintf("%s:%s", scope.ClusterGroupKey, scopedFullnameData.FullnameClusterGroup.ClusterGroupName)
+	case scope.WorkspaceScope:
+		scopeID = fmt.Sprintf("%s:%s", scope.WorkspaceKey, scopedFullnameData.FullnameWorkspace.WorkspaceName)
+	case scope.OrganizationScope:
+		scopeID = fmt.Sprintf("%s:%s", scope.OrganizationKey, scopedFullnameData.FullnameOrganization.OrgID)
+	case scope.ClusterScope, scope.UnknownScope:
+	}
+
+	return fmt.Sprintf("%s:%s", scopeID, exemptionName)
+}
+
+// validatePolicyKinds validates that the policies of the selected kinds can be created at the scope of the exemption.
+func validatePolicyKinds(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown(scope.ScopeKey) || !diff.NewValueKnown(PolicyKindsKey) {
+		return nil
+	}
+
+	scopedFullnameData := scope.ConstructScopeFromData(diff.Get(scope.ScopeKey), "")
+	if scopedFullnameData == nil {
+		return nil
+	}
+
+	var scopeKey string
+
+	switch scopedFullnameData.Scope {
+	case scope.ClusterGroupScope:
+		scopeKey = scope.ClusterGroupKey
+	case scope.WorkspaceScope:
+		scopeKey = scope.WorkspaceKey
+	case scope.OrganizationScope:
+		scopeKey = scope.OrganizationKey
+	case scope.ClusterScope, scope.UnknownScope:
+		return nil
+	}
+
+	unsupported := make([]string, 0)
+
+	for _, kind := range constructPolicyKinds(diff.Get(PolicyKindsKey)) {
+		if !slices.Contains(policyoperations.ScopeMap[policyKinds[kind].resourceName], scopeKey) {
+			unsupported = append(unsupported, kind)
+		}
+	}
+
+	if len(unsupported) != 0 {
+		return fmt.Errorf("policy kinds: [%s] are not valid: their policies can't be created at the %s scope", strings.Join(unsupported, ", "), scopeKey)
+	}
+
+	return nil
+}
+
+// planAffectedPolicies lists the policies which will honor the exemption, so that they are shown in the plan.
+// A policy created after the exemption makes the list differ from the state, and the exemption is then injected in it by the update.
+func planAffectedPolicies(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	config, ok := m.(authctx.TanzuContext)
+	if !ok || config.TMCConnection == nil || !diff.NewValueKnown(scope.ScopeKey) || !diff.NewValueKnown(PolicyKindsKey) {
+		return diff.SetNewComputed(AffectedPoliciesKey)
+	}
+
+	scopedFullnameData := scope.ConstructScopeFromData(diff.Get(scope.ScopeKey), "")
+	if scopedFullnameData == nil {
+		return nil
+	}
+
+	policies, err := listPolicies(config, scopedFullnameData)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			// The scope is created by the same apply.
+			return diff.SetNewComputed(AffectedPoliciesKey)
+		}
+
+		return err
+	}
+
+	kinds := constructPolicyKinds(diff.Get(PolicyKindsKey))
+	affectedPolicies := make([]interface{}, 0)
+
+	for _, p := range policies {
+		if slices.Contains(kinds, p.kind) {
+			affectedPolicies = append(affectedPolicies, p.name())
+		}
+	}
+
+	if old, _ := diff.GetChange(AffectedPoliciesKey); diff.Id() != "" && reflect.DeepEqual(old, affectedPolicies) {
+		return nil
+	}
+
+	return diff.SetNew(AffectedPoliciesKey, affectedPolicies)
+}
@@ -0,0 +1,122 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+const (
+	testNotIn         = "NotIn"
+	testNamespaceName = "kubernetes.io/metadata.name"
+)
+
+func TestExemptedMatchExpressions(t *testing.T) {
+	t.Parallel()
+
+	systemExclusion := &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+		Key:      testNamespaceName,
+		Operator: testNotIn,
+		Values:   []string{"kube-system"},
+	}
+	teamExclusion := &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+		Key:      "team",
+		Operator: testNotIn,
+		Values:   []string{"x"},
+	}
+
+	cases := []struct {
+		description string
+		input       *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+		expected    []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement
+	}{
+		{
+			description: "check for nil meta",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "meta without exemption annotations",
+			input: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				Annotations: map[string]string{"tmc.cloud.vmware.com/creator": "someone"},
+			},
+			expected: nil,
+		},
+		{
+			description: "meta with exemption annotations, sorted by exemption name",
+			input: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				Annotations: map[string]string{
+					ExemptionAnnotationKey("team-x"): EncodeExemptedMatchExpressions([]*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{teamExclusion}),
+					ExemptionAnnotationKey("system"): EncodeExemptedMatchExpressions([]*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{systemExclusion}),
+					ExemptionAnnotationKey("broken"): "not json",
+				},
+			},
+			expected: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{systemExclusion, teamExclusion},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := ExemptedMatchExpressions(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestAddAndRemoveMatchExpressions(t *testing.T) {
+	t.Parallel()
+
+	configured := &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+		Key:      "environment",
+		Operator: "In",
+		Values:   []string{"production"},
+	}
+	exclusion := &policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+		Key:      testNamespaceName,
+		Operator: testNotIn,
+		Values:   []string{"kube-system", "team-x"},
+	}
+
+	cases := []struct {
+		description string
+		input       *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector
+		added       *policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector
+	}{
+		{
+			description: "policy without namespace selector",
+			input:       nil,
+			added: &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+				MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{exclusion},
+			},
+		},
+		{
+			description: "policy with namespace selector",
+			input: &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+				MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{configured},
+			},
+			added: &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+				MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{configured, exclusion},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			added := AddMatchExpressions(test.input, []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{exclusion})
+			require.Equal(t, test.added, added)
+
+			// The recorded match expressions are decoded from the annotation, they aren't the injected pointers.
+			recorded := DecodeExemptedMatchExpressions(EncodeExemptedMatchExpressions([]*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{exclusion}))
+			require.Equal(t, test.input, RemoveMatchExpressions(added, recorded))
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	policyrecipecustommodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom/recipe"
@@ -29,6 +30,10 @@ var (
 		reciperesource.TMCCustomKey,
 	}
 
+	customRecipePath       = helper.GetFirstElementOf(policy.SpecKey, policy.InputKey, reciperesource.TMCCustomKey)
+	customTemplateNamePath = helper.GetFirstElementOf(policy.SpecKey, policy.InputKey, reciperesource.TMCCustomKey, reciperesource.TemplateNameKey)
+	customParametersPath   = helper.GetFirstElementOf(policy.SpecKey, policy.InputKey, reciperesource.TMCCustomKey, reciperesource.ParametersKey)
+
 	inputSchema = &schema.Schema{
 		Type:        schema.TypeList,
 		Description: fmt.Sprintf("Input for the custom policy, having one of the valid recipes: %v.", RecipesAllowed),
@@ -226,9 +231,12 @@ func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}
 		if recipeType, ok := recipeData.([]interface{}); ok && len(recipeType) != 0 {
 			config := i.(authctx.TanzuContext)
 
-			err := reciperesource.ValidateCustomRecipe(config, recipeType[0].(map[string]interface{}))
-			if err != nil {
-				return errors.Wrapf(err, "Custom Recipe validation failed:\n")
+			// The parameters are validated before the policy is created when they aren't known at plan time.
+			if diff.NewValueKnown(customTemplateNamePath) && diff.NewValueKnown(customParametersPath) {
+				err := reciperesource.ValidateCustomRecipe(config, recipeType[0].(map[string]interface{}))
+				if err != nil {
+					return errors.Wrapf(err, "Custom Recipe validation failed:\n")
+				}
 			}
 
 			recipesFound = append(recipesFound, reciperesource.TMCCustomKey)
@@ -243,3 +251,18 @@ func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}
 
 	return nil
 }
+
+// ValidateCustomRecipeParameters validates the parameters of the custom recipe of a custom policy, if any.
+func ValidateCustomRecipeParameters(config authctx.TanzuContext, d *schema.ResourceData) error {
+	customRecipe, _ := d.Get(customRecipePath).([]interface{})
+
+	if len(customRecipe) == 0 || customRecipe[0] == nil {
+		return nil
+	}
+
+	if err := reciperesource.ValidateCustomRecipe(config, customRecipe[0].(map[string]interface{})); err != nil {
+		return errors.Wrapf(err, "Custom Recipe validation failed:\n")
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	policyrecipecustommodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom"
 	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/common"
 )
 
@@ -25,10 +26,11 @@ var TMCBlockRolebindingSubjects = &schema.Schema{
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			AuditKey: {
-				Type:        schema.TypeBool,
-				Description: auditDryRunDotDescription,
-				Optional:    true,
-				Default:     false,
+				Type:             schema.TypeBool,
+				Description:      auditDryRunDotDescription,
+				Optional:         true,
+				Default:          false,
+				DiffSuppressFunc: policy.SuppressAuditDiff,
 			},
 			ParametersKey: {
 				Type:        schema.TypeList,
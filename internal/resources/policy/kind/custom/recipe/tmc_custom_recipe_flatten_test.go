@@ -101,3 +101,102 @@ func TestValidateRecipeParameters(t *testing.T) {
 		})
 	}
 }
+
+const testRequiredLabelsTemplateManifest = `
+apiVersion: templates.gatekeeper.sh/v1beta1
+kind: ConstraintTemplate
+metadata:
+  name: k8srequiredlabels
+spec:
+  crd:
+    spec:
+      names:
+        kind: K8sRequiredLabels
+      validation:
+        openAPIV3Schema:
+          type: object
+          required:
+            - labels
+          properties:
+            message:
+              type: string
+            labels:
+              type: array
+              items:
+                type: object
+                required:
+                  - key
+                properties:
+                  key:
+                    type: string
+                    minLength: 1
+                  allowedRegex:
+                    type: string
+  targets:
+    - target: admission.k8s.gatekeeper.sh
+      rego: |
+        package k8srequiredlabels
+`
+
+func TestValidateTemplateParameters(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description      string
+		templateManifest string
+		parameters       string
+		expectedErrors   []string
+	}{
+		{
+			description:      "scenario for valid template parameters",
+			templateManifest: testRequiredLabelsTemplateManifest,
+			parameters:       `{"message": "missing labels", "labels": [{"key": "owner", "allowedRegex": "^[a-z]+$"}]}`,
+		},
+		{
+			description:      "scenario for missing required template parameter",
+			templateManifest: testRequiredLabelsTemplateManifest,
+			parameters:       `{"message": "missing labels"}`,
+			expectedErrors:   []string{"Key 'labels' is required but not provided."},
+		},
+		{
+			description:      "scenario for misspelled nested template parameter",
+			templateManifest: testRequiredLabelsTemplateManifest,
+			parameters:       `{"labels": [{"key": "owner"}, {"key": "team", "allowedRegx": "^[a-z]+$"}]}`,
+			expectedErrors:   []string{"Value validation failed for key 'labels': Key 'labels[1].allowedRegx' is not expected in the schema."},
+		},
+		{
+			description:      "scenario for template parameter of the wrong type",
+			templateManifest: testRequiredLabelsTemplateManifest,
+			parameters:       `{"labels": [{"key": 1}]}`,
+			expectedErrors:   []string{"Value validation failed for key 'labels': Key 'labels[0].key' should be a string, type provided: float64"},
+		},
+		{
+			description:      "scenario for invalid JSON template parameters",
+			templateManifest: testRequiredLabelsTemplateManifest,
+			parameters:       `{"labels": [`,
+			expectedErrors:   []string{"parameters are not a valid JSON object: unexpected end of JSON input"},
+		},
+		{
+			description:      "scenario for template without parameters schema",
+			templateManifest: "apiVersion: templates.gatekeeper.sh/v1beta1\nkind: ConstraintTemplate\nspec:\n  crd:\n    spec:\n      names:\n        kind: K8sNoParameters\n",
+			parameters:       `{"anything": true}`,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := make([]string, 0)
+
+			for _, err := range ValidateTemplateParameters(test.templateManifest, test.parameters) {
+				actual = append(actual, err.Error())
+			}
+
+			if len(test.expectedErrors) == 0 {
+				require.Empty(t, actual)
+			} else {
+				require.Equal(t, test.expectedErrors, actual)
+			}
+		})
+	}
+}
@@ -6,19 +6,21 @@ package recipe
 
 import (
 	"encoding/json"
-	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	openapiv3 "github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper/openapi_v3_schema_validator"
+	custompolicytemplatemodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/custompolicytemplate"
 	policyrecipecustommodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom"
 	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
 	recipemodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/recipe"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/common"
 )
 
@@ -36,10 +38,11 @@ var TMCCustomSchema = &schema.Schema{
 				Required:    true,
 			},
 			AuditKey: {
-				Type:        schema.TypeBool,
-				Description: auditDryRunDotDescription,
-				Optional:    true,
-				Default:     false,
+				Type:             schema.TypeBool,
+				Description:      auditDryRunDotDescription,
+				Optional:         true,
+				Default:          false,
+				DiffSuppressFunc: policy.SuppressAuditDiff,
 			},
 			ParametersKey: {
 				Type:        schema.TypeString,
@@ -105,47 +108,124 @@ func ConstructTMCCustom(customRecipe []interface{}) (customInputModel *policyrec
 	return customInputModel
 }
 
+// ValidateCustomRecipe validates the parameters of a custom recipe against the openAPIV3Schema of its policy template in TMC,
+// or against the input schema of the recipe when its policy template isn't available.
+// A policy template created in the same apply can't be read at plan time, the parameters are validated again before the policy is created.
 func ValidateCustomRecipe(config authctx.TanzuContext, customRecipe map[string]interface{}) error {
-	errMessages := make([]string, 0)
 	customTemplateName := customRecipe[TemplateNameKey].(string)
+	parameters, _ := customRecipe[ParametersKey].(string)
+
+	errs := validateTMCTemplateParameters(config, customTemplateName, parameters)
+
+	if len(errs) > 0 {
+		errMessages := make([]string, 0, len(errs))
+
+		for _, e := range errs {
+			errMessages = append(errMessages, e.Error())
+		}
 
-	recipeModel := &recipemodels.VmwareTanzuManageV1alpha1PolicyTypeRecipeFullName{
-		TypeName: "custom-policy",
-		Name:     customTemplateName,
+		return errors.Errorf("parameters are not valid for template %s:\n%s", customTemplateName, strings.Join(errMessages, "\n"))
 	}
 
-	recipeData, err := config.TMCConnection.RecipeResourceService.RecipeResourceServiceGet(recipeModel)
-	if err != nil {
-		// NOTE: If error is 404 not found then do not fail the planning. This fix ensures that if user tries to create the new custom template
-		// and assign the same template to the custom policy in a single terraform apply operation will be able to proceed, instead of failing in the planning phase.
-		if !clienterrors.IsNotFoundError(err) {
-			errMessages = append(errMessages, err.Error())
+	return nil
+}
+
+// validateTMCTemplateParameters validates the parameters of a custom recipe against its policy template in TMC,
+// or against the input schema of the recipe when there is no such policy template.
+func validateTMCTemplateParameters(config authctx.TanzuContext, customTemplateName string, parameters string) (errs []error) {
+	templateData, err := config.TMCConnection.CustomPolicyTemplateResourceService.CustomPolicyTemplateResourceServiceGet(
+		&custompolicytemplatemodels.VmwareTanzuManageV1alpha1PolicyTemplateFullName{Name: customTemplateName},
+	)
+
+	switch {
+	case err == nil:
+		if templateData.Template != nil && templateData.Template.Spec != nil {
+			errs = ValidateTemplateParameters(templateData.Template.Spec.Object, parameters)
 		}
-	} else {
-		errs := ValidateRecipeParameters(recipeData.Recipe.Spec.InputSchema, customRecipe[ParametersKey].(string))
-
-		if len(errs) > 0 {
-			errMsg := ""
-
-			for _, e := range errs {
-				if errMsg == "" {
-					errMsg = e.Error()
-				} else {
-					errMsg = fmt.Sprintf("%s\n%s", errMsg, e.Error())
-				}
+	case !clienterrors.IsNotFoundError(err):
+		errs = append(errs, err)
+	default:
+		recipeModel := &recipemodels.VmwareTanzuManageV1alpha1PolicyTypeRecipeFullName{
+			TypeName: "custom-policy",
+			Name:     customTemplateName,
+		}
+
+		recipeData, err := config.TMCConnection.RecipeResourceService.RecipeResourceServiceGet(recipeModel)
+		if err != nil {
+			// NOTE: If error is 404 not found then do not fail the planning. This fix ensures that if user tries to create the new custom template
+			// and assign the same template to the custom policy in a single terraform apply operation will be able to proceed, instead of failing in the planning phase.
+			// The parameters are validated again before the policy is created, once the template exists.
+			if !clienterrors.IsNotFoundError(err) {
+				errs = append(errs, err)
 			}
+		} else {
+			errs = ValidateRecipeParameters(recipeData.Recipe.Spec.InputSchema, parameters)
+		}
+	}
+
+	return errs
+}
+
+// ValidateTemplateParameters validates the JSON encoded parameters of a custom policy against the openAPIV3Schema
+// of the Gatekeeper ConstraintTemplate manifest, the errors hold the path of the invalid parameters.
+func ValidateTemplateParameters(templateManifest string, parameters string) (errs []error) {
+	template := make(map[string]interface{})
+
+	if err := yaml.Unmarshal([]byte(templateManifest), &template); err != nil {
+		return []error{errors.Wrap(err, "template manifest is not valid YAML")}
+	}
+
+	parametersSchema := templateParametersSchema(template)
+	properties, _ := parametersSchema[string(openapiv3.PropertiesKey)].(map[string]interface{})
 
-			errMessages = append(errMessages, errMsg)
+	if len(properties) == 0 {
+		return nil
+	}
+
+	parametersJSON := make(map[string]interface{})
+
+	if parameters != "" {
+		if err := json.Unmarshal([]byte(parameters), &parametersJSON); err != nil {
+			return []error{errors.Wrap(err, "parameters are not a valid JSON object")}
 		}
 	}
 
-	if len(errMessages) > 0 {
-		errMsg := strings.Join(errMessages, "\n")
+	openAPIV3Validator := &openapiv3.OpenAPIV3SchemaValidator{
+		Schema:             properties,
+		AllowUnknownFields: openapiv3.AllowsUnknownFields(parametersSchema),
+		SchemaName:         "template parameters",
+	}
 
-		return errors.New(errMsg)
+	errs = append(errs, openAPIV3Validator.ValidateRequiredFields(parametersJSON)...)
+	errs = append(errs, openAPIV3Validator.ValidateFormat(parametersJSON)...)
+
+	if required, ok := parametersSchema[string(openapiv3.RequiredKey)].([]interface{}); ok {
+		for _, key := range required {
+			if _, ok := parametersJSON[key.(string)]; !ok {
+				errs = append(errs, errors.Errorf("Key '%s' is required but not provided.", key))
+			}
+		}
 	}
 
-	return nil
+	return errs
+}
+
+// templateParametersSchema returns the openAPIV3Schema of the parameters of a Gatekeeper ConstraintTemplate, nil when it has none.
+func templateParametersSchema(template map[string]interface{}) map[string]interface{} {
+	var value interface{} = template
+
+	for _, key := range []string{"spec", "crd", "spec", "validation", "openAPIV3Schema"} {
+		valueMap, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		value = valueMap[key]
+	}
+
+	parametersSchema, _ := value.(map[string]interface{})
+
+	return parametersSchema
 }
 
 func ValidateRecipeParameters(recipeSchema string, recipeParameters string) (errs []error) {
@@ -26,6 +26,9 @@ func ResourceCustomPolicy() *schema.Resource {
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindcustom.ResourceName])),
 			policykindcustom.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateEnforcementAction(policyoperations.EnforcementActionMap[policykindcustom.ResourceName]),
+			policy.PlanEnforcementAction,
+			policy.PlanChangeSummary(policykindcustom.SpecSchema, policyoperations.LooseningRulesMap[policykindcustom.ResourceName]),
 		),
 	}
 }
@@ -37,7 +40,9 @@ var customPolicySchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	scope.ScopeKey: scope.ScopeSchema,
-	common.MetaKey: common.Meta,
-	policy.SpecKey: policykindcustom.SpecSchema,
+	scope.ScopeKey:              scope.ScopeSchema,
+	common.MetaKey:              common.Meta,
+	policy.SpecKey:              policykindcustom.SpecSchema,
+	policy.EnforcementActionKey: policy.EnforcementActionSchema,
+	policy.ChangeSummaryKey:     policy.ChangeSummarySchema,
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	policyrecipeimagemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/image"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 )
 
 var Custom = &schema.Schema{
@@ -20,10 +21,11 @@ var Custom = &schema.Schema{
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			AuditKey: {
-				Type:        schema.TypeBool,
-				Description: auditDryRunViolationsDescription,
-				Optional:    true,
-				Default:     false,
+				Type:             schema.TypeBool,
+				Description:      auditDryRunViolationsDescription,
+				Optional:         true,
+				Default:          false,
+				DiffSuppressFunc: policy.SuppressAuditDiff,
 			},
 			RulesKey: {
 				Type:        schema.TypeList,
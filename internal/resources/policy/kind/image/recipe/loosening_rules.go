@@ -0,0 +1,24 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package recipe
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+)
+
+// LooseningRules tell which changes of the recipes loosen an image policy.
+// The rules of the allowed_name_tag and custom recipes are allow lists: adding rules allows more images.
+var LooseningRules = &policy.LooseningRules{
+	Enabled: []string{
+		AuditKey,
+	},
+	Added: []string{
+		policy.MatchExpressionsKey,
+		RulesKey,
+	},
+	Orders: map[string][]string{
+		policy.EnforcementActionKey: {policy.EnforcementActionDeny, policy.EnforcementActionDryRun},
+	},
+}
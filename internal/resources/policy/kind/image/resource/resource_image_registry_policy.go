@@ -26,6 +26,9 @@ func ResourceImagePolicy() *schema.Resource {
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindimage.ResourceName])),
 			policykindimage.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateEnforcementAction(policyoperations.EnforcementActionMap[policykindimage.ResourceName]),
+			policy.PlanEnforcementAction,
+			policy.PlanChangeSummary(policykindimage.SpecSchema, policyoperations.LooseningRulesMap[policykindimage.ResourceName]),
 		),
 	}
 }
@@ -37,7 +40,9 @@ var imagePolicySchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	scope.ScopeKey: scope.ScopeSchema,
-	common.MetaKey: common.Meta,
-	policy.SpecKey: policykindimage.SpecSchema,
+	scope.ScopeKey:              scope.ScopeSchema,
+	common.MetaKey:              common.Meta,
+	policy.SpecKey:              policykindimage.SpecSchema,
+	policy.EnforcementActionKey: policy.EnforcementActionSchema,
+	policy.ChangeSummaryKey:     policy.ChangeSummarySchema,
 }
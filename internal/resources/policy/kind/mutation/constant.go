@@ -10,10 +10,12 @@ import (
 )
 
 const (
-	ResourceName             = "tanzu-mission-control_mutation_policy"
-	typePolicy               = "mutation-policy" // Type of Policy as defined in API
-	UnknownRecipe     Recipe = policy.UnknownRecipe
-	PodSecurityRecipe Recipe = recipe.PodSecurityKey
-	LabelRecipe       Recipe = recipe.LabelKey
-	AnnotationRecipe  Recipe = recipe.AnnotationKey
+	ResourceName                      = "tanzu-mission-control_mutation_policy"
+	typePolicy                        = "mutation-policy" // Type of Policy as defined in API
+	UnknownRecipe              Recipe = policy.UnknownRecipe
+	PodSecurityRecipe          Recipe = recipe.PodSecurityKey
+	LabelRecipe                Recipe = recipe.LabelKey
+	AnnotationRecipe           Recipe = recipe.AnnotationKey
+	AssignRecipe               Recipe = recipe.AssignKey
+	ImageRegistryRewriteRecipe Recipe = recipe.ImageRegistryRewriteKey
 )
@@ -26,22 +26,26 @@ var (
 		ForceNew:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				reciperesource.PodSecurityKey: reciperesource.PodSecuritySchema,
-				reciperesource.LabelKey:       reciperesource.LabelSchema,
-				reciperesource.AnnotationKey:  reciperesource.AnnotationSchema,
+				reciperesource.PodSecurityKey:          reciperesource.PodSecuritySchema,
+				reciperesource.LabelKey:                reciperesource.LabelSchema,
+				reciperesource.AnnotationKey:           reciperesource.AnnotationSchema,
+				reciperesource.AssignKey:               reciperesource.AssignSchema,
+				reciperesource.ImageRegistryRewriteKey: reciperesource.ImageRegistryRewriteSchema,
 			},
 		},
 	}
-	RecipesAllowed = [...]string{reciperesource.PodSecurityKey, reciperesource.LabelKey, reciperesource.AnnotationKey}
+	RecipesAllowed = [...]string{reciperesource.PodSecurityKey, reciperesource.LabelKey, reciperesource.AnnotationKey, reciperesource.AssignKey, reciperesource.ImageRegistryRewriteKey}
 )
 
 type (
 	Recipe      string
 	inputRecipe struct {
-		recipe      Recipe
-		podSecurity *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity
-		label       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label
-		annotation  *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation
+		recipe               Recipe
+		podSecurity          *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity
+		label                *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label
+		annotation           *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation
+		assign               *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign
+		imageRegistryRewrite *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite
 	}
 )
 
@@ -75,6 +79,20 @@ func constructInput(data []interface{}) (inputRecipeData *inputRecipe) {
 					annotation: reciperesource.ConstructAnnotation(ir),
 				}
 			}
+		case reciperesource.AssignKey:
+			if ir, ok := input.([]interface{}); ok && len(ir) != 0 {
+				inputRecipeData = &inputRecipe{
+					recipe: AssignRecipe,
+					assign: reciperesource.ConstructAssign(ir),
+				}
+			}
+		case reciperesource.ImageRegistryRewriteKey:
+			if ir, ok := input.([]interface{}); ok && len(ir) != 0 {
+				inputRecipeData = &inputRecipe{
+					recipe:               ImageRegistryRewriteRecipe,
+					imageRegistryRewrite: reciperesource.ConstructImageRegistryRewrite(ir),
+				}
+			}
 		}
 	}
 
@@ -95,6 +113,10 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 		flattenInputData[reciperesource.LabelKey] = reciperesource.FlattenLabel(inputRecipeData.label)
 	case AnnotationRecipe:
 		flattenInputData[reciperesource.AnnotationKey] = reciperesource.FlattenAnnotation(inputRecipeData.annotation)
+	case AssignRecipe:
+		flattenInputData[reciperesource.AssignKey] = reciperesource.FlattenAssign(inputRecipeData.assign)
+	case ImageRegistryRewriteRecipe:
+		flattenInputData[reciperesource.ImageRegistryRewriteKey] = reciperesource.FlattenImageRegistryRewrite(inputRecipeData.imageRegistryRewrite)
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -145,9 +167,7 @@ func ValidateInput(_ context.Context, diff *schema.ResourceDiff, _ interface{})
 }
 
 func appendRecipeFromInput(inputData map[string]interface{}) (recipesFound []string) {
-	recipeKeys := []string{reciperesource.PodSecurityKey, reciperesource.LabelKey, reciperesource.AnnotationKey}
-
-	for _, recipeKey := range recipeKeys {
+	for _, recipeKey := range RecipesAllowed {
 		if recipeData, ok := inputData[recipeKey]; ok {
 			if recipeType, ok := recipeData.([]interface{}); ok && len(recipeType) != 0 {
 				recipesFound = append(recipesFound, recipeKey)
@@ -0,0 +1,119 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+)
+
+const (
+	testImagePullPolicyLocation = "spec.containers[name:*].imagePullPolicy"
+	testTolerationsLocation     = "spec.tolerations"
+)
+
+func TestFlattenAssign(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil mutation assign",
+		},
+		{
+			description: "flatten normal assign mutation policy struct",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign{
+				Location: testImagePullPolicyLocation,
+				Value:    "IfNotPresent",
+				PathTests: []*policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1AssignPathTest{
+					{
+						SubPath:   testImagePullPolicyLocation,
+						Condition: mustNotExistCondition,
+					},
+				},
+				Scope: policyrecipemutationcommonmodel.NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(policyrecipemutationcommonmodel.Cluster),
+				TargetKubernetesResources: []*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources{
+					{
+						APIGroups: []string{testPolicy},
+						Kinds:     []string{testPod},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					locationKey: testImagePullPolicyLocation,
+					valueKey:    `"IfNotPresent"`,
+					pathTestsKey: []interface{}{
+						map[string]interface{}{
+							subPathKey:   testImagePullPolicyLocation,
+							conditionKey: mustNotExistCondition,
+						},
+					},
+					scopeKey: testCluster,
+					targetKubernetesResourcesKey: []interface{}{
+						map[string]interface{}{
+							apiGroupsKey: []string{testPolicy},
+							kindsKey:     []string{testPod},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenAssign(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestConstructAssign(t *testing.T) {
+	t.Parallel()
+
+	actual := ConstructAssign([]interface{}{
+		map[string]interface{}{
+			locationKey: testTolerationsLocation,
+			valueKey:    `[{"key": "dedicated", "operator": "Exists"}]`,
+			pathTestsKey: []interface{}{
+				map[string]interface{}{
+					subPathKey:   testTolerationsLocation,
+					conditionKey: mustNotExistCondition,
+				},
+			},
+			scopeKey: testCluster,
+		},
+	})
+
+	require.Equal(t, &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign{
+		Location: testTolerationsLocation,
+		Value:    []interface{}{map[string]interface{}{"key": "dedicated", "operator": "Exists"}},
+		PathTests: []*policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1AssignPathTest{
+			{
+				SubPath:   testTolerationsLocation,
+				Condition: mustNotExistCondition,
+			},
+		},
+		Scope: policyrecipemutationcommonmodel.NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(policyrecipemutationcommonmodel.Cluster),
+	}, actual)
+}
+
+func TestIsJSONValueEqual(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isJSONValueEqual("", `{"cpu":"100m","memory":"128Mi"}`, `{"memory": "128Mi", "cpu": "100m"}`, nil))
+	require.False(t, isJSONValueEqual("", `{"cpu":"100m"}`, `{"cpu":"200m"}`, nil))
+	require.False(t, isJSONValueEqual("", "", `"IfNotPresent"`, nil))
+}
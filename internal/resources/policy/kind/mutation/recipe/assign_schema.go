@@ -0,0 +1,205 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package recipe
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/common"
+)
+
+var AssignSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for assign mutation policy recipe version v1, which sets the value of a field of the target Kubernetes resources",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			targetKubernetesResourcesKey: common.TargetKubernetesResourcesSchema,
+			scopeKey: {
+				Type:         schema.TypeString,
+				Description:  "Scope",
+				Optional:     true,
+				Default:      "*",
+				ValidateFunc: validation.StringInSlice([]string{"*", "Cluster", "Namespaced"}, false),
+			},
+			locationKey: {
+				Type:        schema.TypeString,
+				Description: "Path of the field to be mutated, e.g. 'spec.containers[name:*].imagePullPolicy'. The fields of the metadata can't be mutated by this recipe, use the label and annotation recipes instead.",
+				Required:    true,
+				ValidateFunc: validation.All(
+					validation.StringIsNotWhiteSpace,
+					validation.StringDoesNotMatch(regexp.MustCompile(`^metadata(\.|$)`), "the fields of the metadata can't be mutated by the assign recipe"),
+				),
+			},
+			valueKey: {
+				Type:             schema.TypeString,
+				Description:      "JSON encoded value assigned to the field, e.g. '\"IfNotPresent\"' or '[{\"key\": \"dedicated\", \"operator\": \"Exists\"}]'.",
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: isJSONValueEqual,
+			},
+			pathTestsKey: {
+				Type:        schema.TypeList,
+				Description: "Conditions on the sub paths of the location which must be satisfied for the value to be assigned",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						subPathKey: {
+							Type:        schema.TypeString,
+							Description: "Prefix of the location to be tested, e.g. 'spec.containers[name:*].resources'",
+							Required:    true,
+						},
+						conditionKey: {
+							Type:         schema.TypeString,
+							Description:  "Condition on the sub path, valid values are: [MustExist, MustNotExist]",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{mustExistCondition, mustNotExistCondition}, false),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func ConstructAssign(data []interface{}) (assignModel *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign) {
+	if len(data) == 0 || data[0] == nil {
+		return assignModel
+	}
+
+	assignData, _ := data[0].(map[string]interface{})
+
+	assignModel = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign{}
+
+	if v, ok := assignData[locationKey]; ok {
+		helper.SetPrimitiveValue(v, &assignModel.Location, locationKey)
+	}
+
+	if v, ok := assignData[valueKey]; ok {
+		if valueJSON, ok := v.(string); ok && valueJSON != "" {
+			_ = json.Unmarshal([]byte(valueJSON), &assignModel.Value)
+		}
+	}
+
+	if v, ok := assignData[pathTestsKey]; ok {
+		if vs, ok := v.([]interface{}); ok {
+			for _, raw := range vs {
+				pathTestData, _ := raw.(map[string]interface{})
+				if pathTestData == nil {
+					continue
+				}
+
+				pathTest := &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1AssignPathTest{}
+
+				if v, ok := pathTestData[subPathKey]; ok {
+					helper.SetPrimitiveValue(v, &pathTest.SubPath, subPathKey)
+				}
+
+				if v, ok := pathTestData[conditionKey]; ok {
+					helper.SetPrimitiveValue(v, &pathTest.Condition, conditionKey)
+				}
+
+				assignModel.PathTests = append(assignModel.PathTests, pathTest)
+			}
+		}
+	}
+
+	if scope, ok := assignData[scopeKey]; ok {
+		mutationScope := policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(scope.(string))
+		assignModel.Scope = &mutationScope
+	}
+
+	if v, ok := assignData[targetKubernetesResourcesKey]; ok {
+		if vs, ok := v.([]interface{}); ok {
+			if len(vs) != 0 && vs[0] != nil {
+				assignModel.TargetKubernetesResources = make([]*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources, 0)
+
+				for _, raw := range vs {
+					assignModel.TargetKubernetesResources = append(assignModel.TargetKubernetesResources, common.ExpandTargetKubernetesResources(raw))
+				}
+			}
+		}
+	}
+
+	return assignModel
+}
+
+func FlattenAssign(mutationAssign *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign) (data []interface{}) {
+	if mutationAssign == nil {
+		return data
+	}
+
+	flattenAssign := make(map[string]interface{})
+
+	flattenAssign[locationKey] = mutationAssign.Location
+
+	if mutationAssign.Value != nil {
+		valueJSONBytes, _ := json.Marshal(mutationAssign.Value)
+		flattenAssign[valueKey] = helper.ConvertToString(valueJSONBytes, "")
+	}
+
+	if mutationAssign.PathTests != nil {
+		pathTests := make([]interface{}, 0, len(mutationAssign.PathTests))
+
+		for _, pathTest := range mutationAssign.PathTests {
+			if pathTest == nil {
+				continue
+			}
+
+			pathTests = append(pathTests, map[string]interface{}{
+				subPathKey:   pathTest.SubPath,
+				conditionKey: pathTest.Condition,
+			})
+		}
+
+		flattenAssign[pathTestsKey] = pathTests
+	}
+
+	if mutationAssign.Scope != nil {
+		flattenAssign[scopeKey] = string(*mutationAssign.Scope)
+	}
+
+	if mutationAssign.TargetKubernetesResources != nil {
+		var targetKubernetesResources []interface{}
+
+		for _, tkr := range mutationAssign.TargetKubernetesResources {
+			targetKubernetesResources = append(targetKubernetesResources, common.FlattenTargetKubernetesResources(tkr))
+		}
+
+		flattenAssign[targetKubernetesResourcesKey] = targetKubernetesResources
+	}
+
+	return []interface{}{flattenAssign}
+}
+
+// isJSONValueEqual suppresses the difference between two JSON encoded values which only differ by their formatting.
+func isJSONValueEqual(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	if oldValue == newValue {
+		return true
+	}
+
+	var oldValueJSON, newValueJSON interface{}
+
+	if err := json.Unmarshal([]byte(oldValue), &oldValueJSON); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(newValue), &newValueJSON); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldValueJSON, newValueJSON)
+}
@@ -8,6 +8,8 @@ const (
 	PodSecurityKey               = "pod_security"
 	LabelKey                     = "label"
 	AnnotationKey                = "annotation"
+	AssignKey                    = "assign"
+	ImageRegistryRewriteKey      = "image_registry_rewrite"
 	targetKubernetesResourcesKey = "target_kubernetes_resources"
 	scopeKey                     = "scope"
 	apiGroupsKey                 = "api_groups"
@@ -34,7 +36,15 @@ const (
 	supplementalGroupsKey        = "supplemental_groups"
 	minKey                       = "min"
 	maxKey                       = "max"
+	locationKey                  = "location"
+	pathTestsKey                 = "path_tests"
+	subPathKey                   = "sub_path"
+	rulesKey                     = "rules"
+	sourceRegistryKey            = "source_registry"
+	targetRegistryKey            = "target_registry"
 	alwaysConditionOp            = "Always"
 	ifFieldDoesNotExistOp        = "IfFieldDoesNotExist"
 	ifFieldExistsOp              = "IfFieldExists"
+	mustExistCondition           = "MustExist"
+	mustNotExistCondition        = "MustNotExist"
 )
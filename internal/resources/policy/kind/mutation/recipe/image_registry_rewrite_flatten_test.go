@@ -0,0 +1,77 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+)
+
+const (
+	testSourceRegistry = "docker.io"
+	testTargetRegistry = "registry.example.com/docker.io"
+)
+
+func TestFlattenImageRegistryRewrite(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil mutation image registry rewrite",
+		},
+		{
+			description: "flatten normal image registry rewrite mutation policy struct",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite{
+				Rules: []*policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewriteRule{
+					{
+						SourceRegistry: testSourceRegistry,
+						TargetRegistry: testTargetRegistry,
+					},
+				},
+				Scope: policyrecipemutationcommonmodel.NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(policyrecipemutationcommonmodel.Cluster),
+				TargetKubernetesResources: []*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources{
+					{
+						APIGroups: []string{testPolicy},
+						Kinds:     []string{testPod},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					rulesKey: []interface{}{
+						map[string]interface{}{
+							sourceRegistryKey: testSourceRegistry,
+							targetRegistryKey: testTargetRegistry,
+						},
+					},
+					scopeKey: testCluster,
+					targetKubernetesResourcesKey: []interface{}{
+						map[string]interface{}{
+							apiGroupsKey: []string{testPolicy},
+							kindsKey:     []string{testPod},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenImageRegistryRewrite(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/common"
+)
+
+var ImageRegistryRewriteSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for image registry rewrite mutation policy recipe version v1, which rewrites the registry of the images of the containers, e.g. to pull them from a mirror in air-gapped clusters",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			targetKubernetesResourcesKey: common.TargetKubernetesResourcesSchema,
+			scopeKey: {
+				Type:         schema.TypeString,
+				Description:  "Scope",
+				Optional:     true,
+				Default:      "*",
+				ValidateFunc: validation.StringInSlice([]string{"*", "Cluster", "Namespaced"}, false),
+			},
+			rulesKey: {
+				Type:        schema.TypeList,
+				Description: "Registries rewritten in the images of the containers, init containers and ephemeral containers",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						sourceRegistryKey: {
+							Type:         schema.TypeString,
+							Description:  "Registry of the images to be rewritten, e.g. 'docker.io'",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						targetRegistryKey: {
+							Type:         schema.TypeString,
+							Description:  "Registry replacing the source registry, e.g. 'registry.example.com/docker.io'",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func ConstructImageRegistryRewrite(data []interface{}) (imageRegistryRewriteModel *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite) {
+	if len(data) == 0 || data[0] == nil {
+		return imageRegistryRewriteModel
+	}
+
+	imageRegistryRewriteData, _ := data[0].(map[string]interface{})
+
+	imageRegistryRewriteModel = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite{}
+
+	if v, ok := imageRegistryRewriteData[rulesKey]; ok {
+		if vs, ok := v.([]interface{}); ok {
+			for _, raw := range vs {
+				ruleData, _ := raw.(map[string]interface{})
+				if ruleData == nil {
+					continue
+				}
+
+				rule := &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewriteRule{}
+
+				if v, ok := ruleData[sourceRegistryKey]; ok {
+					helper.SetPrimitiveValue(v, &rule.SourceRegistry, sourceRegistryKey)
+				}
+
+				if v, ok := ruleData[targetRegistryKey]; ok {
+					helper.SetPrimitiveValue(v, &rule.TargetRegistry, targetRegistryKey)
+				}
+
+				imageRegistryRewriteModel.Rules = append(imageRegistryRewriteModel.Rules, rule)
+			}
+		}
+	}
+
+	if scope, ok := imageRegistryRewriteData[scopeKey]; ok {
+		mutationScope := policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(scope.(string))
+		imageRegistryRewriteModel.Scope = &mutationScope
+	}
+
+	if v, ok := imageRegistryRewriteData[targetKubernetesResourcesKey]; ok {
+		if vs, ok := v.([]interface{}); ok {
+			if len(vs) != 0 && vs[0] != nil {
+				imageRegistryRewriteModel.TargetKubernetesResources = make([]*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources, 0)
+
+				for _, raw := range vs {
+					imageRegistryRewriteModel.TargetKubernetesResources = append(imageRegistryRewriteModel.TargetKubernetesResources, common.ExpandTargetKubernetesResources(raw))
+				}
+			}
+		}
+	}
+
+	return imageRegistryRewriteModel
+}
+
+func FlattenImageRegistryRewrite(imageRegistryRewrite *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite) (data []interface{}) {
+	if imageRegistryRewrite == nil {
+		return data
+	}
+
+	flattenImageRegistryRewrite := make(map[string]interface{})
+
+	rules := make([]interface{}, 0, len(imageRegistryRewrite.Rules))
+
+	for _, rule := range imageRegistryRewrite.Rules {
+		if rule == nil {
+			continue
+		}
+
+		rules = append(rules, map[string]interface{}{
+			sourceRegistryKey: rule.SourceRegistry,
+			targetRegistryKey: rule.TargetRegistry,
+		})
+	}
+
+	flattenImageRegistryRewrite[rulesKey] = rules
+
+	if imageRegistryRewrite.Scope != nil {
+		flattenImageRegistryRewrite[scopeKey] = string(*imageRegistryRewrite.Scope)
+	}
+
+	if imageRegistryRewrite.TargetKubernetesResources != nil {
+		var targetKubernetesResources []interface{}
+
+		for _, tkr := range imageRegistryRewrite.TargetKubernetesResources {
+			targetKubernetesResources = append(targetKubernetesResources, common.FlattenTargetKubernetesResources(tkr))
+		}
+
+		flattenImageRegistryRewrite[targetKubernetesResourcesKey] = targetKubernetesResources
+	}
+
+	return []interface{}{flattenImageRegistryRewrite}
+}
@@ -44,7 +44,7 @@ func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
 
 	endpoint := os.Getenv("TMC_ENDPOINT")
 
-	for _, recipe := range []string{annotation, label, podSecurity} {
+	for _, recipe := range []string{annotation, label, podSecurity, assign, imageRegistryRewrite} {
 		testConfig.setUpOrgPolicyEndPointMocks(t, recipe, endpoint)
 		testConfig.setUpClusterGroupEndPointMocks(t, endpoint)
 		testConfig.setUpClusterGroupPolicyEndpointMocks(t, recipe, endpoint)
@@ -221,6 +221,40 @@ func getMockPolicyCreateSpec(recipe string) *policymodel.VmwareTanzuManageV1alph
 				},
 			},
 		}
+	case assign:
+		spec.Input = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign{
+			Location: "spec.containers[name:*].imagePullPolicy",
+			Value:    "IfNotPresent",
+			PathTests: []*policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1AssignPathTest{
+				{
+					SubPath:   "spec.containers[name:*].imagePullPolicy",
+					Condition: "MustNotExist",
+				},
+			},
+			Scope: policyrecipemutationcommonmodel.NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(policyrecipemutationcommonmodel.Namespaced),
+			TargetKubernetesResources: []*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources{
+				{
+					APIGroups: []string{""},
+					Kinds:     []string{"Pod"},
+				},
+			},
+		}
+	case imageRegistryRewrite:
+		spec.Input = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite{
+			Rules: []*policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewriteRule{
+				{
+					SourceRegistry: "docker.io",
+					TargetRegistry: "registry.example.com/docker.io",
+				},
+			},
+			Scope: policyrecipemutationcommonmodel.NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(policyrecipemutationcommonmodel.Namespaced),
+			TargetKubernetesResources: []*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources{
+				{
+					APIGroups: []string{""},
+					Kinds:     []string{"Pod"},
+				},
+			},
+		}
 	case podSecurity:
 		spec.Input = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity{
 			AllowPrivilegeEscalation: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityAllowPrivilegeEscalation{
@@ -26,6 +26,9 @@ func ResourceMutationPolicy() *schema.Resource {
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindmutation.ResourceName])),
 			policykindmutation.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateEnforcementAction(policyoperations.EnforcementActionMap[policykindmutation.ResourceName]),
+			policy.PlanEnforcementAction,
+			policy.PlanChangeSummary(policykindmutation.SpecSchema, policyoperations.LooseningRulesMap[policykindmutation.ResourceName]),
 		),
 	}
 }
@@ -37,7 +40,9 @@ var mutationPolicySchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	scope.ScopeKey: scope.ScopeSchema,
-	policy.SpecKey: policykindmutation.SpecSchema,
-	common.MetaKey: common.Meta,
+	scope.ScopeKey:              scope.ScopeSchema,
+	policy.SpecKey:              policykindmutation.SpecSchema,
+	policy.EnforcementActionKey: policy.EnforcementActionSchema,
+	policy.ChangeSummaryKey:     policy.ChangeSummarySchema,
+	common.MetaKey:              common.Meta,
 }
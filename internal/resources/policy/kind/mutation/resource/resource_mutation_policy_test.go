@@ -84,6 +84,14 @@ func TestAcceptanceForMutationPolicyResource(t *testing.T) {
 				Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(annotation, scope.ClusterGroupScope, getAnnotationResourceInput()),
 				Check:  testConfig.checkClusterGroupScopeMutationPolicyResourceAttributes(annotation),
 			},
+			{
+				Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(assign, scope.ClusterGroupScope, getAssignResourceInput()),
+				Check:  testConfig.checkClusterGroupScopeMutationPolicyResourceAttributes(assign),
+			},
+			{
+				Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(imageRegistryRewrite, scope.ClusterGroupScope, getImageRegistryRewriteResourceInput()),
+				Check:  testConfig.checkClusterGroupScopeMutationPolicyResourceAttributes(imageRegistryRewrite),
+			},
 			{
 				PreConfig: func() {
 					if testConfig.ScopeHelperResources.OrgID == "" {
@@ -363,6 +371,52 @@ func getLabelResourceInput() string {
 `
 }
 
+func getAssignResourceInput() string {
+	return `
+    input {
+      assign {
+        target_kubernetes_resources {
+          api_groups = [
+            ""
+          ]
+          kinds = [
+            "Pod"
+          ]
+        }
+        scope    = "Namespaced"
+        location = "spec.containers[name:*].imagePullPolicy"
+        value    = jsonencode("IfNotPresent")
+        path_tests {
+          sub_path  = "spec.containers[name:*].imagePullPolicy"
+          condition = "MustNotExist"
+        }
+      }
+    }
+`
+}
+
+func getImageRegistryRewriteResourceInput() string {
+	return `
+    input {
+      image_registry_rewrite {
+        target_kubernetes_resources {
+          api_groups = [
+            ""
+          ]
+          kinds = [
+            "Pod"
+          ]
+        }
+        scope = "Namespaced"
+        rules {
+          source_registry = "docker.io"
+          target_registry = "registry.example.com/docker.io"
+        }
+      }
+    }
+`
+}
+
 func getPodSecurityResourceInput() string {
 	return `
  	input {
@@ -21,6 +21,8 @@ const (
 	annotation                = "annotation"
 	label                     = "label"
 	podSecurity               = "pod-security"
+	assign                    = "assign"
+	imageRegistryRewrite      = "image-registry-rewrite"
 )
 
 type testAcceptanceConfig struct {
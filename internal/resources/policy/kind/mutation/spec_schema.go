@@ -79,6 +79,14 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		if inputRecipeData.annotation != nil {
 			spec.Input = *inputRecipeData.annotation
 		}
+	case AssignRecipe:
+		if inputRecipeData.assign != nil {
+			spec.Input = *inputRecipeData.assign
+		}
+	case ImageRegistryRewriteRecipe:
+		if inputRecipeData.imageRegistryRewrite != nil {
+			spec.Input = *inputRecipeData.imageRegistryRewrite
+		}
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -152,6 +160,30 @@ func FlattenSpec(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) (d
 			recipe:     AnnotationRecipe,
 			annotation: &annotationRecipeInput,
 		}
+	case string(AssignRecipe):
+		var assignRecipeInput policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Assign
+
+		err = assignRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe: AssignRecipe,
+			assign: &assignRecipeInput,
+		}
+	case string(ImageRegistryRewriteRecipe):
+		var imageRegistryRewriteRecipeInput policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ImageRegistryRewrite
+
+		err = imageRegistryRewriteRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:               ImageRegistryRewriteRecipe,
+			imageRegistryRewrite: &imageRegistryRewriteRecipeInput,
+		}
 	case string(UnknownRecipe):
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
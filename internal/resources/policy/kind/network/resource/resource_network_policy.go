@@ -26,6 +26,9 @@ func ResourceNetworkPolicy() *schema.Resource {
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindnetwork.ResourceName])),
 			policykindnetwork.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateEnforcementAction(policyoperations.EnforcementActionMap[policykindnetwork.ResourceName]),
+			policy.PlanEnforcementAction,
+			policy.PlanChangeSummary(policykindnetwork.SpecSchema, policyoperations.LooseningRulesMap[policykindnetwork.ResourceName]),
 		),
 	}
 }
@@ -37,7 +40,9 @@ var networkPolicySchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	scope.ScopeKey: scope.ScopeSchema,
-	common.MetaKey: common.Meta,
-	policy.SpecKey: policykindnetwork.SpecSchema,
+	scope.ScopeKey:              scope.ScopeSchema,
+	common.MetaKey:              common.Meta,
+	policy.SpecKey:              policykindnetwork.SpecSchema,
+	policy.EnforcementActionKey: policy.EnforcementActionSchema,
+	policy.ChangeSummaryKey:     policy.ChangeSummarySchema,
 }
@@ -99,11 +99,11 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 	case CustomRecipe:
 		flattenInputData[reciperesource.CustomKey] = reciperesource.FlattenCustom(inputRecipeData.input)
 	case SmallRecipe:
-		flattenInputData[reciperesource.SmallKey] = []interface{}{make(map[string]interface{})}
+		flattenInputData[reciperesource.SmallKey] = reciperesource.FlattenPreset(inputRecipeData.input)
 	case MediumRecipe:
-		flattenInputData[reciperesource.MediumKey] = []interface{}{make(map[string]interface{})}
+		flattenInputData[reciperesource.MediumKey] = reciperesource.FlattenPreset(inputRecipeData.input)
 	case LargeRecipe:
-		flattenInputData[reciperesource.LargeKey] = []interface{}{make(map[string]interface{})}
+		flattenInputData[reciperesource.LargeKey] = reciperesource.FlattenPreset(inputRecipeData.input)
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -18,4 +18,12 @@ const (
 	RequestsStorageKey                = "requests_storage"
 	RequestsStoragePerClassKey        = "requests_storage_per_class"
 	ResourceCountsKey                 = "resource_counts"
+	ExtendedResourcesKey              = "extended_resources"
+	LimitRangeKey                     = "limit_range"
+	DefaultRequestsCPUKey             = "default_requests_cpu"
+	DefaultRequestsMemoryKey          = "default_requests_memory"
+	DefaultLimitsCPUKey               = "default_limits_cpu"
+	DefaultLimitsMemoryKey            = "default_limits_memory"
+	MaxLimitsCPUKey                   = "max_limits_cpu"
+	MaxLimitsMemoryKey                = "max_limits_memory"
 )
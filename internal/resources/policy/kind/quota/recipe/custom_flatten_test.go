@@ -52,6 +52,43 @@ func TestFlattenCustomRecipe(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "namespace quota policy custom recipe with limit range, object counts and extended resources",
+			input: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom{
+				LimitsCPU:         "4",
+				ExtendedResources: map[string]string{"nvidia.com/gpu": "2"},
+				ResourceCounts:    map[string]int{"services.loadbalancers": 1},
+				LimitRange: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange{
+					DefaultRequestsCPU:    "100m",
+					DefaultRequestsMemory: "128Mi",
+					MaxLimitsCPU:          "2",
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					LimitsCPUKey:                      "4",
+					LimitsMemoryKey:                   "",
+					PersistentVolumeClaimsKey:         int64(0),
+					PersistentVolumeClaimsPerClassKey: map[string]int(nil),
+					RequestsCPUKey:                    "",
+					RequestsMemoryKey:                 "",
+					RequestsStorageKey:                "",
+					RequestsStoragePerClassKey:        map[string]string(nil),
+					ResourceCountsKey:                 map[string]int{"services.loadbalancers": 1},
+					ExtendedResourcesKey:              map[string]string{"nvidia.com/gpu": "2"},
+					LimitRangeKey: []interface{}{
+						map[string]interface{}{
+							DefaultRequestsCPUKey:    "100m",
+							DefaultRequestsMemoryKey: "128Mi",
+							DefaultLimitsCPUKey:      "",
+							DefaultLimitsMemoryKey:   "",
+							MaxLimitsCPUKey:          "2",
+							MaxLimitsMemoryKey:       "",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, each := range cases {
@@ -62,3 +99,51 @@ func TestFlattenCustomRecipe(t *testing.T) {
 		})
 	}
 }
+
+func TestConstructCustomRecipe(t *testing.T) {
+	t.Parallel()
+
+	actual := ConstructCustom([]interface{}{
+		map[string]interface{}{
+			RequestsCPUKey:       "2",
+			ExtendedResourcesKey: map[string]interface{}{"nvidia.com/gpu": "4"},
+			ResourceCountsKey:    map[string]interface{}{"configmaps": 20},
+			LimitRangeKey: []interface{}{
+				map[string]interface{}{
+					DefaultLimitsCPUKey:    "500m",
+					DefaultLimitsMemoryKey: "512Mi",
+				},
+			},
+		},
+	})
+
+	require.Equal(t, &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom{
+		RequestsCPU:       "2",
+		ExtendedResources: map[string]string{"nvidia.com/gpu": "4"},
+		ResourceCounts:    map[string]int{"configmaps": 20},
+		LimitRange: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange{
+			DefaultLimitsCPU:    "500m",
+			DefaultLimitsMemory: "512Mi",
+		},
+	}, actual)
+}
+
+func TestCustomRecipeMapKeys(t *testing.T) {
+	t.Parallel()
+
+	for _, key := range []string{"pods", "services", "services.loadbalancers", "deployments.apps", "count"} {
+		require.True(t, resourceCountsKeyRegex.MatchString(key), key)
+	}
+
+	for _, key := range []string{"Services", "count/services", "services.", ""} {
+		require.False(t, resourceCountsKeyRegex.MatchString(key), key)
+	}
+
+	for _, key := range []string{"nvidia.com/gpu", "example.com/foo_bar", "amd.com/gpu"} {
+		require.True(t, extendedResourcesKeyRegex.MatchString(key), key)
+	}
+
+	for _, key := range []string{"gpu", "cpu", "nvidia.com/", "/gpu"} {
+		require.False(t, extendedResourcesKeyRegex.MatchString(key), key)
+	}
+}
@@ -5,7 +5,10 @@
 package recipe
 
 import (
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	policyrecipequotamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/quota"
@@ -63,15 +66,70 @@ var Custom = &schema.Schema{
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			ResourceCountsKey: {
-				Type:        schema.TypeMap,
-				Description: "The total number of Services of the given type that can exist in a namespace",
+				Type:             schema.TypeMap,
+				Description:      "The total number of objects of each resource that can exist in a namespace, keyed by the plural name of the resource, e.g. pods, configmaps, services or services.loadbalancers",
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeInt},
+				ValidateDiagFunc: validation.MapKeyMatch(resourceCountsKeyRegex, "resource counts must be keyed by the plural name of a resource, optionally followed by its type or API group, e.g. pods, services.loadbalancers or deployments.apps"),
+			},
+			ExtendedResourcesKey: {
+				Type:             schema.TypeMap,
+				Description:      "Across all pods in a non-terminal state, the sum of requests of each extended resource cannot exceed this value, e.g. nvidia.com/gpu = \"4\"",
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyMatch(extendedResourcesKeyRegex, "extended resources must be keyed by a domain-prefixed resource name, e.g. nvidia.com/gpu"),
+			},
+			LimitRangeKey: limitRangeSchema,
+		},
+	},
+}
+
+var limitRangeSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Default requests and limits applied to the containers which don't set them, and maximum limits of a container",
+	Optional:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			DefaultRequestsCPUKey: {
+				Type:        schema.TypeString,
+				Description: "The CPU request of a container which doesn't set it",
+				Optional:    true,
+			},
+			DefaultRequestsMemoryKey: {
+				Type:        schema.TypeString,
+				Description: "The memory request of a container which doesn't set it",
+				Optional:    true,
+			},
+			DefaultLimitsCPUKey: {
+				Type:        schema.TypeString,
+				Description: "The CPU limit of a container which doesn't set it",
+				Optional:    true,
+			},
+			DefaultLimitsMemoryKey: {
+				Type:        schema.TypeString,
+				Description: "The memory limit of a container which doesn't set it",
+				Optional:    true,
+			},
+			MaxLimitsCPUKey: {
+				Type:        schema.TypeString,
+				Description: "The CPU limit of a container cannot exceed this value",
+				Optional:    true,
+			},
+			MaxLimitsMemoryKey: {
+				Type:        schema.TypeString,
+				Description: "The memory limit of a container cannot exceed this value",
 				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
 		},
 	},
 }
 
+var (
+	resourceCountsKeyRegex    = regexp.MustCompile(`^[a-z][a-z0-9-]*(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`)
+	extendedResourcesKeyRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}/[A-Za-z0-9]([A-Za-z0-9_.-]*[A-Za-z0-9])?$`)
+)
+
 func ConstructCustom(data []interface{}) (custom *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom) {
 	if len(data) == 0 || data[0] == nil {
 		return custom
@@ -117,9 +175,55 @@ func ConstructCustom(data []interface{}) (custom *policyrecipequotamodel.VmwareT
 		custom.ResourceCounts = common.GetTypeIntMapData(v.(map[string]interface{}))
 	}
 
+	if v, ok := customData[ExtendedResourcesKey]; ok {
+		custom.ExtendedResources = common.GetTypeStringMapData(v.(map[string]interface{}))
+	}
+
+	if v, ok := customData[LimitRangeKey]; ok {
+		if v1, ok := v.([]interface{}); ok {
+			custom.LimitRange = constructLimitRange(v1)
+		}
+	}
+
 	return custom
 }
 
+func constructLimitRange(data []interface{}) (limitRange *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange) {
+	if len(data) == 0 || data[0] == nil {
+		return limitRange
+	}
+
+	limitRangeData, _ := data[0].(map[string]interface{})
+
+	limitRange = &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange{}
+
+	if v, ok := limitRangeData[DefaultRequestsCPUKey]; ok {
+		helper.SetPrimitiveValue(v, &limitRange.DefaultRequestsCPU, DefaultRequestsCPUKey)
+	}
+
+	if v, ok := limitRangeData[DefaultRequestsMemoryKey]; ok {
+		helper.SetPrimitiveValue(v, &limitRange.DefaultRequestsMemory, DefaultRequestsMemoryKey)
+	}
+
+	if v, ok := limitRangeData[DefaultLimitsCPUKey]; ok {
+		helper.SetPrimitiveValue(v, &limitRange.DefaultLimitsCPU, DefaultLimitsCPUKey)
+	}
+
+	if v, ok := limitRangeData[DefaultLimitsMemoryKey]; ok {
+		helper.SetPrimitiveValue(v, &limitRange.DefaultLimitsMemory, DefaultLimitsMemoryKey)
+	}
+
+	if v, ok := limitRangeData[MaxLimitsCPUKey]; ok {
+		helper.SetPrimitiveValue(v, &limitRange.MaxLimitsCPU, MaxLimitsCPUKey)
+	}
+
+	if v, ok := limitRangeData[MaxLimitsMemoryKey]; ok {
+		helper.SetPrimitiveValue(v, &limitRange.MaxLimitsMemory, MaxLimitsMemoryKey)
+	}
+
+	return limitRange
+}
+
 func FlattenCustom(custom *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom) (data []interface{}) {
 	if custom == nil {
 		return data
@@ -137,5 +241,30 @@ func FlattenCustom(custom *policyrecipequotamodel.VmwareTanzuManageV1alpha1Commo
 	flattenCustom[RequestsStoragePerClassKey] = custom.RequestsStoragePerClass
 	flattenCustom[ResourceCountsKey] = custom.ResourceCounts
 
+	if custom.ExtendedResources != nil {
+		flattenCustom[ExtendedResourcesKey] = custom.ExtendedResources
+	}
+
+	if custom.LimitRange != nil {
+		flattenCustom[LimitRangeKey] = flattenLimitRange(custom.LimitRange)
+	}
+
 	return []interface{}{flattenCustom}
 }
+
+func flattenLimitRange(limitRange *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange) (data []interface{}) {
+	if limitRange == nil {
+		return data
+	}
+
+	flattenLimitRange := make(map[string]interface{})
+
+	flattenLimitRange[DefaultRequestsCPUKey] = limitRange.DefaultRequestsCPU
+	flattenLimitRange[DefaultRequestsMemoryKey] = limitRange.DefaultRequestsMemory
+	flattenLimitRange[DefaultLimitsCPUKey] = limitRange.DefaultLimitsCPU
+	flattenLimitRange[DefaultLimitsMemoryKey] = limitRange.DefaultLimitsMemory
+	flattenLimitRange[MaxLimitsCPUKey] = limitRange.MaxLimitsCPU
+	flattenLimitRange[MaxLimitsMemoryKey] = limitRange.MaxLimitsMemory
+
+	return []interface{}{flattenLimitRange}
+}
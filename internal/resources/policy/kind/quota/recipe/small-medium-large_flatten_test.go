@@ -0,0 +1,84 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipequotamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/quota"
+)
+
+func TestFlattenPreset(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil data",
+			input:       nil,
+			expected:    []interface{}{make(map[string]interface{})},
+		},
+		{
+			description: "values reported for the preset",
+			input: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom{
+				RequestsCPU:    "500m",
+				RequestsMemory: "512Mi",
+				LimitsCPU:      "1",
+				LimitsMemory:   "2Gi",
+				LimitRange: &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1CustomLimitRange{
+					DefaultRequestsCPU:    "100m",
+					DefaultRequestsMemory: "128Mi",
+					DefaultLimitsCPU:      "250m",
+					DefaultLimitsMemory:   "256Mi",
+				},
+				ResourceCounts:    map[string]int{"configmaps": 20, "secrets": 20},
+				ExtendedResources: map[string]string{"nvidia.com/gpu": "1"},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					RequestsCPUKey:       "500m",
+					RequestsMemoryKey:    "512Mi",
+					LimitsCPUKey:         "1",
+					LimitsMemoryKey:      "2Gi",
+					ResourceCountsKey:    map[string]int{"configmaps": 20, "secrets": 20},
+					ExtendedResourcesKey: map[string]string{"nvidia.com/gpu": "1"},
+					LimitRangeKey: []interface{}{
+						map[string]interface{}{
+							DefaultRequestsCPUKey:    "100m",
+							DefaultRequestsMemoryKey: "128Mi",
+							DefaultLimitsCPUKey:      "250m",
+							DefaultLimitsMemoryKey:   "256Mi",
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "no values reported for the preset",
+			input:       &policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom{},
+			expected: []interface{}{
+				map[string]interface{}{
+					RequestsCPUKey:    "",
+					RequestsMemoryKey: "",
+					LimitsCPUKey:      "",
+					LimitsMemoryKey:   "",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenPreset(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -5,38 +5,122 @@
 package recipe
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	policyrecipequotamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/quota"
 )
 
-var Small = &schema.Schema{
-	Type:        schema.TypeList,
-	Description: "The input schema for namespace quota policy small recipe version v1",
-	Optional:    true,
-	ForceNew:    true,
-	MaxItems:    1,
-	Elem: &schema.Resource{
-		Schema: map[string]*schema.Schema{},
-	},
-}
+var Small = presetSchema(SmallKey)
 
-var Medium = &schema.Schema{
-	Type:        schema.TypeList,
-	Description: "The input schema for namespace quota policy medium recipe version v1",
-	Optional:    true,
-	ForceNew:    true,
-	MaxItems:    1,
-	Elem: &schema.Resource{
-		Schema: map[string]*schema.Schema{},
-	},
+var Medium = presetSchema(MediumKey)
+
+var Large = presetSchema(LargeKey)
+
+// presetSchema is the schema of a preset recipe, which has no input: its attributes are the values enforced by the preset, as reported by TMC.
+func presetSchema(recipe string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("The input schema for namespace quota policy %s recipe version v1", recipe),
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				RequestsCPUKey: {
+					Type:        schema.TypeString,
+					Description: "The sum of CPU requests across all pods in a non-terminal state cannot exceed this value",
+					Computed:    true,
+				},
+				RequestsMemoryKey: {
+					Type:        schema.TypeString,
+					Description: "The sum of memory requests across all pods in a non-terminal state cannot exceed this value",
+					Computed:    true,
+				},
+				LimitsCPUKey: {
+					Type:        schema.TypeString,
+					Description: "The sum of CPU limits across all pods in a non-terminal state cannot exceed this value",
+					Computed:    true,
+				},
+				LimitsMemoryKey: {
+					Type:        schema.TypeString,
+					Description: "The sum of memory limits across all pods in a non-terminal state cannot exceed this value",
+					Computed:    true,
+				},
+				ResourceCountsKey: {
+					Type:        schema.TypeMap,
+					Description: "The total number of objects of each resource that can exist in a namespace",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeInt},
+				},
+				ExtendedResourcesKey: {
+					Type:        schema.TypeMap,
+					Description: "Across all pods in a non-terminal state, the sum of requests of each extended resource cannot exceed this value",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				LimitRangeKey: {
+					Type:        schema.TypeList,
+					Description: "Default requests and limits applied to the containers which don't set them",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							DefaultRequestsCPUKey: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							DefaultRequestsMemoryKey: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							DefaultLimitsCPUKey: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							DefaultLimitsMemoryKey: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
 }
 
-var Large = &schema.Schema{
-	Type:        schema.TypeList,
-	Description: "The input schema for namespace quota policy large recipe version v1",
-	Optional:    true,
-	ForceNew:    true,
-	MaxItems:    1,
-	Elem: &schema.Resource{
-		Schema: map[string]*schema.Schema{},
-	},
+// FlattenPreset returns the values enforced by a preset recipe from the input of the policy read from TMC.
+func FlattenPreset(preset *policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom) (data []interface{}) {
+	flattenPreset := make(map[string]interface{})
+
+	if preset == nil {
+		return []interface{}{flattenPreset}
+	}
+
+	flattenPreset[RequestsCPUKey] = preset.RequestsCPU
+	flattenPreset[RequestsMemoryKey] = preset.RequestsMemory
+	flattenPreset[LimitsCPUKey] = preset.LimitsCPU
+	flattenPreset[LimitsMemoryKey] = preset.LimitsMemory
+
+	if preset.ResourceCounts != nil {
+		flattenPreset[ResourceCountsKey] = preset.ResourceCounts
+	}
+
+	if preset.ExtendedResources != nil {
+		flattenPreset[ExtendedResourcesKey] = preset.ExtendedResources
+	}
+
+	if preset.LimitRange != nil {
+		flattenPreset[LimitRangeKey] = []interface{}{
+			map[string]interface{}{
+				DefaultRequestsCPUKey:    preset.LimitRange.DefaultRequestsCPU,
+				DefaultRequestsMemoryKey: preset.LimitRange.DefaultRequestsMemory,
+				DefaultLimitsCPUKey:      preset.LimitRange.DefaultLimitsCPU,
+				DefaultLimitsMemoryKey:   preset.LimitRange.DefaultLimitsMemory,
+			},
+		}
+	}
+
+	return []interface{}{flattenPreset}
 }
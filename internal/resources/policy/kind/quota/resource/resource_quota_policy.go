@@ -26,6 +26,9 @@ func ResourceQuotaPolicy() *schema.Resource {
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindquota.ResourceName])),
 			policykindquota.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateEnforcementAction(policyoperations.EnforcementActionMap[policykindquota.ResourceName]),
+			policy.PlanEnforcementAction,
+			policy.PlanChangeSummary(policykindquota.SpecSchema, policyoperations.LooseningRulesMap[policykindquota.ResourceName]),
 		),
 	}
 }
@@ -37,7 +40,9 @@ var quotaPolicySchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	scope.ScopeKey: scope.ScopeSchema,
-	common.MetaKey: common.Meta,
-	policy.SpecKey: policykindquota.SpecSchema,
+	scope.ScopeKey:              scope.ScopeSchema,
+	common.MetaKey:              common.Meta,
+	policy.SpecKey:              policykindquota.SpecSchema,
+	policy.EnforcementActionKey: policy.EnforcementActionSchema,
+	policy.ChangeSummaryKey:     policy.ChangeSummarySchema,
 }
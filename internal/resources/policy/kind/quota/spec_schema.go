@@ -108,7 +108,8 @@ func FlattenSpec(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) (d
 	}
 
 	switch spec.Recipe {
-	case string(CustomRecipe):
+	case string(CustomRecipe), string(SmallRecipe), string(MediumRecipe), string(LargeRecipe):
+		// The preset recipes have no input of their own, TMC reports the values they enforce in the input of the policy.
 		var customRecipeInput policyrecipequotamodel.VmwareTanzuManageV1alpha1CommonPolicySpecQuotaV1Custom
 
 		err = customRecipeInput.UnmarshalBinary(byteSlice)
@@ -117,21 +118,9 @@ func FlattenSpec(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) (d
 		}
 
 		inputRecipeData = &inputRecipe{
-			recipe: CustomRecipe,
+			recipe: Recipe(spec.Recipe),
 			input:  &customRecipeInput,
 		}
-	case string(SmallRecipe):
-		inputRecipeData = &inputRecipe{
-			recipe: SmallRecipe,
-		}
-	case string(MediumRecipe):
-		inputRecipeData = &inputRecipe{
-			recipe: MediumRecipe,
-		}
-	case string(LargeRecipe):
-		inputRecipeData = &inputRecipe{
-			recipe: LargeRecipe,
-		}
 	case string(UnknownRecipe):
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -0,0 +1,118 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policykindsecurity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security/recipe"
+)
+
+func testSecuritySpec(recipe string, input map[string]interface{}) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			policy.InputKey: []interface{}{
+				map[string]interface{}{
+					recipe: []interface{}{input},
+				},
+			},
+		},
+	}
+}
+
+func testCustomInput(allowPrivilegedContainers bool, runAsUserRule string, allowedVolumes, requiredDropCapabilities []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		reciperesource.AuditKey:       false,
+		"allow_privileged_containers": allowPrivilegedContainers,
+		"read_only_root_file_system":  true,
+		"allowed_volumes":             allowedVolumes,
+		"run_as_user": []interface{}{
+			map[string]interface{}{
+				"rule":   runAsUserRule,
+				"ranges": []interface{}{},
+			},
+		},
+		"linux_capabilities": []interface{}{
+			map[string]interface{}{
+				"allowed_capabilities":       []interface{}{},
+				"required_drop_capabilities": requiredDropCapabilities,
+			},
+		},
+	}
+}
+
+func TestSummarizeChanges(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		oldSpec     []interface{}
+		newSpec     []interface{}
+		expected    []string
+		loosening   []string
+	}{
+		{
+			description: "strict to baseline recipe",
+			oldSpec:     testSecuritySpec(reciperesource.StrictKey, map[string]interface{}{reciperesource.AuditKey: false}),
+			newSpec:     testSecuritySpec(reciperesource.BaselineKey, map[string]interface{}{reciperesource.AuditKey: false}),
+			expected:    []string{"recipe: strict -> baseline (loosened)"},
+			loosening:   []string{"recipe: strict -> baseline (loosened)"},
+		},
+		{
+			description: "baseline to strict recipe",
+			oldSpec:     testSecuritySpec(reciperesource.BaselineKey, map[string]interface{}{reciperesource.AuditKey: false}),
+			newSpec:     testSecuritySpec(reciperesource.StrictKey, map[string]interface{}{reciperesource.AuditKey: false}),
+			expected:    []string{"recipe: baseline -> strict (tightened)"},
+			loosening:   []string{},
+		},
+		{
+			description: "custom recipe loosened",
+			oldSpec:     testSecuritySpec(reciperesource.CustomKey, testCustomInput(false, "MustRunAs", []interface{}{"configMap"}, []interface{}{"NET_RAW", "SYS_ADMIN"})),
+			newSpec:     testSecuritySpec(reciperesource.CustomKey, testCustomInput(true, "RunAsAny", []interface{}{"configMap", "hostPath"}, []interface{}{"SYS_ADMIN"})),
+			expected: []string{
+				"input.custom.allow_privileged_containers: false -> true (loosened)",
+				"input.custom.allowed_volumes: added [hostPath] (loosened)",
+				"input.custom.linux_capabilities.required_drop_capabilities: removed [NET_RAW] (loosened)",
+				"input.custom.run_as_user.rule: MustRunAs -> RunAsAny (loosened)",
+			},
+			loosening: []string{
+				"input.custom.allow_privileged_containers: false -> true (loosened)",
+				"input.custom.allowed_volumes: added [hostPath] (loosened)",
+				"input.custom.linux_capabilities.required_drop_capabilities: removed [NET_RAW] (loosened)",
+				"input.custom.run_as_user.rule: MustRunAs -> RunAsAny (loosened)",
+			},
+		},
+		{
+			description: "custom recipe tightened",
+			oldSpec:     testSecuritySpec(reciperesource.CustomKey, testCustomInput(true, "RunAsAny", []interface{}{"configMap", "hostPath"}, []interface{}{})),
+			newSpec:     testSecuritySpec(reciperesource.CustomKey, testCustomInput(false, "MustRunAsNonRoot", []interface{}{"configMap"}, []interface{}{"NET_RAW"})),
+			expected: []string{
+				"input.custom.allow_privileged_containers: true -> false (tightened)",
+				"input.custom.allowed_volumes: removed [hostPath] (tightened)",
+				"input.custom.linux_capabilities.required_drop_capabilities: added [NET_RAW] (tightened)",
+				"input.custom.run_as_user.rule: RunAsAny -> MustRunAsNonRoot (tightened)",
+			},
+			loosening: []string{},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := policy.SummarizeChanges(SpecSchema, reciperesource.LooseningRules, test.oldSpec, test.newSpec, policy.EnforcementActionDeny, policy.EnforcementActionDeny)
+			require.Equal(t, test.expected, actual)
+
+			summary := make([]interface{}, 0, len(actual))
+			for _, line := range actual {
+				summary = append(summary, line)
+			}
+
+			require.Equal(t, test.loosening, policy.LooseningChanges(summary))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	policyrecipesecuritymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/security"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 )
 
 var Baseline = &schema.Schema{
@@ -22,10 +23,11 @@ var Baseline = &schema.Schema{
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			AuditKey: {
-				Type:        schema.TypeBool,
-				Description: auditDryRunDescription,
-				Optional:    true,
-				Default:     false,
+				Type:             schema.TypeBool,
+				Description:      auditDryRunDescription,
+				Optional:         true,
+				Default:          false,
+				DiffSuppressFunc: policy.SuppressAuditDiff,
 			},
 			DisableNativePspKey: {
 				Type:        schema.TypeBool,
@@ -0,0 +1,43 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package recipe
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+)
+
+// LooseningRules tell which changes of the recipes loosen a security policy.
+var LooseningRules = &policy.LooseningRules{
+	Enabled: []string{
+		AuditKey,
+		allowPrivilegedContainersKey,
+		allowPrivilegeEscalationKey,
+		allowHostNamespaceSharingKey,
+		allowHostNetworkKey,
+	},
+	Disabled: []string{
+		readOnlyRootFileSystemKey,
+		readOnlyKey,
+	},
+	Added: []string{
+		policy.MatchExpressionsKey,
+		allowedVolumesKey,
+		allowedCapabilitiesKey,
+		allowedHostPathsKey,
+		allowedSELinuxOptionsKey,
+		allowedProfilesKey,
+		allowedLocalhostFilesKey,
+		rangesKey,
+	},
+	Removed: []string{
+		requiredDropCapabilitiesKey,
+		forbiddenSysctlsKey,
+	},
+	Orders: map[string][]string{
+		policy.RecipeKey:            {StrictKey, BaselineKey},
+		policy.EnforcementActionKey: {policy.EnforcementActionDeny, policy.EnforcementActionDryRun},
+		ruleKey:                     {mustRunAsValue, "MustRunAsNonRoot", mayRunAsValue, runAsAnyValue},
+	},
+}
@@ -26,6 +26,9 @@ func ResourceSecurityPolicy() *schema.Resource {
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindsecurity.ResourceName])),
 			policykindsecurity.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateEnforcementAction(policyoperations.EnforcementActionMap[policykindsecurity.ResourceName]),
+			policy.PlanEnforcementAction,
+			policy.PlanChangeSummary(policykindsecurity.SpecSchema, policyoperations.LooseningRulesMap[policykindsecurity.ResourceName]),
 		),
 	}
 }
@@ -37,7 +40,9 @@ var securityPolicySchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	scope.ScopeKey: scope.ScopeSchema,
-	common.MetaKey: common.Meta,
-	policy.SpecKey: policykindsecurity.SpecSchema,
+	scope.ScopeKey:              scope.ScopeSchema,
+	common.MetaKey:              common.Meta,
+	policy.SpecKey:              policykindsecurity.SpecSchema,
+	policy.EnforcementActionKey: policy.EnforcementActionSchema,
+	policy.ChangeSummaryKey:     policy.ChangeSummarySchema,
 }
@@ -13,7 +13,6 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
-	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
 	policyclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/cluster"
 	policyclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/clustergroup"
 	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
@@ -21,11 +20,6 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	policykindcustom "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
-	policykindimage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
-	policykindmutation "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
-	policykindnetwork "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network"
-	policykindquota "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
-	policykindsecurity "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
 )
 
@@ -38,21 +32,16 @@ func ResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.Errorf("Unable to create Tanzu Mission Control cluster %s policy entry; Scope full name is empty", rn)
 	}
 
-	var policySpec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec
-
-	switch rn {
-	case policykindcustom.ResourceName:
-		policySpec = policykindcustom.ConstructSpec(d)
-	case policykindsecurity.ResourceName:
-		policySpec = policykindsecurity.ConstructSpec(d)
-	case policykindimage.ResourceName:
-		policySpec = policykindimage.ConstructSpec(d)
-	case policykindquota.ResourceName:
-		policySpec = policykindquota.ConstructSpec(d)
-	case policykindnetwork.ResourceName:
-		policySpec = policykindnetwork.ConstructSpec(d)
-	case policykindmutation.ResourceName:
-		policySpec = policykindmutation.ConstructSpec(d)
+	// The policy template may have been created by the same apply, after the plan.
+	if rn == policykindcustom.ResourceName {
+		if err := policykindcustom.ValidateCustomRecipeParameters(config, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	policySpec, err := constructPolicySpec(d, rn)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	var UID string
@@ -133,5 +122,8 @@ func ResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	// always run
 	d.SetId(UID)
 
-	return ResourcePolicyRead(ctx, d, m, rn)
+	// The change summary of a policy is planned when it is replaced, e.g. on a change of recipe.
+	diags = policy.LooseningWarning(d, rn)
+
+	return append(diags, ResourcePolicyRead(ctx, d, m, rn)...)
 }
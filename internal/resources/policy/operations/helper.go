@@ -19,10 +19,12 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	policykindcustom "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
 	policykindimage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
+	policykindimagerecipe "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image/recipe"
 	policykindmutation "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
 	policykindnetwork "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network"
 	policykindquota "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
 	policykindsecurity "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
+	policykindsecurityrecipe "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security/recipe"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
 )
 
@@ -35,6 +37,69 @@ var ScopeMap = map[string][]string{
 	policykindmutation.ResourceName: {scope.ClusterKey, scope.ClusterGroupKey, scope.OrganizationKey},
 }
 
+// EnforcementActionMap lists the enforcement actions supported by the recipes of each kind of policy.
+// The recipes of the network, quota and mutation policies have no audit input, they only support deny.
+var EnforcementActionMap = map[string]map[string][]string{
+	policykindcustom.ResourceName: {
+		string(policykindcustom.TMCBlockNodeportServiceRecipe):     policy.DenyAndDryRun,
+		string(policykindcustom.TMCBlockResourcesRecipe):           policy.DenyAndDryRun,
+		string(policykindcustom.TMCBlockRolebindingSubjectsRecipe): policy.DenyAndDryRun,
+		string(policykindcustom.TMCExternalIPSRecipe):              policy.DenyAndDryRun,
+		string(policykindcustom.TMCHTTPSIngressRecipe):             policy.DenyAndDryRun,
+		string(policykindcustom.TMCRequireLabelsRecipe):            policy.DenyAndDryRun,
+		string(policykindcustom.TMCCustomRecipe):                   policy.DenyAndDryRun,
+	},
+	policykindimage.ResourceName: {
+		string(policykindimage.AllowedNameTagRecipe): policy.DenyAndDryRun,
+		string(policykindimage.CustomRecipe):         policy.DenyAndDryRun,
+		string(policykindimage.BlockLatestTagRecipe): policy.DenyAndDryRun,
+		string(policykindimage.RequireDigestRecipe):  policy.DenyAndDryRun,
+	},
+	policykindsecurity.ResourceName: {
+		string(policykindsecurity.BaselineRecipe): policy.DenyAndDryRun,
+		string(policykindsecurity.CustomRecipe):   policy.DenyAndDryRun,
+		string(policykindsecurity.StrictRecipe):   policy.DenyAndDryRun,
+	},
+	policykindquota.ResourceName:    {},
+	policykindnetwork.ResourceName:  {},
+	policykindmutation.ResourceName: {},
+}
+
+// LooseningRulesMap lists the rules telling which changes loosen a policy, for the kinds of policies whose loosening is reported as a warning.
+var LooseningRulesMap = map[string]*policy.LooseningRules{
+	policykindsecurity.ResourceName: policykindsecurityrecipe.LooseningRules,
+	policykindimage.ResourceName:    policykindimagerecipe.LooseningRules,
+}
+
+// constructPolicySpec constructs the spec of a policy of the given kind, with the audit input of its recipe set from its enforcement action.
+func constructPolicySpec(d *schema.ResourceData, rn string) (*policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec, error) {
+	var policySpec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec
+
+	switch rn {
+	case policykindcustom.ResourceName:
+		policySpec = policykindcustom.ConstructSpec(d)
+	case policykindsecurity.ResourceName:
+		policySpec = policykindsecurity.ConstructSpec(d)
+	case policykindimage.ResourceName:
+		policySpec = policykindimage.ConstructSpec(d)
+	case policykindquota.ResourceName:
+		policySpec = policykindquota.ConstructSpec(d)
+	case policykindnetwork.ResourceName:
+		policySpec = policykindnetwork.ConstructSpec(d)
+	case policykindmutation.ResourceName:
+		policySpec = policykindmutation.ConstructSpec(d)
+	}
+
+	action, _ := d.Get(policy.EnforcementActionKey).(string)
+	supported := policy.SupportedEnforcementActions(EnforcementActionMap[rn], policy.ConfiguredRecipe(d.Get(policy.SpecKey)))
+
+	if err := policy.ApplyEnforcementAction(policySpec, action, supported); err != nil {
+		return nil, err
+	}
+
+	return policySpec, nil
+}
+
 // nolint: gocognit
 func RetrievePolicyUIDMetaAndSpecFromServer(config authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, d *schema.ResourceData, policyName, rn string) (string, *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec, error) {
 	var (
@@ -44,6 +44,11 @@ func ResourcePolicyRead(_ context.Context, d *schema.ResourceData, m interface{}
 	// always run
 	d.SetId(UID)
 
+	// The match expressions injected by the policy exemptions aren't part of the configuration of the policy.
+	if spec != nil {
+		spec.NamespaceSelector = policy.RemoveMatchExpressions(spec.NamespaceSelector, policy.ExemptedMatchExpressions(meta))
+	}
+
 	if err := d.Set(common.MetaKey, common.FlattenMeta(meta)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -69,5 +74,14 @@ func ResourcePolicyRead(_ context.Context, d *schema.ResourceData, m interface{}
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set(policy.EnforcementActionKey, policy.FlattenEnforcementAction(spec)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The change summary only describes the changes planned against the state.
+	if err := d.Set(policy.ChangeSummaryKey, []string{}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
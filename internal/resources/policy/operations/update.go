@@ -24,11 +24,6 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	policykindcustom "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
-	policykindimage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
-	policykindmutation "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
-	policykindnetwork "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network"
-	policykindquota "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
-	policykindsecurity "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
 )
 
@@ -46,6 +41,13 @@ func ResourcePolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Unable to update Tanzu Mission Control %s policy entry; Scope full name is empty", rn)
 	}
 
+	// The policy template may have been created or updated by the same apply, after the plan.
+	if rn == policykindcustom.ResourceName && d.HasChange(policy.SpecKey) {
+		if err := policykindcustom.ValidateCustomRecipeParameters(config, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, meta, spec, err := RetrievePolicyUIDMetaAndSpecFromServer(config, scopedFullnameData, d, rn, policyName)
 	if err != nil {
 		return diag.FromErr(err)
@@ -57,7 +59,12 @@ func ResourcePolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 		updateAvailable = true
 	}
 
-	if updateCheckForSpec(d, spec, rn) {
+	specUpdateAvailable, err := updateCheckForSpec(d, meta, spec, rn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if specUpdateAvailable {
 		updateAvailable = true
 	}
 
@@ -134,7 +141,9 @@ func ResourcePolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[INFO] %s policy update successful", rn)
 
-	return ResourcePolicyRead(ctx, d, m, rn)
+	diags = policy.LooseningWarning(d, rn)
+
+	return append(diags, ResourcePolicyRead(ctx, d, m, rn)...)
 }
 
 func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) bool {
@@ -156,36 +165,25 @@ func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanz
 	return true
 }
 
-func updateCheckForSpec(d *schema.ResourceData, spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec, rn string) bool {
+func updateCheckForSpec(d *schema.ResourceData, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec, rn string) (bool, error) {
 	if !hasSpecChanged(d) {
-		return false
+		return false, nil
 	}
 
-	var policySpec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec
-
-	switch rn {
-	case policykindcustom.ResourceName:
-		policySpec = policykindcustom.ConstructSpec(d)
-	case policykindsecurity.ResourceName:
-		policySpec = policykindsecurity.ConstructSpec(d)
-	case policykindimage.ResourceName:
-		policySpec = policykindimage.ConstructSpec(d)
-	case policykindquota.ResourceName:
-		policySpec = policykindquota.ConstructSpec(d)
-	case policykindnetwork.ResourceName:
-		policySpec = policykindnetwork.ConstructSpec(d)
-	case policykindmutation.ResourceName:
-		policySpec = policykindmutation.ConstructSpec(d)
+	policySpec, err := constructPolicySpec(d, rn)
+	if err != nil {
+		return false, err
 	}
 
 	spec.Input = policySpec.Input
-	spec.NamespaceSelector = policySpec.NamespaceSelector
+	// Keep the match expressions injected by the policy exemptions.
+	spec.NamespaceSelector = policy.AddMatchExpressions(policySpec.NamespaceSelector, policy.ExemptedMatchExpressions(meta))
 	spec.Recipe = policySpec.Recipe
 	spec.RecipeVersion = policySpec.RecipeVersion
 
 	log.Printf("[INFO] updating policy spec")
 
-	return true
+	return true, nil
 }
 
 func hasSpecChanged(d *schema.ResourceData) bool {
@@ -195,6 +193,8 @@ func hasSpecChanged(d *schema.ResourceData) bool {
 	case d.HasChange(helper.GetFirstElementOf(policy.SpecKey, policy.InputKey)):
 		fallthrough
 	case d.HasChange(helper.GetFirstElementOf(policy.SpecKey, policy.NamespaceSelectorKey)):
+		fallthrough
+	case d.HasChange(policy.EnforcementActionKey):
 		updateRequired = true
 	}
 
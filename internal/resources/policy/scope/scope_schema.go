@@ -55,6 +55,11 @@ func ConstructScope(d *schema.ResourceData, name string) (scopedFullnameData *Sc
 		return scopedFullnameData
 	}
 
+	return ConstructScopeFromData(value, name)
+}
+
+// ConstructScopeFromData constructs the policy full name from the value of the scope block, e.g. during the plan.
+func ConstructScopeFromData(value interface{}, name string) (scopedFullnameData *ScopedFullname) {
 	data, _ := value.([]interface{})
 
 	if len(data) == 0 || data[0] == nil {
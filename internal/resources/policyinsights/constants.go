@@ -0,0 +1,50 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyinsights
+
+const (
+	ResourceName = "tanzu-mission-control_policy_insights"
+
+	// Scope Keys.
+	ScopeKey                 = "scope"
+	ClusterGroupKey          = "cluster_group"
+	WorkspaceKey             = "workspace"
+	ClusterKey               = "cluster"
+	NamespaceKey             = "namespace"
+	NameKey                  = "name"
+	ClusterNameKey           = "cluster_name"
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+
+	// Filter Keys.
+	PolicyNameKey        = "policy_name"
+	PolicyTypeKey        = "policy_type"
+	EnforcementActionKey = "enforcement_action"
+	ResourceKindKey      = "resource_kind"
+	LastSeenAfterKey     = "last_seen_after"
+
+	// Computed Keys.
+	ViolationsKey         = "violations"
+	TotalCountKey         = "total_count"
+	ClusterGroupNameKey   = "cluster_group_name"
+	WorkspaceNameKey      = "workspace_name"
+	NamespaceNameKey      = "namespace_name"
+	EnforcedKey           = "enforced"
+	ResourceNameKey       = "resource_name"
+	ResourceAPIVersionKey = "resource_api_version"
+	MessageKey            = "message"
+	FirstSeenKey          = "first_seen"
+	LastSeenKey           = "last_seen"
+
+	// Enforcement actions as defined in API.
+	enforcementActionDeny   = "deny"
+	enforcementActionDryRun = "dryrun"
+	enforcementActionWarn   = "warn"
+
+	policyTypeSuffix = "-policy"
+)
+
+// policyTypes are the kinds of the policies which report violations, the type of the policy in the API is suffixed with -policy.
+var policyTypes = []string{"security", "image", "custom", "network", "quota", "mutation"}
@@ -0,0 +1,314 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyinsights
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	policyinsightsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/insights"
+)
+
+func DataSourcePolicyInsights() *schema.Resource {
+	return &schema.Resource{
+		Schema:      policyInsightsSchema,
+		ReadContext: dataSourcePolicyInsightsRead,
+	}
+}
+
+var scopeKeys = []string{
+	fmt.Sprintf("%s.0.%s", ScopeKey, ClusterGroupKey),
+	fmt.Sprintf("%s.0.%s", ScopeKey, WorkspaceKey),
+	fmt.Sprintf("%s.0.%s", ScopeKey, ClusterKey),
+	fmt.Sprintf("%s.0.%s", ScopeKey, NamespaceKey),
+}
+
+var policyInsightsSchema = map[string]*schema.Schema{
+	ScopeKey: {
+		Type:        schema.TypeList,
+		Description: "Scope of the policy violations. The violations of the whole organization are returned by default.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ClusterGroupKey: {
+					Type:         schema.TypeList,
+					Description:  "The violations of the clusters of a cluster group.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: scopeKeys,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							NameKey: nameSchema("Name of the cluster group."),
+						},
+					},
+				},
+				WorkspaceKey: {
+					Type:         schema.TypeList,
+					Description:  "The violations of the namespaces of a workspace.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: scopeKeys,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							NameKey: nameSchema("Name of the workspace."),
+						},
+					},
+				},
+				ClusterKey: {
+					Type:         schema.TypeList,
+					Description:  "The violations of a cluster.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: scopeKeys,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							NameKey:                  nameSchema("Name of the cluster."),
+							ManagementClusterNameKey: managementClusterNameSchema,
+							ProvisionerNameKey:       provisionerNameSchema,
+						},
+					},
+				},
+				NamespaceKey: {
+					Type:         schema.TypeList,
+					Description:  "The violations of a namespace of a cluster.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: scopeKeys,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							NameKey:                  nameSchema("Name of the namespace."),
+							ClusterNameKey:           nameSchema("Name of the cluster of the namespace."),
+							ManagementClusterNameKey: managementClusterNameSchema,
+							ProvisionerNameKey:       provisionerNameSchema,
+						},
+					},
+				},
+			},
+		},
+	},
+	PolicyNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the policy. Supports globbing, such as 'baseline-*'.",
+		Optional:    true,
+	},
+	PolicyTypeKey: {
+		Type:         schema.TypeString,
+		Description:  fmt.Sprintf("Kind of the policy, valid values are: [%s]", strings.Join(policyTypes, ", ")),
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(policyTypes, false),
+	},
+	EnforcementActionKey: {
+		Type: schema.TypeString,
+		Description: fmt.Sprintf("Enforcement action of the policy, valid values are: [%s]. '%s' violations are enforced, '%s' and '%s' violations are only audited.",
+			strings.Join(enforcementActions, ", "), enforcementActionDeny, enforcementActionDryRun, enforcementActionWarn),
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(enforcementActions, false),
+	},
+	ResourceKindKey: {
+		Type:        schema.TypeString,
+		Description: "Kind of the violating Kubernetes resource, such as 'Pod'. The comparison is case insensitive.",
+		Optional:    true,
+	},
+	LastSeenAfterKey: {
+		Type:         schema.TypeString,
+		Description:  "Only return the violations seen after this RFC3339 timestamp, such as '2024-01-02T15:04:05Z'.",
+		Optional:     true,
+		ValidateFunc: validation.IsRFC3339Time,
+	},
+	ViolationsKey: {
+		Type:        schema.TypeList,
+		Description: "Policy violations matching the query, the most recently seen first.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ClusterGroupNameKey:      computedStringSchema("Name of the cluster group of the cluster."),
+				WorkspaceNameKey:         computedStringSchema("Name of the workspace of the namespace."),
+				ClusterNameKey:           computedStringSchema("Name of the cluster."),
+				ManagementClusterNameKey: computedStringSchema("Name of the management cluster of the cluster."),
+				ProvisionerNameKey:       computedStringSchema("Name of the provisioner of the cluster."),
+				NamespaceNameKey:         computedStringSchema("Namespace of the violating resource, empty for a cluster scoped resource."),
+				PolicyNameKey:            computedStringSchema("Name of the policy."),
+				PolicyTypeKey:            computedStringSchema("Kind of the policy."),
+				EnforcementActionKey:     computedStringSchema("Enforcement action of the policy."),
+				EnforcedKey: {
+					Type:        schema.TypeBool,
+					Description: "True when the policy is enforced, false when the violation is only audited.",
+					Computed:    true,
+				},
+				ResourceKindKey:       computedStringSchema("Kind of the violating resource."),
+				ResourceNameKey:       computedStringSchema("Name of the violating resource."),
+				ResourceAPIVersionKey: computedStringSchema("API version of the violating resource."),
+				MessageKey:            computedStringSchema("Message of the violation."),
+				FirstSeenKey:          computedStringSchema("Time the violation was first seen."),
+				LastSeenKey:           computedStringSchema("Time the violation was last seen."),
+			},
+		},
+	},
+	TotalCountKey: {
+		Type: schema.TypeInt,
+		Description: fmt.Sprintf("Number of policy violations matching the scope and the policy filters as reported by TMC. The '%s' and '%s' filters are not applied to this count, "+
+			"use the length of '%s' for the number of violations matching all filters.", ResourceKindKey, LastSeenAfterKey, ViolationsKey),
+		Computed: true,
+	},
+}
+
+var enforcementActions = []string{enforcementActionDeny, enforcementActionDryRun, enforcementActionWarn}
+
+var managementClusterNameSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Name of the management cluster.",
+	Optional:    true,
+	Default:     "attached",
+}
+
+var provisionerNameSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Name of the provisioner.",
+	Optional:    true,
+	Default:     "attached",
+}
+
+func nameSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  description,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+}
+
+func computedStringSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: description,
+		Computed:    true,
+	}
+}
+
+func dataSourcePolicyInsightsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	searchScope := constructSearchScope(d)
+
+	query := &issueQuery{}
+	query.resourceKind, _ = d.Get(ResourceKindKey).(string)
+
+	if lastSeenAfter, _ := d.Get(LastSeenAfterKey).(string); lastSeenAfter != "" {
+		var err error
+
+		query.lastSeenAfter, err = time.Parse(time.RFC3339, lastSeenAfter)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "invalid %s", LastSeenAfterKey))
+		}
+	}
+
+	resp, err := config.TMCConnection.PolicyInsightsResourceService.PolicyInsightsResourceServiceListIssues(searchScope)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Couldn't list policy violations."))
+	}
+
+	issues := filterIssues(resp.Issues, query)
+
+	d.SetId(constructID(searchScope, query))
+
+	if err := d.Set(ViolationsKey, flattenIssues(issues)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	totalCount := totalIssueCount(resp)
+
+	if err := d.Set(TotalCountKey, totalCount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if totalCount > len(resp.Issues) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Only %d of %d policy violations were returned by TMC, narrow the scope or the policy filters to read all violations.", len(resp.Issues), totalCount),
+		})
+	}
+
+	return diags
+}
+
+func constructSearchScope(d *schema.ResourceData) *policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsSearchScope {
+	searchScope := &policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsSearchScope{}
+
+	searchScope.PolicyName, _ = d.Get(PolicyNameKey).(string)
+	searchScope.EnforcementAction, _ = d.Get(EnforcementActionKey).(string)
+
+	if policyType, _ := d.Get(PolicyTypeKey).(string); policyType != "" {
+		searchScope.PolicyType = policyType + policyTypeSuffix
+	}
+
+	scopeData := firstElement(d.Get(ScopeKey))
+
+	if clusterGroup := firstElement(scopeData[ClusterGroupKey]); clusterGroup != nil {
+		searchScope.ClusterGroupName, _ = clusterGroup[NameKey].(string)
+	}
+
+	if workspace := firstElement(scopeData[WorkspaceKey]); workspace != nil {
+		searchScope.WorkspaceName, _ = workspace[NameKey].(string)
+	}
+
+	if cluster := firstElement(scopeData[ClusterKey]); cluster != nil {
+		searchScope.ClusterName, _ = cluster[NameKey].(string)
+		searchScope.ManagementClusterName, _ = cluster[ManagementClusterNameKey].(string)
+		searchScope.ProvisionerName, _ = cluster[ProvisionerNameKey].(string)
+	}
+
+	if namespace := firstElement(scopeData[NamespaceKey]); namespace != nil {
+		searchScope.NamespaceName, _ = namespace[NameKey].(string)
+		searchScope.ClusterName, _ = namespace[ClusterNameKey].(string)
+		searchScope.ManagementClusterName, _ = namespace[ManagementClusterNameKey].(string)
+		searchScope.ProvisionerName, _ = namespace[ProvisionerNameKey].(string)
+	}
+
+	return searchScope
+}
+
+func firstElement(value interface{}) map[string]interface{} {
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	element, _ := data[0].(map[string]interface{})
+
+	return element
+}
+
+// constructID returns an ID identifying the query, the scope parts which aren't set are left empty.
+func constructID(searchScope *policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsSearchScope, query *issueQuery) string {
+	lastSeenAfter := ""
+
+	if !query.lastSeenAfter.IsZero() {
+		lastSeenAfter = query.lastSeenAfter.UTC().Format(time.RFC3339)
+	}
+
+	return strings.Join([]string{
+		searchScope.ClusterGroupName,
+		searchScope.WorkspaceName,
+		searchScope.ManagementClusterName,
+		searchScope.ProvisionerName,
+		searchScope.ClusterName,
+		searchScope.NamespaceName,
+		searchScope.PolicyName,
+		searchScope.PolicyType,
+		searchScope.EnforcementAction,
+		query.resourceKind,
+		lastSeenAfter,
+	}, "/")
+}
@@ -0,0 +1,116 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyinsights
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	policyinsightsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/insights"
+)
+
+// issueQuery holds the filters which are applied to the issues returned by TMC.
+type issueQuery struct {
+	resourceKind  string
+	lastSeenAfter time.Time
+}
+
+// filterIssues returns the issues matching the query, the most recently seen first.
+func filterIssues(issues []*policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsIssue, query *issueQuery) []*policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsIssue {
+	filtered := make([]*policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsIssue, 0, len(issues))
+
+	for _, issue := range issues {
+		if issue == nil {
+			continue
+		}
+
+		if query.resourceKind != "" && (issue.Resource == nil || !strings.EqualFold(issue.Resource.Kind, query.resourceKind)) {
+			continue
+		}
+
+		if !query.lastSeenAfter.IsZero() && !time.Time(issue.LastSeen).After(query.lastSeenAfter) {
+			continue
+		}
+
+		filtered = append(filtered, issue)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		lastSeenI, lastSeenJ := time.Time(filtered[i].LastSeen), time.Time(filtered[j].LastSeen)
+
+		if !lastSeenI.Equal(lastSeenJ) {
+			return lastSeenI.After(lastSeenJ)
+		}
+
+		return issueKey(filtered[i]) < issueKey(filtered[j])
+	})
+
+	return filtered
+}
+
+// totalIssueCount returns the number of issues matching the search scope as reported by TMC,
+// the number of returned issues is used when TMC doesn't report it.
+func totalIssueCount(resp *policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsListIssuesResponse) int {
+	totalCount, err := strconv.Atoi(resp.TotalCount)
+	if err != nil || totalCount < len(resp.Issues) {
+		return len(resp.Issues)
+	}
+
+	return totalCount
+}
+
+func issueKey(issue *policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsIssue) string {
+	resourceName := ""
+
+	if issue.Resource != nil {
+		resourceName = issue.Resource.Kind + "/" + issue.Resource.Name
+	}
+
+	return strings.Join([]string{issue.PolicyName, issue.ClusterName, issue.NamespaceName, resourceName}, "/")
+}
+
+func flattenIssues(issues []*policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsIssue) []interface{} {
+	data := make([]interface{}, 0, len(issues))
+
+	for _, issue := range issues {
+		flatIssue := map[string]interface{}{
+			ClusterGroupNameKey:      issue.ClusterGroupName,
+			WorkspaceNameKey:         issue.WorkspaceName,
+			ClusterNameKey:           issue.ClusterName,
+			ManagementClusterNameKey: issue.ManagementClusterName,
+			ProvisionerNameKey:       issue.ProvisionerName,
+			NamespaceNameKey:         issue.NamespaceName,
+			PolicyNameKey:            issue.PolicyName,
+			PolicyTypeKey:            strings.TrimSuffix(issue.PolicyType, policyTypeSuffix),
+			EnforcementActionKey:     issue.EnforcementAction,
+			EnforcedKey:              issue.EnforcementAction == enforcementActionDeny,
+			MessageKey:               issue.Message,
+			FirstSeenKey:             formatTime(issue.FirstSeen),
+			LastSeenKey:              formatTime(issue.LastSeen),
+		}
+
+		if issue.Resource != nil {
+			flatIssue[ResourceKindKey] = issue.Resource.Kind
+			flatIssue[ResourceNameKey] = issue.Resource.Name
+			flatIssue[ResourceAPIVersionKey] = issue.Resource.APIVersion
+		}
+
+		data = append(data, flatIssue)
+	}
+
+	return data
+}
+
+func formatTime(dateTime strfmt.DateTime) string {
+	if time.Time(dateTime).IsZero() {
+		return ""
+	}
+
+	return time.Time(dateTime).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,149 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyinsights
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	policyinsightsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/insights"
+)
+
+func testIssue(policyName, kind, name string, lastSeen time.Time) *policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsIssue {
+	return &policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsIssue{
+		ClusterName:       "test-cluster",
+		PolicyName:        policyName,
+		PolicyType:        "security-policy",
+		EnforcementAction: enforcementActionDeny,
+		Resource:          &policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsResource{APIVersion: "v1", Kind: kind, Name: name},
+		LastSeen:          strfmt.DateTime(lastSeen),
+	}
+}
+
+func TestFilterIssues(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	issues := []*policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsIssue{
+		testIssue("baseline", "Pod", "web", now.Add(-2*time.Hour)),
+		testIssue("strict", "Deployment", "api", now),
+		nil,
+		testIssue("baseline", "Pod", "api", now.Add(-2*time.Hour)),
+		{PolicyName: "no-resource", LastSeen: strfmt.DateTime(now.Add(-time.Hour))},
+	}
+
+	cases := []struct {
+		description string
+		query       *issueQuery
+		expected    []string
+	}{
+		{
+			description: "no filter sorts by last seen then by name",
+			query:       &issueQuery{},
+			expected:    []string{"strict/api", "no-resource/", "baseline/api", "baseline/web"},
+		},
+		{
+			description: "resource kind is case insensitive",
+			query:       &issueQuery{resourceKind: "pod"},
+			expected:    []string{"baseline/api", "baseline/web"},
+		},
+		{
+			description: "last seen after",
+			query:       &issueQuery{lastSeenAfter: now.Add(-90 * time.Minute)},
+			expected:    []string{"strict/api", "no-resource/"},
+		},
+		{
+			description: "no match",
+			query:       &issueQuery{resourceKind: "Service"},
+			expected:    []string{},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.description, func(t *testing.T) {
+			actual := make([]string, 0)
+
+			for _, issue := range filterIssues(issues, test.query) {
+				name := ""
+
+				if issue.Resource != nil {
+					name = issue.Resource.Name
+				}
+
+				actual = append(actual, issue.PolicyName+"/"+name)
+			}
+
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenIssues(t *testing.T) {
+	lastSeen := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	issue := testIssue("baseline", "Pod", "web", lastSeen)
+	issue.EnforcementAction = enforcementActionDryRun
+
+	actual := flattenIssues([]*policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsIssue{issue})
+
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			ClusterGroupNameKey:      "",
+			WorkspaceNameKey:         "",
+			ClusterNameKey:           "test-cluster",
+			ManagementClusterNameKey: "",
+			ProvisionerNameKey:       "",
+			NamespaceNameKey:         "",
+			PolicyNameKey:            "baseline",
+			PolicyTypeKey:            "security",
+			EnforcementActionKey:     enforcementActionDryRun,
+			EnforcedKey:              false,
+			MessageKey:               "",
+			FirstSeenKey:             "",
+			LastSeenKey:              "2024-03-01T12:00:00Z",
+			ResourceKindKey:          "Pod",
+			ResourceNameKey:          "web",
+			ResourceAPIVersionKey:    "v1",
+		},
+	}, actual)
+}
+
+func TestTotalIssueCount(t *testing.T) {
+	issues := []*policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsIssue{
+		testIssue("baseline", "Pod", "web", time.Time{}),
+		testIssue("baseline", "Pod", "api", time.Time{}),
+	}
+
+	cases := []struct {
+		description string
+		totalCount  string
+		expected    int
+	}{
+		{
+			description: "total count reported by TMC",
+			totalCount:  "120",
+			expected:    120,
+		},
+		{
+			description: "total count not reported",
+			totalCount:  "",
+			expected:    2,
+		},
+		{
+			description: "total count lower than the returned issues",
+			totalCount:  "1",
+			expected:    2,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			resp := &policyinsightsmodel.VmwareTanzuManageV1alpha1PolicyInsightsListIssuesResponse{Issues: issues, TotalCount: test.totalCount}
+			require.Equal(t, test.expected, totalIssueCount(resp))
+		})
+	}
+}
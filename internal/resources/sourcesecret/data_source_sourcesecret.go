@@ -14,8 +14,6 @@ import (
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
-	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
-	sourcesecretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/cluster"
 	sourcesecretclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/clustergroup"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -79,17 +77,11 @@ func resourceSourcesecretRead(ctx context.Context, d *schema.ResourceData, m int
 		specTypeData  string
 	)
 
-	switch *atomicSpec.SourceSecretType {
-	case *sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeUSERNAMEPASSWORD):
+	// The sensitive value isn't returned by TMC, it is kept from the configuration.
+	if sensitiveValuePath, _ := spec.SensitiveValueKeys(*atomicSpec.SourceSecretType); sensitiveValuePath != "" {
 		if _, ok := d.GetOk(spec.SpecKey); ok {
-			specTypeData, _ = (d.Get(helper.GetFirstElementOf(spec.SpecKey, spec.DataKey, spec.UsernamePasswordKey, spec.PasswordKey))).(string)
+			specTypeData, _ = (d.Get(sensitiveValuePath)).(string)
 		}
-	case *sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeSSH):
-		if _, ok := d.GetOk(spec.SpecKey); ok {
-			specTypeData, _ = (d.Get(helper.GetFirstElementOf(spec.SpecKey, spec.DataKey, spec.SSHKey, spec.IdentityKey))).(string)
-		}
-	default:
-		specTypeData = ""
 	}
 
 	switch scopedFullnameData.Scope {
@@ -21,7 +21,9 @@ import (
 	sourcesecretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/cluster"
 	sourcesecretclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/clustergroup"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/readiness"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/continuousdelivery"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/sourcesecret/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/sourcesecret/spec"
 )
@@ -79,6 +81,10 @@ func getSecretSchema(isDataSource bool) map[string]*schema.Schema {
 		}
 	}
 
+	if !isDataSource {
+		sourcesecretSchema[readiness.WaitForReadyKey] = readiness.WaitForReadySchema
+	}
+
 	return sourcesecretSchema
 }
 
@@ -182,11 +188,15 @@ func resourceSourcesecretCreate(ctx context.Context, d *schema.ResourceData, m i
 		meta = common.ConstructMeta(d)
 	)
 
-	err := enableContinuousDelivery(&config, scopedFullnameData, meta)
+	implicitlyEnabled, err := enableContinuousDelivery(&config, scopedFullnameData, meta)
 	if err != nil {
 		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control source secret entry, name : %s", sourcesecretName))
 	}
 
+	if implicitlyEnabled {
+		diags = append(diags, continuousdelivery.ImplicitEnablementWarning(ResourceName))
+	}
+
 	switch scopedFullnameData.Scope {
 	case commonscope.ClusterScope:
 		if scopedFullnameData.FullnameCluster != nil {
@@ -229,7 +239,12 @@ func resourceSourcesecretCreate(ctx context.Context, d *schema.ResourceData, m i
 	// always run
 	d.SetId(UID)
 
-	return resourceSourcesecretRead(ctx, d, m)
+	if err := waitForReady(config, d, scopedFullnameData); err != nil {
+		diags = append(diags, resourceSourcesecretRead(ctx, d, m)...)
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceSourcesecretRead(ctx, d, m)...)
 }
 
 func resourceSourcesecretInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -304,6 +319,10 @@ func resourceSourcesecretInPlaceUpdate(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[INFO] source secret update successful")
 
+	if err := waitForReady(config, d, scopedFullnameData); err != nil {
+		return append(resourceSourcesecretRead(ctx, d, m), diag.FromErr(err)...)
+	}
+
 	return resourceSourcesecretRead(ctx, d, m)
 }
 
@@ -366,16 +385,14 @@ func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanz
 
 func updateCheckForSpec(d *schema.ResourceData, atomicSpec *sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretSpec, scope commonscope.Scope) bool {
 	if !spec.HasSpecChanged(d) {
-		if *atomicSpec.SourceSecretType == *sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeUSERNAMEPASSWORD) {
-			specTypeData, _ := (d.Get(helper.GetFirstElementOf(spec.SpecKey, spec.DataKey, spec.UsernamePasswordKey, spec.PasswordKey))).(string)
-			val, _ := spec.GetEncodedSpecData(specTypeData)
-			atomicSpec.Data.Data[spec.PasswordKey] = val
-		}
+		// TMC doesn't return the sensitive value, it is sent again from the configuration.
+		if sensitiveValuePath, sensitiveDataKey := spec.SensitiveValueKeys(*atomicSpec.SourceSecretType); sensitiveValuePath != "" {
+			specTypeData, _ := (d.Get(sensitiveValuePath)).(string)
 
-		if *atomicSpec.SourceSecretType == *sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeSSH) {
-			specTypeData, _ := (d.Get(helper.GetFirstElementOf(spec.SpecKey, spec.DataKey, spec.SSHKey, spec.IdentityKey))).(string)
-			val, _ := spec.GetEncodedSpecData(specTypeData)
-			atomicSpec.Data.Data[spec.IdentityKey] = val
+			if specTypeData != "" {
+				val, _ := spec.GetEncodedSpecData(specTypeData)
+				atomicSpec.Data.Data[sensitiveDataKey] = val
+			}
 		}
 
 		return false
@@ -0,0 +1,92 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package sourcesecret
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	sourcesecretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/cluster"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/readiness"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/sourcesecret/scope"
+)
+
+// waitForReady waits until the source secret is valid on the target when wait_for_ready is set.
+func waitForReady(config authctx.TanzuContext, d *schema.ResourceData, scopedFullnameData *scope.ScopedFullname) error {
+	return readiness.WaitForReady(d, scopedFullnameData.Scope, fmt.Sprintf("source secret %s", d.Get(nameKey)), &readiness.StatusFuncs{
+		Cluster: func() (*readiness.ClusterStatus, error) {
+			resp, err := config.TMCConnection.ClusterSourcesecretResourceService.ManageV1alpha1ClusterFluxcdSourcesecretResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.SourceSecret.Status == nil {
+				return nil, nil
+			}
+
+			return &readiness.ClusterStatus{
+				Meta:               resp.SourceSecret.Meta,
+				ObservedGeneration: resp.SourceSecret.Status.ObservedGeneration,
+				State:              credentialState(resp.SourceSecret.Status.Status),
+			}, nil
+		},
+		ClusterGroup: func() (*readiness.BatchStatus, error) {
+			resp, err := config.TMCConnection.ClusterGroupSourcesecretResourceService.ManageV1alpha1ClustergroupFluxcdSourcesecretResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.SourceSecret.Status == nil {
+				return nil, nil
+			}
+
+			return &readiness.BatchStatus{
+				Meta:               resp.SourceSecret.Meta,
+				ObservedGeneration: resp.SourceSecret.Status.ObservedGeneration,
+				Phase:              resp.SourceSecret.Status.Phase,
+				Details:            resp.SourceSecret.Status.Details,
+			}, nil
+		},
+	})
+}
+
+// credentialState returns the readiness of a cluster source secret from the phase of its credential,
+// the Ready condition is used when the phase isn't reported.
+func credentialState(status *sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialStatus) *readiness.State {
+	if status == nil {
+		return readiness.ConditionState(nil)
+	}
+
+	if status.Phase == nil {
+		conditions := make(map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition, len(status.Conditions))
+
+		for key, condition := range status.Conditions {
+			if condition != nil {
+				conditions[key] = *condition
+			}
+		}
+
+		return readiness.ConditionState(conditions)
+	}
+
+	state := &readiness.State{Message: fmt.Sprintf("phase %s", *status.Phase)}
+
+	if status.PhaseInfo != "" {
+		state.Message = fmt.Sprintf("%s: %s", state.Message, status.PhaseInfo)
+	}
+
+	switch *status.Phase {
+	case sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialStatusPhaseVALID:
+		state.Ready = true
+	case sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialStatusPhaseINVALID,
+		sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialStatusPhaseERROR:
+		state.Failed = true
+	}
+
+	return state
+}
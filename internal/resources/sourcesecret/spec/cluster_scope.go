@@ -10,6 +10,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	sourcesecretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/cluster"
 )
 
@@ -107,12 +108,96 @@ func ConstructSpecForClusterScope(d *schema.ResourceData) (spec *sourcesecretclu
 					return spec
 				}
 			}
+
+			if githubApp, ok := specType[GithubAppKey]; ok {
+				if v1, ok := githubApp.([]interface{}); ok && len(v1) != 0 {
+					data, _ := v1[0].(map[string]interface{})
+
+					return constructSpecData(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeGITHUBAPP, map[string]string{
+						githubAppIDDataKey:             stringValue(data, AppIDKey),
+						githubAppInstallationIDDataKey: stringValue(data, InstallationIDKey),
+						githubAppPrivateKeyDataKey:     stringValue(data, PrivateKeyKey),
+						githubAppBaseURLDataKey:        stringValue(data, BaseURLKey),
+					})
+				}
+			}
+
+			if bearerToken, ok := specType[BearerTokenKey]; ok {
+				if v1, ok := bearerToken.([]interface{}); ok && len(v1) != 0 {
+					data, _ := v1[0].(map[string]interface{})
+
+					return constructSpecData(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeBEARERTOKEN, map[string]string{
+						bearerTokenDataKey: stringValue(data, TokenKey),
+					})
+				}
+			}
+
+			if tls, ok := specType[TLSKey]; ok {
+				if v1, ok := tls.([]interface{}); ok && len(v1) != 0 {
+					data, _ := v1[0].(map[string]interface{})
+
+					return constructSpecData(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeTLS, map[string]string{
+						caDataKey:   stringValue(data, CAKey),
+						certDataKey: stringValue(data, CertKey),
+						keyDataKey:  stringValue(data, KeyKey),
+					})
+				}
+			}
 		}
 	}
 
 	return spec
 }
 
+// constructSpecData returns the spec of an opaque source secret, the empty values are left out of the secret.
+func constructSpecData(sourceSecretType sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType,
+	values map[string]string) *sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretSpec {
+	specData := make(map[string]strfmt.Base64, len(values))
+
+	for key, value := range values {
+		if value == "" {
+			continue
+		}
+
+		specData[key], _ = GetEncodedSpecData(value)
+	}
+
+	return &sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretSpec{
+		SourceSecretType: sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType(sourceSecretType),
+		Data: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+			Type: sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretType(
+				sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretTypeOPAQUESECRETTYPE,
+			),
+			Data: specData,
+		},
+	}
+}
+
+func stringValue(data map[string]interface{}, key string) string {
+	value, _ := data[key].(string)
+
+	return value
+}
+
+// SensitiveValueKeys returns the path in the schema and the key in the source secret data of the sensitive value of a source secret type.
+// TMC doesn't return the sensitive value, so it is kept from the configuration.
+func SensitiveValueKeys(sourceSecretType sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType) (path string, dataKey string) {
+	switch sourceSecretType {
+	case sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeUSERNAMEPASSWORD:
+		return helper.GetFirstElementOf(SpecKey, DataKey, UsernamePasswordKey, PasswordKey), PasswordKey
+	case sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeSSH:
+		return helper.GetFirstElementOf(SpecKey, DataKey, SSHKey, IdentityKey), IdentityKey
+	case sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeGITHUBAPP:
+		return helper.GetFirstElementOf(SpecKey, DataKey, GithubAppKey, PrivateKeyKey), githubAppPrivateKeyDataKey
+	case sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeBEARERTOKEN:
+		return helper.GetFirstElementOf(SpecKey, DataKey, BearerTokenKey, TokenKey), bearerTokenDataKey
+	case sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeTLS:
+		return helper.GetFirstElementOf(SpecKey, DataKey, TLSKey, KeyKey), keyDataKey
+	}
+
+	return "", ""
+}
+
 func FlattenSpecForClusterScope(spec *sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretSpec, specTypeData string) (data []interface{}) {
 	if spec == nil {
 		return data
@@ -160,6 +245,45 @@ func FlattenSpecForClusterScope(spec *sourcesecretclustermodel.VmwareTanzuManage
 		Data[SSHKey] = []interface{}{sshspecData}
 	}
 
+	if *spec.SourceSecretType == sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeGITHUBAPP {
+		appID, ok := spec.Data.Data[githubAppIDDataKey]
+		if !ok {
+			return data
+		}
+
+		appIDData, err := getDecodedSpecData(appID)
+		if err != nil {
+			return data
+		}
+
+		Data[GithubAppKey] = []interface{}{
+			map[string]interface{}{
+				AppIDKey:          appIDData,
+				InstallationIDKey: decodedValue(spec.Data.Data, githubAppInstallationIDDataKey),
+				PrivateKeyKey:     specTypeData,
+				BaseURLKey:        decodedValue(spec.Data.Data, githubAppBaseURLDataKey),
+			},
+		}
+	}
+
+	if *spec.SourceSecretType == sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeBEARERTOKEN {
+		Data[BearerTokenKey] = []interface{}{
+			map[string]interface{}{
+				TokenKey: specTypeData,
+			},
+		}
+	}
+
+	if *spec.SourceSecretType == sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeTLS {
+		Data[TLSKey] = []interface{}{
+			map[string]interface{}{
+				CAKey:   decodedValue(spec.Data.Data, caDataKey),
+				CertKey: decodedValue(spec.Data.Data, certDataKey),
+				KeyKey:  specTypeData,
+			},
+		}
+	}
+
 	flattenSpecData[DataKey] = []interface{}{Data}
 
 	return []interface{}{flattenSpecData}
@@ -176,6 +300,18 @@ func GetEncodedSpecData(data string) (strfmt.Base64, error) {
 	return secretspecdata, nil
 }
 
+// decodedValue returns the decoded value of a key of the source secret data, empty when the key is missing or can't be decoded.
+func decodedValue(data map[string]strfmt.Base64, key string) string {
+	value, ok := data[key]
+	if !ok {
+		return ""
+	}
+
+	decoded, _ := getDecodedSpecData(value)
+
+	return decoded
+}
+
 func getDecodedSpecData(data strfmt.Base64) (string, error) {
 	rawData, err := base64.StdEncoding.DecodeString(data.String())
 	if err != nil {
@@ -13,4 +13,27 @@ const (
 	IdentityKey         = "identity"
 	KnownhostsKey       = "known_hosts"
 	SpecKey             = "spec"
+	GithubAppKey        = "github_app"
+	AppIDKey            = "app_id"
+	InstallationIDKey   = "installation_id"
+	PrivateKeyKey       = "private_key"
+	BaseURLKey          = "base_url"
+	BearerTokenKey      = "bearer_token"
+	TokenKey            = "token"
+	TLSKey              = "tls"
+	CAKey               = "ca"
+	CertKey             = "cert"
+	KeyKey              = "key"
+)
+
+// Keys of the source secret data, as expected by Flux.
+const (
+	githubAppIDDataKey             = "githubAppID"
+	githubAppInstallationIDDataKey = "githubAppInstallationID"
+	githubAppPrivateKeyDataKey     = "githubAppPrivateKey"
+	githubAppBaseURLDataKey        = "githubAppBaseURL"
+	bearerTokenDataKey             = "bearerToken"
+	caDataKey                      = "ca.crt"
+	certDataKey                    = "tls.crt"
+	keyDataKey                     = "tls.key"
 )
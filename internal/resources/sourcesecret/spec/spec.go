@@ -28,7 +28,7 @@ var (
 		},
 	}
 
-	specsAllowed = [...]string{UsernamePasswordKey, SSHKey}
+	specsAllowed = [...]string{UsernamePasswordKey, SSHKey, GithubAppKey, BearerTokenKey, TLSKey}
 
 	dataSchema = &schema.Schema{
 		Type:        schema.TypeList,
@@ -40,6 +40,9 @@ var (
 			Schema: map[string]*schema.Schema{
 				UsernamePasswordKey: usernamePasswordDataSpec,
 				SSHKey:              sshDataSpec,
+				GithubAppKey:        githubAppDataSpec,
+				BearerTokenKey:      bearerTokenDataSpec,
+				TLSKey:              tlsDataSpec,
 			},
 		},
 	}
@@ -87,6 +90,82 @@ var (
 			},
 		},
 	}
+
+	githubAppDataSpec = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The schema for GitHub App credential type spec.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				AppIDKey: {
+					Type:        schema.TypeString,
+					Description: "ID of the GitHub App.",
+					Required:    true,
+				},
+				InstallationIDKey: {
+					Type:        schema.TypeString,
+					Description: "ID of the installation of the GitHub App.",
+					Required:    true,
+				},
+				PrivateKeyKey: {
+					Type:        schema.TypeString,
+					Description: "PEM encoded private key of the GitHub App.",
+					Required:    true,
+					Sensitive:   true,
+				},
+				BaseURLKey: {
+					Type:        schema.TypeString,
+					Description: "Base URL of the GitHub Enterprise Server API. The public GitHub API is used by default.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+
+	bearerTokenDataSpec = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The schema for Bearer Token credential type spec.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				TokenKey: {
+					Type:        schema.TypeString,
+					Description: "Token for the bearer authorization.",
+					Required:    true,
+					Sensitive:   true,
+				},
+			},
+		},
+	}
+
+	tlsDataSpec = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The schema for TLS credential type spec. The client certificate and key have to be provided together.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				CAKey: {
+					Type:        schema.TypeString,
+					Description: "PEM encoded certificate of the CA which signed the certificate of the server.",
+					Optional:    true,
+				},
+				CertKey: {
+					Type:        schema.TypeString,
+					Description: "PEM encoded client certificate.",
+					Optional:    true,
+				},
+				KeyKey: {
+					Type:        schema.TypeString,
+					Description: "PEM encoded private key of the client certificate.",
+					Optional:    true,
+					Sensitive:   true,
+				},
+			},
+		},
+	}
 )
 
 func HasSpecChanged(d *schema.ResourceData) bool {
@@ -103,6 +182,24 @@ func HasSpecChanged(d *schema.ResourceData) bool {
 		if d.HasChange(helper.GetFirstElementOf(SpecKey, DataKey, SSHKey, IdentityKey)) || d.HasChange(helper.GetFirstElementOf(SpecKey, DataKey, SSHKey, KnownhostsKey)) {
 			updateRequired = true
 		}
+
+		fallthrough
+	case d.Get(helper.GetFirstElementOf(SpecKey, DataKey, GithubAppKey)) != nil:
+		if d.HasChange(helper.GetFirstElementOf(SpecKey, DataKey, GithubAppKey)) {
+			updateRequired = true
+		}
+
+		fallthrough
+	case d.Get(helper.GetFirstElementOf(SpecKey, DataKey, BearerTokenKey)) != nil:
+		if d.HasChange(helper.GetFirstElementOf(SpecKey, DataKey, BearerTokenKey)) {
+			updateRequired = true
+		}
+
+		fallthrough
+	case d.Get(helper.GetFirstElementOf(SpecKey, DataKey, TLSKey)) != nil:
+		if d.HasChange(helper.GetFirstElementOf(SpecKey, DataKey, TLSKey)) {
+			updateRequired = true
+		}
 	}
 
 	return updateRequired
@@ -132,15 +229,11 @@ func ValidateSpec(_ context.Context, diff *schema.ResourceDiff, i interface{}) e
 
 			specType := v1[0].(map[string]interface{})
 
-			if usernamePassword, ok := specType[UsernamePasswordKey]; ok {
-				if v1, ok := usernamePassword.([]interface{}); ok && len(v1) != 0 {
-					specesFound = append(specesFound, UsernamePasswordKey)
-				}
-			}
-
-			if ssh, ok := specType[SSHKey]; ok {
-				if v1, ok := ssh.([]interface{}); ok && len(v1) != 0 {
-					specesFound = append(specesFound, SSHKey)
+			for _, specTypeKey := range specsAllowed {
+				if specTypeValue, ok := specType[specTypeKey]; ok {
+					if v1, ok := specTypeValue.([]interface{}); ok && len(v1) != 0 {
+						specesFound = append(specesFound, specTypeKey)
+					}
 				}
 			}
 		}
@@ -152,5 +245,34 @@ func ValidateSpec(_ context.Context, diff *schema.ResourceDiff, i interface{}) e
 		return fmt.Errorf("found spec types: %v are not valid: maximum one valid spec type block is allowed", strings.Join(specesFound, `, `))
 	}
 
+	if specesFound[0] == TLSKey {
+		return validateTLS(diff)
+	}
+
+	return nil
+}
+
+// validateTLS checks that a TLS spec has a CA certificate or a client certificate, and that the client certificate comes with its key.
+// Values which aren't known at plan time are skipped.
+func validateTLS(diff *schema.ResourceDiff) error {
+	values := make(map[string]string, 3)
+
+	for _, key := range []string{CAKey, CertKey, KeyKey} {
+		path := helper.GetFirstElementOf(SpecKey, DataKey, TLSKey, key)
+
+		if !diff.NewValueKnown(path) {
+			return nil
+		}
+
+		values[key], _ = diff.Get(path).(string)
+	}
+
+	switch {
+	case values[CAKey] == "" && values[CertKey] == "":
+		return fmt.Errorf("spec type %v is not valid: %v or %v is required", TLSKey, CAKey, CertKey)
+	case (values[CertKey] == "") != (values[KeyKey] == ""):
+		return fmt.Errorf("spec type %v is not valid: %v and %v have to be provided together", TLSKey, CertKey, KeyKey)
+	}
+
 	return nil
 }
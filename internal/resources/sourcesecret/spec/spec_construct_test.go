@@ -0,0 +1,98 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	sourcesecretclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/cluster"
+)
+
+func TestConstructSpecForClusterScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description  string
+		data         map[string]interface{}
+		expectedType sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType
+		expectedData map[string]string
+	}{
+		{
+			description: "github app type spec",
+			data: map[string]interface{}{
+				GithubAppKey: []interface{}{
+					map[string]interface{}{
+						AppIDKey:          "1234",
+						InstallationIDKey: "5678",
+						PrivateKeyKey:     "someprivatekey",
+						BaseURLKey:        "https://github.example.com/api/v3",
+					},
+				},
+			},
+			expectedType: sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeGITHUBAPP,
+			expectedData: map[string]string{
+				githubAppIDDataKey:             "1234",
+				githubAppInstallationIDDataKey: "5678",
+				githubAppPrivateKeyDataKey:     "someprivatekey",
+				githubAppBaseURLDataKey:        "https://github.example.com/api/v3",
+			},
+		},
+		{
+			description: "bearer token type spec",
+			data: map[string]interface{}{
+				BearerTokenKey: []interface{}{
+					map[string]interface{}{
+						TokenKey: "sometoken",
+					},
+				},
+			},
+			expectedType: sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeBEARERTOKEN,
+			expectedData: map[string]string{
+				bearerTokenDataKey: "sometoken",
+			},
+		},
+		{
+			description: "tls type spec with only a CA certificate",
+			data: map[string]interface{}{
+				TLSKey: []interface{}{
+					map[string]interface{}{
+						CAKey: "someca",
+					},
+				},
+			},
+			expectedType: sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeTLS,
+			expectedData: map[string]string{
+				caDataKey: "someca",
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{SpecKey: SpecSchema}, map[string]interface{}{
+				SpecKey: []interface{}{
+					map[string]interface{}{
+						DataKey: []interface{}{test.data},
+					},
+				},
+			})
+
+			actual := ConstructSpecForClusterScope(d)
+			require.Equal(t, test.expectedType, *actual.SourceSecretType)
+
+			actualData := make(map[string]string, len(actual.Data.Data))
+
+			for key, value := range actual.Data.Data {
+				actualData[key] = string(value)
+			}
+
+			require.Equal(t, test.expectedData, actualData)
+		})
+	}
+}
@@ -83,6 +83,87 @@ func TestFlattenSpecForClusterScope(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "normal scenario with complete cluster source secret github app type spec",
+			input: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretSpec{
+				SourceSecretType: sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeGITHUBAPP),
+				Data: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+					Data: map[string]strfmt.Base64{
+						githubAppIDDataKey:             []byte("1234"),
+						githubAppInstallationIDDataKey: []byte("5678"),
+						githubAppPrivateKeyDataKey:     []byte(""),
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					DataKey: []interface{}{
+						map[string]interface{}{
+							GithubAppKey: []interface{}{
+								map[string]interface{}{
+									AppIDKey:          "1234",
+									InstallationIDKey: "5678",
+									PrivateKeyKey:     testSomevalue,
+									BaseURLKey:        "",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "normal scenario with complete cluster source secret bearer token type spec",
+			input: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretSpec{
+				SourceSecretType: sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeBEARERTOKEN),
+				Data: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+					Data: map[string]strfmt.Base64{
+						bearerTokenDataKey: []byte(""),
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					DataKey: []interface{}{
+						map[string]interface{}{
+							BearerTokenKey: []interface{}{
+								map[string]interface{}{
+									TokenKey: testSomevalue,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "normal scenario with complete cluster source secret tls type spec",
+			input: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretSpec{
+				SourceSecretType: sourcesecretclustermodel.NewVmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretType(sourcesecretclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretTypeTLS),
+				Data: &sourcesecretclustermodel.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+					Data: map[string]strfmt.Base64{
+						caDataKey:   []byte("someca"),
+						certDataKey: []byte("somecert"),
+						keyDataKey:  []byte(""),
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					DataKey: []interface{}{
+						map[string]interface{}{
+							TLSKey: []interface{}{
+								map[string]interface{}{
+									CAKey:   "someca",
+									CertKey: "somecert",
+									KeyKey:  testSomevalue,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, each := range cases {
@@ -0,0 +1,66 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzukubernetescluster
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	openapiv3 "github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper/openapi_v3_schema_validator"
+)
+
+const testClusterClassSchema = `{
+  "network": {
+    "type": "object",
+    "properties": {
+      "proxy": {
+        "type": "object",
+        "required": ["httpProxy", "noProxy"],
+        "properties": {
+          "httpProxy": {"type": "string"},
+          "noProxy": {"type": "array", "items": {"type": "string"}}
+        }
+      }
+    }
+  },
+  "ntpServers": {
+    "type": "array",
+    "items": {"type": "string"}
+  }
+}`
+
+func TestValidateClusterVariablesErrors(t *testing.T) {
+	schema := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(testClusterClassSchema), &schema))
+
+	validator := &ClusterClassValidator{
+		OpenAPIV3Validator: &openapiv3.OpenAPIV3SchemaValidator{Schema: schema},
+	}
+
+	errs := validator.ValidateClusterVariables(`{
+		"network": {"extra": true, "proxy": {"httpProxy": 1}},
+		"ntpServers": ["time.example.com", 1],
+		"unknown": "value"
+	}`, true)
+
+	messages := make([]string, 0, len(errs))
+
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+
+	sort.Strings(messages)
+
+	require.Equal(t, []string{
+		"Key 'unknown' is not expected in cluster class schema.",
+		"Object 'network' field validation failed: Key 'noProxy' is required in object 'proxy' but not provided!",
+		"Value validation failed for key 'network': Key 'extra' is not expected in key network.",
+		"Value validation failed for key 'network': Key 'httpProxy' should be a string, type provided: float64",
+		"Value validation failed for key 'ntpServers': Key 'ntpServers' should be a string, type provided: float64",
+	}, messages)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	tfModelConverterHelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper/converter"
 	clusterclassmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clusterclass"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	tanzukubernetesclustermodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
 	tkcnodepoolmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster/nodepool"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/clusterclass"
@@ -196,8 +197,22 @@ func resourceTanzuKubernetesClusterUpdate(ctx context.Context, data *schema.Reso
 		return diag.FromErr(errors.Wrapf(err, "Couldn't update TKG Cluster."))
 	}
 
-	if data.HasChangeExcept(TimeoutPolicyKey) {
+	if data.HasChangesExcept(TimeoutPolicyKey, UpgradeStrategyKey) {
 		modelNodePools := model.Spec.Topology.NodePools
+		stagedUpgrade := isStagedUpgrade(data)
+
+		var upgradeMeta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+
+		var upgradedNodePools []*tkcnodepoolmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterNodepool
+
+		if stagedUpgrade {
+			upgradedNodePools = getExistingNodePools(data, modelNodePools)
+
+			err = deferNodePoolsUpgrade(ctx, config, model.FullName, upgradedNodePools)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Couldn't defer TKG Cluster node pools upgrade."))
+			}
+		}
 
 		if data.HasChanges(clusterResourceUpdateKeys...) {
 			model.Spec.Topology.NodePools = nil
@@ -206,11 +221,34 @@ func resourceTanzuKubernetesClusterUpdate(ctx context.Context, data *schema.Reso
 				TanzuKubernetesCluster: model,
 			}
 
-			_, err = config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceUpdate(clusterRequest)
+			var clusterResp *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterData
+
+			clusterResp, err = config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceUpdate(clusterRequest)
 			if err != nil {
+				// The state keeps the current version so the upgrade is planned again, the node pools aren't held until then.
+				data.Partial(stagedUpgrade)
+				releaseDeferredNodePools(ctx, config, model.FullName, upgradedNodePools)
+
 				return diag.FromErr(errors.Wrapf(err, "Couldn't update TKG Cluster.\nManagement Cluster Name: %s, Provisioner: %s, Cluster Name: %s",
 					model.FullName.ManagementClusterName, model.FullName.ProvisionerName, model.FullName.Name))
 			}
+
+			if clusterResp != nil && clusterResp.TanzuKubernetesCluster != nil {
+				upgradeMeta = clusterResp.TanzuKubernetesCluster.Meta
+			}
+		}
+
+		if stagedUpgrade {
+			err = rolloutNodePoolsUpgrade(ctx, &config, data, model.FullName, upgradeMeta, upgradedNodePools)
+			if err != nil {
+				// The new version is only persisted once all node pools are upgraded, the state keeps the current version so the rollout is resumed by the next apply.
+				// The node pools which weren't upgraded yet are released so none of them is left held.
+				data.Partial(true)
+				releaseDeferredNodePools(ctx, config, model.FullName, upgradedNodePools)
+
+				return diag.FromErr(errors.Wrapf(err, "Couldn't upgrade TKG Cluster.\nManagement Cluster Name: %s, Provisioner: %s, Cluster Name: %s",
+					model.FullName.ManagementClusterName, model.FullName.ProvisionerName, model.FullName.Name))
+			}
 		}
 
 		if data.HasChange(nodePoolResourceKey) {
@@ -418,5 +456,17 @@ func validateSchema(_ context.Context, data *schema.ResourceDiff, value interfac
 		err = errors.New(errStr)
 	}
 
+	upgradeErr := validateVersionUpgrade(resp.ClusterClasses[0], data)
+
+	if upgradeErr != nil {
+		errStr := "Version upgrade validation failed:\n"
+
+		if err != nil {
+			errStr = fmt.Sprintf("%s%s", err.Error(), errStr)
+		}
+
+		err = errors.Errorf("%s%s\n", errStr, upgradeErr.Error())
+	}
+
 	return err
 }
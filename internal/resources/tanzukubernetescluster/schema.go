@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
@@ -33,6 +34,7 @@ const (
 	ManagementClusterNameKey = "management_cluster_name"
 	ProvisionerNameKey       = "provisioner_name"
 	TimeoutPolicyKey         = "timeout_policy"
+	UpgradeStrategyKey       = "upgrade_strategy"
 
 	// Spec Directive Keys.
 	ClusterGroupNameKey = "cluster_group_name"
@@ -47,6 +49,9 @@ const (
 	WaitForKubeConfigKey = "wait_for_kubeconfig"
 	FailOnTimeOutKey     = "fail_on_timeout"
 
+	// Upgrade Strategy Directive Keys.
+	NodePoolBatchSizeKey = "node_pool_batch_size"
+
 	// Topology Directive Keys.
 	ClusterClassKey     = "cluster_class"
 	ControlPlaneKey     = "control_plane"
@@ -72,6 +77,9 @@ const (
 	TimeoutDefaultValue           = 60
 	WaitForKubeConfigDefaultValue = true
 	FailOnTimeOutDefaultValue     = true
+
+	// Upgrade Strategy Default Values.
+	NodePoolBatchSizeDefaultValue = 1
 )
 
 var tanzuKubernetesClusterSchema = map[string]*schema.Schema{
@@ -81,6 +89,7 @@ var tanzuKubernetesClusterSchema = map[string]*schema.Schema{
 	SpecKey:                  specSchema,
 	common.MetaKey:           common.Meta,
 	TimeoutPolicyKey:         timeoutPolicySchema,
+	UpgradeStrategyKey:       upgradeStrategySchema,
 }
 
 var clusterNameSchema = &schema.Schema{
@@ -171,6 +180,25 @@ var timeoutPolicySchema = &schema.Schema{
 	},
 }
 
+var upgradeStrategySchema = &schema.Schema{
+	Type: schema.TypeList,
+	Description: "Staged upgrade strategy for Tanzu Kubernetes cluster version upgrades. When set, the control plane is upgraded first and the node pools are upgraded afterwards in batches, " +
+		"each batch waits for its node pools to be ready and the cluster to be healthy before the next batch is upgraded.",
+	Optional: true,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			NodePoolBatchSizeKey: {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("Number of node pools upgraded concurrently. (Default: %d)", NodePoolBatchSizeDefaultValue),
+				Default:      NodePoolBatchSizeDefaultValue,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	},
+}
+
 var TopologySchema = &schema.Schema{
 	Type:        schema.TypeList,
 	Description: "The cluster topology.",
@@ -0,0 +1,366 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzukubernetescluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	clusterclassmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clusterclass"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+	tanzukubernetesclustermodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+	tkccommonmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster/common"
+	tkcnodepoolmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster/nodepool"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kubernetesversions"
+)
+
+const (
+	// clusterClassReadyCondition is the condition reporting whether a cluster class can be used by clusters.
+	clusterClassReadyCondition = "Ready"
+
+	// clusterReadyCondition is the condition reporting whether a Tanzu Kubernetes Cluster is ready.
+	clusterReadyCondition = "Ready"
+
+	// deferUpgradeAnnotation is the Cluster API annotation which holds a machine deployment on its current version when the cluster topology version changes.
+	deferUpgradeAnnotation = "topology.cluster.x-k8s.io/defer-upgrade"
+)
+
+// versionKey key of the cluster version in the resource - needed for change checks on the resource for upgrades.
+var versionKey = strings.Join([]string{SpecKey, "0", TopologyKey, "0", VersionKey}, ".")
+
+// validateVersionUpgrade checks during plan whether the cluster can be upgraded from its current version to the configured version with its ClusterClass.
+func validateVersionUpgrade(clusterClass *clusterclassmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerClusterClass, data *schema.ResourceDiff) error {
+	if data.Id() == "" || !data.HasChange(versionKey) {
+		return nil
+	}
+
+	oldValue, newValue := data.GetChange(versionKey)
+	currentVersion, _ := oldValue.(string)
+	targetVersion, _ := newValue.(string)
+
+	// Version is unknown during plan.
+	if currentVersion == "" || targetVersion == "" {
+		return nil
+	}
+
+	return validateUpgradePath(clusterClass, currentVersion, targetVersion)
+}
+
+// validateUpgradePath checks whether the ClusterClass of the cluster is ready to roll out an upgrade and whether the upgrade follows the Kubernetes version skew policy.
+func validateUpgradePath(clusterClass *clusterclassmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerClusterClass, currentVersion, targetVersion string) error {
+	if clusterClass != nil && clusterClass.Status != nil {
+		condition, ok := clusterClass.Status.Conditions[clusterClassReadyCondition]
+
+		if ok && condition.Status != nil && *condition.Status != statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE {
+			return errors.Errorf("Cluster can't be upgraded from %s to %s. Cluster class %s is not ready: %s",
+				currentVersion, targetVersion, clusterClass.FullName.Name, condition.Message)
+		}
+	}
+
+	if !kubernetesversions.IsValidUpgrade(currentVersion, targetVersion) {
+		return errors.Errorf("Cluster can't be upgraded from %s to %s. Clusters can only be upgraded to a newer version, one minor version at a time.",
+			currentVersion, targetVersion)
+	}
+
+	return nil
+}
+
+// isStagedUpgrade checks whether the cluster version changed and an upgrade strategy is set.
+func isStagedUpgrade(data *schema.ResourceData) bool {
+	return data.HasChange(versionKey) && len(data.Get(UpgradeStrategyKey).([]interface{})) > 0
+}
+
+// getUpgradeStrategy returns an upgrade strategy based on the default values and Terraform config values provided.
+func getUpgradeStrategy(data *schema.ResourceData) map[string]interface{} {
+	upgradeStrategy := map[string]interface{}{
+		NodePoolBatchSizeKey: NodePoolBatchSizeDefaultValue,
+	}
+
+	tfUpgradeStrategy := data.Get(UpgradeStrategyKey).([]interface{})
+
+	if len(tfUpgradeStrategy) > 0 && tfUpgradeStrategy[0] != nil {
+		for k, v := range tfUpgradeStrategy[0].(map[string]interface{}) {
+			upgradeStrategy[k] = v
+		}
+	}
+
+	return upgradeStrategy
+}
+
+// getExistingNodePools returns the node pools of the model which already exist in the cluster, these are the node pools being upgraded.
+func getExistingNodePools(data *schema.ResourceData, modelNodePools []*tkcnodepoolmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterNodepool) []*tkcnodepoolmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterNodepool {
+	oldTFValue, _ := data.GetChange(nodePoolResourceKey)
+	existingNodePools := make([]*tkcnodepoolmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterNodepool, 0)
+
+	for _, np := range modelNodePools {
+		for _, oldNodePool := range oldTFValue.([]interface{}) {
+			if oldNodePool.(map[string]interface{})[NameKey].(string) == np.FullName.Name {
+				existingNodePools = append(existingNodePools, np)
+
+				break
+			}
+		}
+	}
+
+	return existingNodePools
+}
+
+// deferNodePoolsUpgrade annotates the existing node pools so they are held on their current version while the control plane is upgraded.
+// When a node pool can't be annotated, the node pools already annotated are released so none of them is left held.
+func deferNodePoolsUpgrade(ctx context.Context, config authctx.TanzuContext, clusterFn *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterFullName, nodePools []*tkcnodepoolmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterNodepool) error {
+	for i, np := range nodePools {
+		if np.Spec.Metadata == nil {
+			np.Spec.Metadata = &tkccommonmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterCommonClusterMetadata{}
+		}
+
+		if np.Spec.Metadata.Annotations == nil {
+			np.Spec.Metadata.Annotations = make(map[string]string)
+		}
+
+		np.Spec.Metadata.Annotations[deferUpgradeAnnotation] = ""
+
+		if err := updateNodePool(config, clusterFn, np); err != nil {
+			delete(np.Spec.Metadata.Annotations, deferUpgradeAnnotation)
+			releaseDeferredNodePools(ctx, config, clusterFn, nodePools[:i])
+
+			return err
+		}
+
+		tflog.Debug(ctx, "Deferred node pool upgrade", map[string]interface{}{"node_pool": np.FullName.Name})
+	}
+
+	return nil
+}
+
+// releaseDeferredNodePools removes the defer-upgrade annotation from the node pools which still hold it, so no node pool is left held after a failed upgrade.
+// Failures are only logged as the error of the upgrade is reported.
+func releaseDeferredNodePools(ctx context.Context, config authctx.TanzuContext, clusterFn *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterFullName, nodePools []*tkcnodepoolmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterNodepool) {
+	for _, np := range nodePools {
+		if np.Spec.Metadata == nil {
+			continue
+		}
+
+		if _, ok := np.Spec.Metadata.Annotations[deferUpgradeAnnotation]; !ok {
+			continue
+		}
+
+		delete(np.Spec.Metadata.Annotations, deferUpgradeAnnotation)
+
+		if err := updateNodePool(config, clusterFn, np); err != nil {
+			tflog.Warn(ctx, "Couldn't release deferred node pool upgrade", map[string]interface{}{"node_pool": np.FullName.Name, "error": err.Error()})
+		}
+	}
+}
+
+// rolloutNodePoolsUpgrade waits for the control plane upgrade and then upgrades the deferred node pools in batches.
+// Each batch has to be ready and the cluster has to be healthy before the next batch is upgraded.
+func rolloutNodePoolsUpgrade(ctx context.Context, config *authctx.TanzuContext, data *schema.ResourceData, clusterFn *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterFullName, upgradeMeta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, nodePools []*tkcnodepoolmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterNodepool) error {
+	timeout := getTimeoutPolicy(data)[TimeoutKey].(int)
+	batchSize := getUpgradeStrategy(data)[NodePoolBatchSizeKey].(int)
+	version := data.Get(versionKey).(string)
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
+
+		defer cancel()
+	}
+
+	tflog.Info(ctx, "Waiting for the control plane to be upgraded", map[string]interface{}{"cluster": clusterFn.Name, "version": version})
+
+	if err := waitControlPlaneUpgraded(ctx, config, clusterFn, upgradeMeta); err != nil {
+		return errors.Wrap(err, "Control plane upgrade failed")
+	}
+
+	tflog.Info(ctx, "Control plane upgraded", map[string]interface{}{"cluster": clusterFn.Name, "version": version})
+
+	batchesCount := (len(nodePools) + batchSize - 1) / batchSize
+
+	for i := 0; i < len(nodePools); i += batchSize {
+		batch := nodePools[i:min(i+batchSize, len(nodePools))]
+		batchNames := make([]string, 0, len(batch))
+
+		for _, np := range batch {
+			batchNames = append(batchNames, np.FullName.Name)
+		}
+
+		batchFields := map[string]interface{}{
+			"cluster":    clusterFn.Name,
+			"node_pools": strings.Join(batchNames, ", "),
+			"batch":      fmt.Sprintf("%d/%d", i/batchSize+1, batchesCount),
+		}
+
+		tflog.Info(ctx, "Upgrading node pools", batchFields)
+
+		for _, np := range batch {
+			delete(np.Spec.Metadata.Annotations, deferUpgradeAnnotation)
+
+			if err := updateNodePool(*config, clusterFn, np); err != nil {
+				return err
+			}
+		}
+
+		// Sleep here is to let the node pools upgrade start before checking their status.
+		time.Sleep(30 * time.Second)
+
+		if err := waitNodePoolsBatchReady(ctx, config, clusterFn, batchNames); err != nil {
+			return errors.Wrapf(err, "Node pools upgrade failed, node pools: %s", strings.Join(batchNames, ", "))
+		}
+
+		if err := waitClusterReady(ctx, config, clusterFn); err != nil {
+			return errors.Wrapf(err, "Cluster is not healthy after upgrading node pools: %s", strings.Join(batchNames, ", "))
+		}
+
+		tflog.Info(ctx, "Node pools upgraded", batchFields)
+	}
+
+	return nil
+}
+
+// waitControlPlaneUpgraded waits for the Tanzu Kubernetes Cluster to be ready once it has observed the upgrade of its control plane.
+// The Tanzu Kubernetes Cluster itself is polled, the upgrade is observed once it reports the generation of the upgrade and either an upgrade phase or a change of its Ready condition after the upgrade,
+// so the status of the cluster before the upgrade isn't mistaken for its result.
+func waitControlPlaneUpgraded(ctx context.Context, config *authctx.TanzuContext, clusterFn *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterFullName, upgradeMeta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) error {
+	clusterPhase := tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhasePHASEUNSPECIFIED
+	upgradeObserved := false
+
+	for {
+		time.Sleep(5 * time.Second)
+
+		if err := ctx.Err(); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				err = errors.Wrapf(err, "Timeout exceeded while waiting for the control plane to be upgraded. Cluster Phase: %s, Upgrade Observed: %t", clusterPhase, upgradeObserved)
+			}
+
+			return err
+		}
+
+		clusterResp, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceGet(clusterFn)
+		if err != nil {
+			if clienterrors.IsUnauthorizedError(err) {
+				authctx.RefreshUserAuthContext(config, clienterrors.IsUnauthorizedError, err)
+
+				continue
+			}
+
+			return err
+		}
+
+		cluster := clusterResp.TanzuKubernetesCluster
+		upgradeObserved = upgradeObserved || isUpgradeObserved(cluster, upgradeMeta)
+
+		if !upgradeObserved || cluster.Status == nil || cluster.Status.Phase == nil {
+			continue
+		}
+
+		clusterPhase = *cluster.Status.Phase
+
+		switch clusterPhase {
+		case tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhaseREADY:
+			return nil
+		case tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhaseERROR:
+			return errors.Errorf("TKG Cluster errored.\nManagement Cluster Name: %s, Provisioner: %s, Cluster Name: %s",
+				clusterFn.ManagementClusterName, clusterFn.ProvisionerName, clusterFn.Name)
+		case tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhaseUPGRADEFAILED:
+			return errors.Errorf("TKG Cluster upgrade failed,\nManagement Cluster Name: %s, Provisioner: %s, Cluster Name: %s",
+				clusterFn.ManagementClusterName, clusterFn.ProvisionerName, clusterFn.Name)
+		}
+	}
+}
+
+// isUpgradeObserved checks whether the Tanzu Kubernetes Cluster reports the upgrade described by the meta of the update response.
+// The cluster has to report at least the generation of the upgrade, and either be upgrading or have its Ready condition changed after the upgrade was requested.
+func isUpgradeObserved(cluster *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterTanzuKubernetesCluster, upgradeMeta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) bool {
+	if cluster == nil || cluster.Status == nil {
+		return false
+	}
+
+	if upgradeMeta == nil {
+		return true
+	}
+
+	if !isGenerationReported(cluster.Meta, upgradeMeta.Generation) {
+		return false
+	}
+
+	if cluster.Status.Phase != nil && (*cluster.Status.Phase == tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhaseUPGRADING ||
+		*cluster.Status.Phase == tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhaseUPDATING) {
+		return true
+	}
+
+	readyCondition, ok := cluster.Status.Conditions[clusterReadyCondition]
+
+	return ok && time.Time(readyCondition.LastTransitionTime).After(time.Time(upgradeMeta.UpdateTime))
+}
+
+// isGenerationReported checks whether the cluster reports at least the generation, any generation is accepted when it isn't set.
+func isGenerationReported(meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, generation string) bool {
+	if generation == "" {
+		return true
+	}
+
+	if meta == nil {
+		return false
+	}
+
+	expected, err := strconv.ParseInt(generation, 10, 64)
+	if err != nil {
+		return meta.Generation == generation
+	}
+
+	reported, err := strconv.ParseInt(meta.Generation, 10, 64)
+
+	return err == nil && reported >= expected
+}
+
+// waitNodePoolsBatchReady waits for the node pools of a batch to be ready.
+func waitNodePoolsBatchReady(ctx context.Context, config *authctx.TanzuContext, clusterFn *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterFullName, nodePoolNames []string) error {
+	nodePoolsResp, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterNodePoolResourceServiceList(clusterFn)
+	if err != nil {
+		return err
+	}
+
+	nodePoolsToCheck := make([]*tkcnodepoolmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterNodepool, 0, len(nodePoolNames))
+
+	for _, np := range nodePoolsResp.Nodepools {
+		for _, name := range nodePoolNames {
+			if np.FullName.Name == name {
+				nodePoolsToCheck = append(nodePoolsToCheck, np)
+
+				break
+			}
+		}
+	}
+
+	return waitNodePoolsReady(ctx, config, clusterFn, nodePoolsToCheck)
+}
+
+func updateNodePool(config authctx.TanzuContext, clusterFn *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterFullName, np *tkcnodepoolmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterNodepool) error {
+	np.FullName.ManagementClusterName = clusterFn.ManagementClusterName
+	np.FullName.ProvisionerName = clusterFn.ProvisionerName
+	np.FullName.TanzuKubernetesClusterName = clusterFn.Name
+	nodePoolRequest := &tkcnodepoolmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterNodepoolData{
+		Nodepool: np,
+	}
+
+	_, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterNodePoolResourceServiceUpdate(nodePoolRequest)
+	if err != nil {
+		return errors.Wrapf(err, "Couldn't update TKG Cluster Nodepool.\nManagement Cluster Name: %s, Provisioner: %s, Cluster Name: %s, Node Pool Name: %s",
+			np.FullName.ManagementClusterName, np.FullName.ProvisionerName, np.FullName.TanzuKubernetesClusterName, np.FullName.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzukubernetescluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	clusterclassmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clusterclass"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+	tanzukubernetesclustermodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+)
+
+func testClusterClass(readyStatus statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatus) *clusterclassmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerClusterClass {
+	return &clusterclassmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerClusterClass{
+		FullName: &clusterclassmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerClusterClassFullName{
+			Name: "tanzukubernetescluster",
+		},
+		Status: &clusterclassmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerClusterClassStatus{
+			Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				clusterClassReadyCondition: {Status: &readyStatus, Message: "variables schema is invalid"},
+			},
+		},
+	}
+}
+
+func TestValidateUpgradePath(t *testing.T) {
+	cases := []struct {
+		name          string
+		clusterClass  *clusterclassmodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerClusterClass
+		current       string
+		target        string
+		expectedError string
+	}{
+		{
+			name:         "next minor version",
+			clusterClass: testClusterClass(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+			current:      "v1.27.3+vmware.1-tkg.1",
+			target:       "v1.28.8+vmware.1-tkg.1",
+		},
+		{
+			name:         "patch version",
+			clusterClass: testClusterClass(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+			current:      "v1.28.8+vmware.1-tkg.1",
+			target:       "v1.28.9+vmware.1-tkg.1",
+		},
+		{
+			name:          "cluster class not ready",
+			clusterClass:  testClusterClass(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE),
+			current:       "v1.27.3+vmware.1-tkg.1",
+			target:        "v1.28.8+vmware.1-tkg.1",
+			expectedError: "Cluster class tanzukubernetescluster is not ready: variables schema is invalid",
+		},
+		{
+			name:          "downgrade",
+			clusterClass:  testClusterClass(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+			current:       "v1.28.8+vmware.1-tkg.1",
+			target:        "v1.27.3+vmware.1-tkg.1",
+			expectedError: "one minor version at a time",
+		},
+		{
+			name:          "skipping a minor version",
+			clusterClass:  testClusterClass(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+			current:       "v1.26.5+vmware.2-tkg.1",
+			target:        "v1.28.8+vmware.1-tkg.1",
+			expectedError: "one minor version at a time",
+		},
+		{
+			name:    "cluster class without status",
+			current: "v1.27.3+vmware.1-tkg.1",
+			target:  "v1.28.8+vmware.1-tkg.1",
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateUpgradePath(test.clusterClass, test.current, test.target)
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestIsGenerationReported(t *testing.T) {
+	cases := []struct {
+		name       string
+		meta       *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+		generation string
+		expected   bool
+	}{
+		{
+			name:     "no generation to wait for",
+			expected: true,
+		},
+		{
+			name:       "generation not reported yet",
+			meta:       &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "4"},
+			generation: "5",
+			expected:   false,
+		},
+		{
+			name:       "generation reported",
+			meta:       &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "5"},
+			generation: "5",
+			expected:   true,
+		},
+		{
+			name:       "newer generation reported",
+			meta:       &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "10"},
+			generation: "9",
+			expected:   true,
+		},
+		{
+			name:       "no meta",
+			generation: "5",
+			expected:   false,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, isGenerationReported(test.meta, test.generation))
+		})
+	}
+}
+
+func TestIsUpgradeObserved(t *testing.T) {
+	updateTime := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	upgradeMeta := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: "5", UpdateTime: strfmt.DateTime(updateTime)}
+
+	testCluster := func(generation string, phase tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhase, readyTransition time.Time) *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterTanzuKubernetesCluster {
+		return &tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterTanzuKubernetesCluster{
+			Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Generation: generation},
+			Status: &tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatus{
+				Phase: phase.Pointer(),
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					clusterReadyCondition: {LastTransitionTime: strfmt.DateTime(readyTransition)},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		name        string
+		cluster     *tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterTanzuKubernetesCluster
+		upgradeMeta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+		expected    bool
+	}{
+		{
+			name:        "generation of the upgrade not reported",
+			cluster:     testCluster("4", tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhaseUPGRADING, updateTime.Add(time.Minute)),
+			upgradeMeta: upgradeMeta,
+			expected:    false,
+		},
+		{
+			name:        "ready before the upgrade",
+			cluster:     testCluster("5", tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhaseREADY, updateTime.Add(-time.Hour)),
+			upgradeMeta: upgradeMeta,
+			expected:    false,
+		},
+		{
+			name:        "upgrading",
+			cluster:     testCluster("5", tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhaseUPGRADING, updateTime.Add(-time.Hour)),
+			upgradeMeta: upgradeMeta,
+			expected:    true,
+		},
+		{
+			name:        "ready after the upgrade",
+			cluster:     testCluster("6", tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhaseREADY, updateTime.Add(time.Minute)),
+			upgradeMeta: upgradeMeta,
+			expected:    true,
+		},
+		{
+			name:     "no update response",
+			cluster:  testCluster("5", tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1CommonClusterStatusPhaseREADY, updateTime.Add(-time.Hour)),
+			expected: true,
+		},
+		{
+			name:        "no cluster status",
+			cluster:     &tanzukubernetesclustermodels.VmwareTanzuManageV1alpha1ManagementClusterProvisionerTanzukubernetesClusterTanzuKubernetesCluster{},
+			upgradeMeta: upgradeMeta,
+			expected:    false,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, isUpgradeObserved(test.cluster, test.upgradeMeta))
+		})
+	}
+}
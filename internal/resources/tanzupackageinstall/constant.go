@@ -7,7 +7,10 @@ package tanzupackageinstall
 const (
 	ResourceName = "tanzu-mission-control_package_install"
 
-	nameKey        = "name"
-	NamespaceKey   = "namespace"
-	DataSourceRead = "dataSourceRead"
+	nameKey              = "name"
+	NamespaceKey         = "namespace"
+	resolvedVersionKey   = "resolved_version"
+	availableUpgradesKey = "available_upgrades"
+	effectiveValuesKey   = "effective_values"
+	DataSourceRead       = "dataSourceRead"
 )
@@ -15,7 +15,9 @@ import (
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	pkginstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall/clustergroup"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackageinstall/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackageinstall/spec"
@@ -56,7 +58,7 @@ func dataPackageInstallRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("Unable to create Tanzu Mission Control package install entry; Scope full name is empty")
 	}
 
-	UID, meta, repoSpec, clusterScopeStatus, err := retrievePackageInstallUIDMetaAndSpecFromServer(config, scopedFullnameData, d)
+	pkgInstallDataFromServer, err := retrievePackageInstallUIDMetaAndSpecFromServer(config, scopedFullnameData, d)
 	if err != nil {
 		if clienterrors.IsNotFoundError(err) {
 			if ctx.Value(contextMethodKey{}) == DataSourceRead {
@@ -71,22 +73,47 @@ func dataPackageInstallRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control package install entry, name : %s", packageInstallName))
 	}
 
-	d.SetId(UID)
+	d.SetId(pkgInstallDataFromServer.UID)
 
-	if err := d.Set(common.MetaKey, common.FlattenMeta(meta)); err != nil {
+	if err := d.Set(common.MetaKey, common.FlattenMeta(pkgInstallDataFromServer.meta)); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set(status.StatusKey, status.FlattenStatusForClusterScope(clusterScopeStatus)); err != nil {
+	var (
+		flattenedStatus    interface{}
+		pathToInlineValues string
+	)
+
+	// default path
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		flattenedStatus = status.FlattenStatusForClusterScope(pkgInstallDataFromServer.clusterScopeStatus)
+		pathToInlineValues = scopedFullnameData.FullnameCluster.ManagementClusterName + "/" +
+			scopedFullnameData.FullnameCluster.ProvisionerName + "/" +
+			scopedFullnameData.FullnameCluster.ClusterName + "/" +
+			scopedFullnameData.FullnameCluster.NamespaceName + "/" +
+			scopedFullnameData.FullnameCluster.Name + ".yaml"
+	case commonscope.ClusterGroupScope:
+		flattenedStatus = status.FlattenStatusForClusterGroupScope(pkgInstallDataFromServer.clusterGroupScopeStatus)
+		pathToInlineValues = scopedFullnameData.FullnameClusterGroup.ClusterGroupName + "/" +
+			scopedFullnameData.FullnameClusterGroup.NamespaceName + "/" +
+			scopedFullnameData.FullnameClusterGroup.Name + ".yaml"
+	}
+
+	if err := d.Set(status.StatusKey, flattenedStatus); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// default path
-	pathToInlineValues := scopedFullnameData.FullnameCluster.ManagementClusterName + "/" +
-		scopedFullnameData.FullnameCluster.ProvisionerName + "/" +
-		scopedFullnameData.FullnameCluster.ClusterName + "/" +
-		scopedFullnameData.FullnameCluster.NamespaceName + "/" +
-		scopedFullnameData.FullnameCluster.Name + ".yaml"
+	if scopedFullnameData.Scope == commonscope.ClusterScope {
+		searchScope := newPackageSearchScope(scopedFullnameData.FullnameCluster, pkgInstallDataFromServer)
+
+		diags = append(diags, setPackageVersions(config, d, searchScope, pkgInstallDataFromServer)...)
+		diags = append(diags, setEffectiveValues(config, d, searchScope, pkgInstallDataFromServer)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
 
 	existingSpec, ok := d.GetOk(policy.SpecKey)
 	if !ok {
@@ -115,7 +142,16 @@ func dataPackageInstallRead(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 
-	specValue, err := spec.FlattenSpecForClusterScope(repoSpec, pathToInlineValues)
+	var specValue []interface{}
+
+	if scopedFullnameData.Scope == commonscope.ClusterGroupScope {
+		specValue, err = spec.FlattenSpecForClusterGroupScope(&pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec{
+			AtomicSpec: pkgInstallDataFromServer.atomicSpec,
+		}, pathToInlineValues)
+	} else {
+		specValue, err = spec.FlattenSpecForClusterScope(pkgInstallDataFromServer.atomicSpec, pathToInlineValues)
+	}
+
 	if err != nil {
 		return diag.FromErr(errors.Wrapf(err, "Unable to write inline values to the file: %s", pathToInlineValues))
 	}
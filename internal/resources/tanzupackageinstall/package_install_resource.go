@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -21,13 +22,24 @@ import (
 	tanzupackage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/package/cluster"
 	tanzupakageclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackage"
 	pkginstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall"
+	pkginstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall/clustergroup"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/readiness"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packageversions"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackageinstall/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackageinstall/spec"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackageinstall/status"
 )
 
+type dataFromServer struct {
+	UID                     string
+	meta                    *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	atomicSpec              *pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec
+	clusterScopeStatus      *pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus
+	clusterGroupScopeStatus *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus
+}
+
 type contextMethodKey struct{}
 
 func ResourcePackageInstall() *schema.Resource {
@@ -38,8 +50,10 @@ func ResourcePackageInstall() *schema.Resource {
 		ReadContext:   dataPackageInstallRead,
 		Schema:        getResourceSchema(),
 		CustomizeDiff: customdiff.All(
-			schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey})),
+			schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
 			schema.CustomizeDiffFunc(spec.ValidateInlineValues()),
+			resolvePackageVersion,
+			validatePackageValues,
 		),
 	}
 }
@@ -69,6 +83,23 @@ func getSecretSchema(isDataSource bool) map[string]*schema.Schema {
 		commonscope.ScopeKey: scope.ScopeSchema,
 		common.MetaKey:       common.Meta,
 		status.StatusKey:     status.StatusSchema,
+		resolvedVersionKey: {
+			Type:        schema.TypeString,
+			Description: "Version of the package resolved from the version selection constraints. For cluster scope, it is known at plan time when the package is available in the package repositories of the cluster.",
+			Computed:    true,
+		},
+		availableUpgradesKey: {
+			Type:        schema.TypeList,
+			Description: "Versions of the package newer than the resolved version which satisfy the version selection constraints. Only available for cluster scope.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		effectiveValuesKey: {
+			Type:        schema.TypeString,
+			Description: "Inline values with the defaults of the values schema of the resolved version of the package, in JSON format. Only available for cluster scope.",
+			Computed:    true,
+			Sensitive:   true,
+		},
 	}
 
 	innerMap := map[string]*schema.Schema{
@@ -83,6 +114,10 @@ func getSecretSchema(isDataSource bool) map[string]*schema.Schema {
 		}
 	}
 
+	if !isDataSource {
+		packageInstallSchema[readiness.WaitForReadyKey] = readiness.WaitForReadySchema
+	}
+
 	return packageInstallSchema
 }
 
@@ -105,29 +140,64 @@ func resourcePackageInstallCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Unable to create Tanzu Mission Control package install entry; Scope full name is empty")
 	}
 
-	specVal, err := spec.ConstructSpecForClusterScope(d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	var UID string
 
-	packageInstallReq := &pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest{
-		Install: &pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
-			FullName: scopedFullnameData.FullnameCluster,
-			Meta:     common.ConstructMeta(d),
-			Spec:     specVal,
-		},
-	}
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			specVal, err := spec.ConstructSpecForClusterScope(d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
 
-	packageInstallResponse, err := config.TMCConnection.PackageInstallResourceService.InstallResourceServiceCreate(packageInstallReq)
-	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster package install entry, name : %s", packageInstallName))
-	}
+			packageInstallReq := &pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest{
+				Install: &pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     common.ConstructMeta(d),
+					Spec:     specVal,
+				},
+			}
 
-	UID := packageInstallResponse.Install.Meta.UID
+			packageInstallResponse, err := config.TMCConnection.PackageInstallResourceService.InstallResourceServiceCreate(packageInstallReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster package install entry, name : %s", packageInstallName))
+			}
+
+			UID = packageInstallResponse.Install.Meta.UID
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			specVal, err := spec.ConstructSpecForClusterGroupScope(d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			packageInstallReq := &pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest{
+				Install: &pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     common.ConstructMeta(d),
+					Spec:     specVal,
+				},
+			}
+
+			packageInstallResponse, err := config.TMCConnection.ClusterGroupPackageInstallResourceService.InstallResourceServiceCreate(packageInstallReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group package install entry, name : %s", packageInstallName))
+			}
+
+			UID = packageInstallResponse.Install.Meta.UID
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
 
 	// always run
 	d.SetId(UID)
 
+	if err := waitForReady(config, d, scopedFullnameData); err != nil {
+		return append(dataPackageInstallRead(ctx, d, m), diag.FromErr(err)...)
+	}
+
 	return dataPackageInstallRead(ctx, d, m)
 }
 
@@ -150,9 +220,23 @@ func resourcePackageInstallDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Unable to create Tanzu Mission Control package install entry; Scope full name is empty")
 	}
 
-	err := config.TMCConnection.PackageInstallResourceService.InstallResourceServiceDelete(scopedFullnameData.FullnameCluster)
-	if err != nil && !clienterrors.IsNotFoundError(err) {
-		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster package install entry, name : %s", packageInstallName))
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			err := config.TMCConnection.PackageInstallResourceService.InstallResourceServiceDelete(scopedFullnameData.FullnameCluster)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster package install entry, name : %s", packageInstallName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			err := config.TMCConnection.ClusterGroupPackageInstallResourceService.InstallResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group package install entry, name : %s", packageInstallName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
@@ -181,27 +265,30 @@ func resourcePackageInstallInPlaceUpdate(ctx context.Context, d *schema.Resource
 		return diag.Errorf("Unable to create Tanzu Mission Control package install entry; Scope full name is empty")
 	}
 
-	_, meta, installSpec, _, err := retrievePackageInstallUIDMetaAndSpecFromServer(config, scopedFullnameData, d)
+	pkgInstallDataFromServer, err := retrievePackageInstallUIDMetaAndSpecFromServer(config, scopedFullnameData, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	var updateAvailable bool
 
-	if updateCheckForMeta(d, meta) {
+	if updateCheckForMeta(d, pkgInstallDataFromServer.meta) {
 		updateAvailable = true
 	}
 
-	specCheck, err := updateCheckForSpec(d, installSpec)
+	specCheck, err := updateCheckForSpec(d, pkgInstallDataFromServer.atomicSpec)
 	if err != nil {
 		log.Println("[ERROR] Unable to check spec has been updated.")
 		return diag.FromErr(err)
 	}
 
 	if specCheck {
-		err = CheckForUpdatedPackage(config, scopedFullnameData, installSpec)
-		if err != nil {
-			return diag.FromErr(err)
+		// The package metadata is only available on a cluster, the members of a cluster group are verified by TMC.
+		if scopedFullnameData.Scope == commonscope.ClusterScope {
+			err = CheckForUpdatedPackage(config, scopedFullnameData, pkgInstallDataFromServer.atomicSpec)
+			if err != nil {
+				return diag.FromErr(err)
+			}
 		}
 
 		updateAvailable = true
@@ -212,70 +299,108 @@ func resourcePackageInstallInPlaceUpdate(ctx context.Context, d *schema.Resource
 		return diags
 	}
 
-	pkgInstallReq := &pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest{
-		Install: &pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
-			FullName: scopedFullnameData.FullnameCluster,
-			Meta:     meta,
-			Spec:     installSpec,
-		},
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		pkgInstallReq := &pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest{
+			Install: &pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
+				FullName: scopedFullnameData.FullnameCluster,
+				Meta:     pkgInstallDataFromServer.meta,
+				Spec:     pkgInstallDataFromServer.atomicSpec,
+			},
+		}
+
+		_, err = config.TMCConnection.PackageInstallResourceService.InstallResourceServiceUpdate(pkgInstallReq)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster package install entry, name : %s", packageInstallName))
+		}
+	case commonscope.ClusterGroupScope:
+		pkgInstallReq := &pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest{
+			Install: &pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall{
+				FullName: scopedFullnameData.FullnameClusterGroup,
+				Meta:     pkgInstallDataFromServer.meta,
+				Spec: &pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec{
+					AtomicSpec: pkgInstallDataFromServer.atomicSpec,
+				},
+			},
+		}
+
+		_, err = config.TMCConnection.ClusterGroupPackageInstallResourceService.InstallResourceServiceUpdate(pkgInstallReq)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group package install entry, name : %s", packageInstallName))
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
 
-	_, err = config.TMCConnection.PackageInstallResourceService.InstallResourceServiceUpdate(pkgInstallReq)
-	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster package install entry, name : %s", packageInstallName))
+	if err := waitForReady(config, d, scopedFullnameData); err != nil {
+		return append(dataPackageInstallRead(ctx, d, m), diag.FromErr(err)...)
 	}
 
 	return dataPackageInstallRead(ctx, d, m)
 }
 
-func retrievePackageInstallUIDMetaAndSpecFromServer(config authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, d *schema.ResourceData) (
-	string,
-	*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta,
-	*pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec,
-	*pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus,
-	error) {
-	var (
-		UID                string
-		meta               *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
-		spec               *pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec
-		clusterScopeStatus *pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus
-	)
-
-	resp, err := config.TMCConnection.PackageInstallResourceService.InstallResourceServiceGet(scopedFullnameData.FullnameCluster)
-	if err != nil {
-		if clienterrors.IsNotFoundError(err) {
-			d.SetId("")
-			return "", nil, nil, nil, err
+func retrievePackageInstallUIDMetaAndSpecFromServer(config authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, d *schema.ResourceData) (*dataFromServer, error) {
+	var pkgInstallDataFromServer = &dataFromServer{}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			resp, err := config.TMCConnection.PackageInstallResourceService.InstallResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return pkgInstallDataFromServer, err
+				}
+
+				return pkgInstallDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster package install entry, name : %s", scopedFullnameData.FullnameCluster.Name)
+			}
+
+			scopedFullnameData.FullnameCluster = resp.Install.FullName
+			pkgInstallDataFromServer.UID = resp.Install.Meta.UID
+			pkgInstallDataFromServer.meta = resp.Install.Meta
+			pkgInstallDataFromServer.atomicSpec = resp.Install.Spec
+			pkgInstallDataFromServer.clusterScopeStatus = resp.Install.Status
 		}
-
-		return "", nil, nil, nil, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster package install entry, name : %s", scopedFullnameData.FullnameCluster.Name)
-	}
-
-	scopedFullnameData = &scope.ScopedFullname{
-		Scope:           commonscope.ClusterScope,
-		FullnameCluster: resp.Install.FullName,
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			resp, err := config.TMCConnection.ClusterGroupPackageInstallResourceService.InstallResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return pkgInstallDataFromServer, err
+				}
+
+				return pkgInstallDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster group package install entry, name : %s", scopedFullnameData.FullnameClusterGroup.Name)
+			}
+
+			scopedFullnameData.FullnameClusterGroup = resp.Install.FullName
+			pkgInstallDataFromServer.UID = resp.Install.Meta.UID
+			pkgInstallDataFromServer.meta = resp.Install.Meta
+			pkgInstallDataFromServer.clusterGroupScopeStatus = resp.Install.Status
+
+			if resp.Install.Spec != nil {
+				pkgInstallDataFromServer.atomicSpec = resp.Install.Spec.AtomicSpec
+			}
+		}
+	case commonscope.UnknownScope:
+		return pkgInstallDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
 
 	fullName, name, namespace := scope.FlattenScope(scopedFullnameData)
 
 	if err := d.Set(nameKey, name); err != nil {
-		return "", nil, nil, nil, err
+		return pkgInstallDataFromServer, err
 	}
 
 	if err := d.Set(commonscope.ScopeKey, fullName); err != nil {
-		return "", nil, nil, nil, err
+		return pkgInstallDataFromServer, err
 	}
 
 	if err := d.Set(NamespaceKey, namespace); err != nil {
-		return "", nil, nil, nil, err
+		return pkgInstallDataFromServer, err
 	}
 
-	UID = resp.Install.Meta.UID
-	meta = resp.Install.Meta
-	spec = resp.Install.Spec
-	clusterScopeStatus = resp.Install.Status
-
-	return UID, meta, spec, clusterScopeStatus, nil
+	return pkgInstallDataFromServer, nil
 }
 
 func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) bool {
@@ -335,6 +460,30 @@ func GetGlobalNamespace(config authctx.TanzuContext, searchscope *tanzupakageclu
 }
 
 func CheckForUpdatedPackage(config authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, spec *pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec) error {
+	// A version range is checked against the versions of the package, only an exact version can be fetched.
+	if !packageversions.IsExactVersion(spec.PackageRef.VersionSelection.Constraints) {
+		versions, err := packageversions.ListVersions(config, &tanzupackage.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope{
+			ClusterName:           scopedFullnameData.FullnameCluster.ClusterName,
+			ManagementClusterName: scopedFullnameData.FullnameCluster.ManagementClusterName,
+			ProvisionerName:       scopedFullnameData.FullnameCluster.ProvisionerName,
+			MetadataName:          spec.PackageRef.PackageMetadataName,
+		})
+		if err != nil {
+			return err
+		}
+
+		resolvedVersion, err := packageversions.ResolveVersion(spec.PackageRef.VersionSelection.Constraints, versions)
+		if err != nil {
+			return err
+		}
+
+		if resolvedVersion == "" {
+			return fmt.Errorf("no version of package %s satisfies the constraints: %s", spec.PackageRef.PackageMetadataName, spec.PackageRef.VersionSelection.Constraints)
+		}
+
+		return nil
+	}
+
 	globalNs, err := GetGlobalNamespace(config, &tanzupakageclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageSearchScope{
 		ClusterName:           scopedFullnameData.FullnameCluster.ClusterName,
 		ManagementClusterName: scopedFullnameData.FullnameCluster.ManagementClusterName,
@@ -247,6 +247,19 @@ func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
 	httpmock.RegisterResponder("GET", getTanzuPkgMetadataEndpoint2,
 		bodyInspectingResponder(t, nil, 200, getTanzuPkgMetadataResponse(testConfig.PkgName2)))
 
+	listTanzuPkgMetadataResponse := &pakageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageListPackagesResponse{
+		Packages: []*pakageclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackagePackage{
+			getTanzuPkgMetadataResponse(testConfig.PkgName1).Package,
+			getTanzuPkgMetadataResponse(testConfig.PkgName2).Package,
+		},
+		TotalCount: "2",
+	}
+
+	listTanzuPkgMetadataEndpoint := (helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ScopeHelperResources.Cluster.Name, apiSubGroup, globalRepoNamespace, apiKindMetadata, pkgMetadataName, "packages")).String()
+
+	httpmock.RegisterResponder("GET", listTanzuPkgMetadataEndpoint,
+		bodyInspectingResponder(t, nil, 200, listTanzuPkgMetadataResponse))
+
 	// cluster level package resource.
 
 	getTanzuPackageResponse := &tanzupakageclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageListTanzuPackagesResponse{
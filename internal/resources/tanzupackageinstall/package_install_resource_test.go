@@ -252,7 +252,10 @@ func (testConfig *testAcceptanceConfig) checkPackageInstallResourceAttributes(sc
 
 	switch scopeType {
 	case commonscope.ClusterScope:
-		check = append(check, resource.TestCheckResourceAttr(testConfig.PkgInstallResourceName, "scope.0.cluster.0.name", testConfig.ScopeHelperResources.Cluster.Name))
+		check = append(check,
+			resource.TestCheckResourceAttr(testConfig.PkgInstallResourceName, "scope.0.cluster.0.name", testConfig.ScopeHelperResources.Cluster.Name),
+			resource.TestCheckResourceAttrSet(testConfig.PkgInstallResourceName, resolvedVersionKey),
+		)
 	case commonscope.UnknownScope:
 		log.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(packageinstallscope.ScopesAllowed[:], `, `))
 	}
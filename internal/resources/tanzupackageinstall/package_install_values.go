@@ -0,0 +1,135 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzupackageinstall
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	tanzupackage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/package/cluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packageversions"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackageinstall/spec"
+)
+
+var (
+	inlineValuesPath       = helper.GetFirstElementOf(spec.SpecKey, spec.InlineValuesKey)
+	pathToInlineValuesPath = helper.GetFirstElementOf(spec.SpecKey, spec.PathToInlineValuesKey)
+)
+
+// validatePackageValues validates the inline values against the values schema of the resolved version of the package and plans the effective values.
+// The values schema is only available on a cluster, so the inline values of a cluster group package install are validated by TMC.
+func validatePackageValues(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() != "" && !diff.HasChange(constraintsPath) && !diff.HasChange(metadataNamePath) &&
+		!diff.HasChange(inlineValuesPath) && !diff.HasChange(pathToInlineValuesPath) {
+		return nil
+	}
+
+	resolvedVersion, _ := diff.Get(resolvedVersionKey).(string)
+	clusterFullname := constructClusterFullname(diff)
+	config, ok := m.(authctx.TanzuContext)
+
+	if !diff.NewValueKnown(resolvedVersionKey) || !diff.NewValueKnown(spec.SpecKey) || resolvedVersion == "" ||
+		clusterFullname == nil || !ok || config.TMCConnection == nil {
+		return diff.SetNewComputed(effectiveValuesKey)
+	}
+
+	specData, _ := diff.Get(spec.SpecKey).([]interface{})
+
+	if len(specData) == 0 || specData[0] == nil {
+		return diff.SetNewComputed(effectiveValuesKey)
+	}
+
+	metadataName, _ := diff.Get(metadataNamePath).(string)
+
+	// The inline values file may be created by the same apply.
+	values, err := spec.ConstructInlineValues(specData[0].(map[string]interface{}))
+	if err != nil {
+		log.Printf("[WARN] unable to read the inline values of package %s at plan time: %v", metadataName, err)
+		return diff.SetNewComputed(effectiveValuesKey)
+	}
+
+	valuesSchema, err := packageversions.GetValuesSchema(config, &tanzupackage.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope{
+		ClusterName:           clusterFullname.ClusterName,
+		ManagementClusterName: clusterFullname.ManagementClusterName,
+		ProvisionerName:       clusterFullname.ProvisionerName,
+		MetadataName:          metadataName,
+	}, resolvedVersion)
+	if err != nil {
+		log.Printf("[WARN] unable to validate the inline values of package %s at plan time: %v", metadataName, err)
+		return diff.SetNewComputed(effectiveValuesKey)
+	}
+
+	effectiveValues, err := spec.ValidateValues(valuesSchema, values)
+	if err != nil {
+		return err
+	}
+
+	effectiveValuesJSON, err := marshalEffectiveValues(effectiveValues)
+	if err != nil {
+		return err
+	}
+
+	return diff.SetNew(effectiveValuesKey, effectiveValuesJSON)
+}
+
+// setEffectiveValues sets the inline values of a cluster package install with the defaults of the values schema of its resolved version.
+// Failing to get the values schema doesn't fail the read, a warning is returned instead.
+func setEffectiveValues(config authctx.TanzuContext, d *schema.ResourceData, searchScope *tanzupackage.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope, data *dataFromServer) (diags diag.Diagnostics) {
+	resolvedVersion, _ := d.Get(resolvedVersionKey).(string)
+
+	if resolvedVersion == "" || searchScope.MetadataName == "" {
+		return diags
+	}
+
+	valuesSchema, err := packageversions.GetValuesSchema(config, searchScope, resolvedVersion)
+	if err != nil {
+		return append(diags, effectiveValuesWarning(err))
+	}
+
+	values, _ := data.atomicSpec.InlineValues.(map[string]interface{})
+
+	effectiveValues, err := spec.ValidateValues(valuesSchema, values)
+	if err != nil {
+		return append(diags, effectiveValuesWarning(err))
+	}
+
+	effectiveValuesJSON, err := marshalEffectiveValues(effectiveValues)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(effectiveValuesKey, effectiveValuesJSON); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func marshalEffectiveValues(effectiveValues map[string]interface{}) (string, error) {
+	if effectiveValues == nil {
+		effectiveValues = map[string]interface{}{}
+	}
+
+	effectiveValuesJSON, err := json.Marshal(effectiveValues)
+	if err != nil {
+		return "", err
+	}
+
+	return string(effectiveValuesJSON), nil
+}
+
+func effectiveValuesWarning(err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Unable to compute the effective values of the package install",
+		Detail:   err.Error(),
+	}
+}
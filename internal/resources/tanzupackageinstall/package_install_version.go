@@ -0,0 +1,160 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzupackageinstall
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	tanzupackage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/package/cluster"
+	pkginstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packageversions"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackageinstall/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackageinstall/spec"
+)
+
+var (
+	constraintsPath  = helper.GetFirstElementOf(spec.SpecKey, spec.PackageRefKey, spec.VersionSelectionKey, spec.ConstraintsKey)
+	metadataNamePath = helper.GetFirstElementOf(spec.SpecKey, spec.PackageRefKey, spec.PackageMetadataNameKey)
+)
+
+// resolvePackageVersion plans the version the version selection constraints resolve to.
+// The package metadata is only available on a cluster, so the version stays unknown until apply for a cluster group.
+func resolvePackageVersion(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() != "" && !diff.HasChange(constraintsPath) && !diff.HasChange(metadataNamePath) {
+		return nil
+	}
+
+	if !diff.NewValueKnown(constraintsPath) || !diff.NewValueKnown(metadataNamePath) || !diff.NewValueKnown(commonscope.ScopeKey) {
+		return setPackageVersionsComputed(diff)
+	}
+
+	constraints, _ := diff.Get(constraintsPath).(string)
+
+	if _, err := helper.ParseConstraints(constraints); err != nil {
+		return err
+	}
+
+	clusterFullname := constructClusterFullname(diff)
+	config, ok := m.(authctx.TanzuContext)
+
+	if clusterFullname == nil || !ok || config.TMCConnection == nil {
+		return setPackageVersionsComputed(diff)
+	}
+
+	metadataName, _ := diff.Get(metadataNamePath).(string)
+
+	versions, err := packageversions.ListVersions(config, &tanzupackage.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope{
+		ClusterName:           clusterFullname.ClusterName,
+		ManagementClusterName: clusterFullname.ManagementClusterName,
+		ProvisionerName:       clusterFullname.ProvisionerName,
+		MetadataName:          metadataName,
+	})
+	if err != nil {
+		log.Printf("[WARN] unable to resolve the version of package %s at plan time: %v", metadataName, err)
+		return setPackageVersionsComputed(diff)
+	}
+
+	resolvedVersion, err := packageversions.ResolveVersion(constraints, versions)
+	if err != nil {
+		return err
+	}
+
+	// The package may become available later, e.g. when its package repository is created by the same apply.
+	if resolvedVersion == "" {
+		log.Printf("[WARN] no version of package %s available at plan time satisfies the constraints: %s", metadataName, constraints)
+		return setPackageVersionsComputed(diff)
+	}
+
+	if err := diff.SetNew(resolvedVersionKey, resolvedVersion); err != nil {
+		return err
+	}
+
+	return diff.SetNewComputed(availableUpgradesKey)
+}
+
+func setPackageVersionsComputed(diff *schema.ResourceDiff) error {
+	if err := diff.SetNewComputed(resolvedVersionKey); err != nil {
+		return err
+	}
+
+	return diff.SetNewComputed(availableUpgradesKey)
+}
+
+func constructClusterFullname(diff *schema.ResourceDiff) *pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName {
+	data, _ := diff.Get(commonscope.ScopeKey).([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	scopeData, _ := data[0].(map[string]interface{})
+	clusterValue, _ := scopeData[commonscope.ClusterKey].([]interface{})
+
+	return scope.ConstructClusterPackageInstallFullname(clusterValue, "", "")
+}
+
+// newPackageSearchScope returns the search scope of the package of a cluster package install.
+func newPackageSearchScope(fullname *pkginstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName, data *dataFromServer) *tanzupackage.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope {
+	searchScope := &tanzupackage.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope{
+		ClusterName:           fullname.ClusterName,
+		ManagementClusterName: fullname.ManagementClusterName,
+		ProvisionerName:       fullname.ProvisionerName,
+	}
+
+	if data.atomicSpec != nil && data.atomicSpec.PackageRef != nil {
+		searchScope.MetadataName = data.atomicSpec.PackageRef.PackageMetadataName
+	}
+
+	return searchScope
+}
+
+// setPackageVersions sets the resolved version of a cluster package install and the newer versions which satisfy its constraints.
+// Failing to list the versions of the package doesn't fail the read, a warning is returned instead.
+func setPackageVersions(config authctx.TanzuContext, d *schema.ResourceData, searchScope *tanzupackage.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSearchScope, data *dataFromServer) (diags diag.Diagnostics) {
+	resolvedVersion, _ := d.Get(resolvedVersionKey).(string)
+
+	if data.clusterScopeStatus != nil && data.clusterScopeStatus.ResolvedVersion != "" {
+		resolvedVersion = data.clusterScopeStatus.ResolvedVersion
+	}
+
+	if err := d.Set(resolvedVersionKey, resolvedVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resolvedVersion == "" || searchScope.MetadataName == "" || data.atomicSpec.PackageRef.VersionSelection == nil {
+		return diags
+	}
+
+	versions, err := packageversions.ListVersions(config, searchScope)
+	if err != nil {
+		return append(diags, availableUpgradesWarning(err))
+	}
+
+	upgrades, err := packageversions.AvailableUpgrades(data.atomicSpec.PackageRef.VersionSelection.Constraints, resolvedVersion, versions)
+	if err != nil {
+		return append(diags, availableUpgradesWarning(err))
+	}
+
+	if err := d.Set(availableUpgradesKey, upgrades); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func availableUpgradesWarning(err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Unable to list the available upgrades of the package install",
+		Detail:   err.Error(),
+	}
+}
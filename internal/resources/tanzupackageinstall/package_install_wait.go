@@ -0,0 +1,54 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package tanzupackageinstall
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/readiness"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackageinstall/scope"
+)
+
+// waitForReady waits until the package install is reconciled by kapp-controller when wait_for_ready is set.
+func waitForReady(config authctx.TanzuContext, d *schema.ResourceData, scopedFullnameData *scope.ScopedFullname) error {
+	return readiness.WaitForReady(d, scopedFullnameData.Scope, fmt.Sprintf("package install %s", d.Get(nameKey)), &readiness.StatusFuncs{
+		Cluster: func() (*readiness.ClusterStatus, error) {
+			resp, err := config.TMCConnection.PackageInstallResourceService.InstallResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.Install.Status == nil {
+				return nil, nil
+			}
+
+			return &readiness.ClusterStatus{
+				Meta:               resp.Install.Meta,
+				ObservedGeneration: resp.Install.Status.ObservedGeneration,
+				State:              readiness.ConditionState(resp.Install.Status.Conditions),
+			}, nil
+		},
+		ClusterGroup: func() (*readiness.BatchStatus, error) {
+			resp, err := config.TMCConnection.ClusterGroupPackageInstallResourceService.InstallResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.Install.Status == nil {
+				return nil, nil
+			}
+
+			return &readiness.BatchStatus{
+				Meta:               resp.Install.Meta,
+				ObservedGeneration: resp.Install.Status.ObservedGeneration,
+				Phase:              resp.Install.Status.Phase,
+				Details:            resp.Install.Status.Details,
+			}, nil
+		},
+	})
+}
@@ -0,0 +1,42 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package scope
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packageinstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func ConstructClusterGroupPackageInstallFullname(data []interface{}, name, namespace string) (fullname *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) {
+	if len(data) == 0 || data[0] == nil {
+		return fullname
+	}
+
+	fullNameData, _ := data[0].(map[string]interface{})
+
+	fullname = &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName{}
+
+	if nameValue, ok := fullNameData[commonscope.NameKey]; ok {
+		helper.SetPrimitiveValue(nameValue, &fullname.ClusterGroupName, commonscope.NameKey)
+	}
+
+	fullname.Name = name
+	fullname.NamespaceName = namespace
+
+	return fullname
+}
+
+func FlattenClusterGroupPackageInstallFullname(fullname *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) (data []interface{}) {
+	if fullname == nil {
+		return data
+	}
+
+	flattenFullname := make(map[string]interface{})
+
+	flattenFullname[commonscope.NameKey] = fullname.ClusterGroupName
+
+	return []interface{}{flattenFullname}
+}
@@ -0,0 +1,51 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	packageinstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func TestFlattenClusterGroupPackageInstallFullname(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster group package install full name",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster group package install full name",
+			input: &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName{
+				ClusterGroupName: "cg",
+				Name:             "n",
+				NamespaceName:    "nn",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					commonscope.NameKey: "cg",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenClusterGroupPackageInstallFullname(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -12,17 +12,19 @@ import (
 	"golang.org/x/exp/slices"
 
 	packageinstallmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall"
+	packageinstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall/clustergroup"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
 // ScopedFullname is a struct for all types of package install full names.
 type ScopedFullname struct {
-	Scope           commonscope.Scope
-	FullnameCluster *packageinstallmodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName
+	Scope                commonscope.Scope
+	FullnameCluster      *packageinstallmodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName
+	FullnameClusterGroup *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName
 }
 
 var (
-	ScopesAllowed = [...]string{commonscope.ClusterKey}
+	ScopesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey}
 	ScopeSchema   = commonscope.GetScopeSchema(
 		commonscope.WithDescription(fmt.Sprintf("Scope for the package install, having one of the valid scopes: %v.", strings.Join(ScopesAllowed[:], `, `))),
 		commonscope.WithScopes(ScopesAllowed[:]))
@@ -54,6 +56,17 @@ func ConstructScope(d *schema.ResourceData, name, namespace string) (scopedFulln
 		}
 	}
 
+	if clusterGroupData, ok := scopeData[commonscope.ClusterGroupKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+		if clusterGroupValue, ok := clusterGroupData.([]interface{}); ok && len(clusterGroupValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:                commonscope.ClusterGroupScope,
+				FullnameClusterGroup: ConstructClusterGroupPackageInstallFullname(clusterGroupValue, name, namespace),
+			}
+
+			scopesFound = append(scopesFound, commonscope.ClusterGroupKey)
+		}
+	}
+
 	return scopedFullnameData, scopesFound
 }
 
@@ -71,6 +84,12 @@ func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}, name, nam
 			namespace = scopedFullname.FullnameCluster.NamespaceName
 			flattenScopeData[commonscope.ClusterKey] = FlattenClusterPackageInstallFullname(scopedFullname.FullnameCluster)
 		}
+	case commonscope.ClusterGroupScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+			name = scopedFullname.FullnameClusterGroup.Name
+			namespace = scopedFullname.FullnameClusterGroup.NamespaceName
+			flattenScopeData[commonscope.ClusterGroupKey] = FlattenClusterGroupPackageInstallFullname(scopedFullname.FullnameClusterGroup)
+		}
 	case commonscope.UnknownScope:
 		fmt.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(ScopesAllowed[:], `, `))
 	}
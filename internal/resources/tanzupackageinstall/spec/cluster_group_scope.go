@@ -0,0 +1,30 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	packageinstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall/clustergroup"
+)
+
+func ConstructSpecForClusterGroupScope(d *schema.ResourceData) (spec *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec, err error) {
+	atomicSpec, err := ConstructSpecForClusterScope(d)
+	if err != nil || atomicSpec == nil {
+		return spec, err
+	}
+
+	return &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec{
+		AtomicSpec: atomicSpec,
+	}, nil
+}
+
+func FlattenSpecForClusterGroupScope(spec *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec, pathToInlineValues string) (data []interface{}, err error) {
+	if spec == nil || spec.AtomicSpec == nil {
+		return data, nil
+	}
+
+	return FlattenSpecForClusterScope(spec.AtomicSpec, pathToInlineValues)
+}
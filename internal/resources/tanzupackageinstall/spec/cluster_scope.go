@@ -5,13 +5,7 @@
 package spec
 
 import (
-	"encoding/json"
-	"math"
-	"strconv"
-
-	valid "github.com/asaskevich/govalidator"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	packageinstallmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall"
@@ -71,59 +65,13 @@ func ConstructSpecForClusterScope(d *schema.ResourceData) (
 
 	spec.RoleBindingScope = packageinstallmodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallRoleBindingScopeCLUSTER.Pointer()
 
-	// To be deprecated in a future release.
-	if v, ok := specData[InlineValuesKey]; ok {
-		if v1, ok := v.(map[string]interface{}); ok {
-			for key, value := range v1 {
-				switch {
-				case valid.IsInt(value.(string)):
-					number, err := strconv.ParseUint(value.(string), 10, 32)
-					if err != nil {
-						v1[key] = value.(string)
-						break
-					}
-
-					if number > math.MaxInt32 {
-						v1[key] = value.(string)
-					} else {
-						finalIntNum := int(number) // Convert uint64 To int
-						v1[key] = finalIntNum
-					}
-				case valid.IsFloat(value.(string)):
-					floatNum, err := strconv.ParseFloat(value.(string), 64)
-					if err != nil {
-						v1[key] = value.(string)
-						break
-					}
-
-					v1[key] = floatNum
-				default:
-					v1[key] = value.(string)
-				}
-			}
-
-			spec.InlineValues = v1
-		}
+	inlineValues, err := ConstructInlineValues(specData)
+	if err != nil {
+		return spec, err
 	}
 
-	if inlineValuesFile, ok := specData[PathToInlineValuesKey]; ok {
-		if (inlineValuesFile.(string)) != "" {
-			if !(helper.FileExists(inlineValuesFile.(string))) {
-				return spec, errors.Errorf("File %s does not exists.", inlineValuesFile.(string))
-			}
-
-			yamlData, err := helper.ReadYamlFileAsJSON(inlineValuesFile.(string))
-			if err != nil {
-				return spec, errors.Wrapf(err, "Error while reading file %s as JSON string.", inlineValuesFile.(string))
-			}
-
-			var jsonData map[string]interface{}
-			if err = json.Unmarshal([]byte(yamlData), &jsonData); err != nil {
-				return spec, errors.Wrapf(err, "failed to unmarshal YAML data from file %s", inlineValuesFile.(string))
-			}
-
-			spec.InlineValues = jsonData
-		}
+	if inlineValues != nil {
+		spec.InlineValues = inlineValues
 	}
 
 	return spec, nil
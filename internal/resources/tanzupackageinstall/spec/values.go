@@ -0,0 +1,142 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+
+	valid "github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	openapiv3 "github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper/openapi_v3_schema_validator"
+)
+
+// ConstructInlineValues reads the inline values of a package install spec, from the inline values file or the deprecated inline values.
+// Nil is returned when neither is set.
+func ConstructInlineValues(specData map[string]interface{}) (values map[string]interface{}, err error) {
+	// To be deprecated in a future release.
+	if v, ok := specData[InlineValuesKey]; ok {
+		if v1, ok := v.(map[string]interface{}); ok {
+			values = make(map[string]interface{}, len(v1))
+
+			for key, value := range v1 {
+				values[key] = convertInlineValue(value.(string))
+			}
+		}
+	}
+
+	if inlineValuesFile, ok := specData[PathToInlineValuesKey]; ok {
+		if (inlineValuesFile.(string)) != "" {
+			if !(helper.FileExists(inlineValuesFile.(string))) {
+				return values, errors.Errorf("File %s does not exists.", inlineValuesFile.(string))
+			}
+
+			yamlData, err := helper.ReadYamlFileAsJSON(inlineValuesFile.(string))
+			if err != nil {
+				return values, errors.Wrapf(err, "Error while reading file %s as JSON string.", inlineValuesFile.(string))
+			}
+
+			var jsonData map[string]interface{}
+			if err = json.Unmarshal([]byte(yamlData), &jsonData); err != nil {
+				return values, errors.Wrapf(err, "failed to unmarshal YAML data from file %s", inlineValuesFile.(string))
+			}
+
+			values = jsonData
+		}
+	}
+
+	return values, nil
+}
+
+func convertInlineValue(value string) interface{} {
+	switch {
+	case valid.IsInt(value):
+		number, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || number > math.MaxInt32 {
+			return value
+		}
+
+		return int(number) // Convert uint64 To int
+	case valid.IsFloat(value):
+		floatNum, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return value
+		}
+
+		return floatNum
+	default:
+		return value
+	}
+}
+
+// ValidateValues validates the inline values of a package install against the OpenAPI values schema of the package.
+// The values are returned with the defaults of the schema set for the keys which aren't provided.
+func ValidateValues(valuesSchema map[string]interface{}, values map[string]interface{}) (map[string]interface{}, error) {
+	properties, _ := valuesSchema[string(openapiv3.PropertiesKey)].(map[string]interface{})
+
+	if len(properties) == 0 {
+		return values, nil
+	}
+
+	// The numbers of the values are compared as JSON numbers, like the numbers of the schema.
+	normalizedValues, err := normalizeValues(values)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := &openapiv3.OpenAPIV3SchemaValidator{
+		Schema:             properties,
+		AllowUnknownFields: openapiv3.AllowsUnknownFields(valuesSchema),
+		SchemaName:         "package values",
+	}
+
+	effectiveValues := validator.ApplyDefaults(normalizedValues)
+
+	errs := validator.ValidateFormat(normalizedValues)
+	errs = append(errs, validator.ValidateRequiredFields(effectiveValues)...)
+
+	if required, ok := valuesSchema[string(openapiv3.RequiredKey)].([]interface{}); ok {
+		for _, key := range required {
+			if _, ok := effectiveValues[key.(string)]; !ok {
+				errs = append(errs, errors.Errorf("Key '%s' is required but not provided.", key))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		errMessages := make([]string, 0, len(errs))
+
+		for _, e := range errs {
+			errMessages = append(errMessages, e.Error())
+		}
+
+		return nil, errors.Errorf("inline values are not valid for the values schema of the package:\n%s", strings.Join(errMessages, "\n"))
+	}
+
+	return effectiveValues, nil
+}
+
+func normalizeValues(values map[string]interface{}) (map[string]interface{}, error) {
+	normalizedValues := make(map[string]interface{})
+
+	if len(values) == 0 {
+		return normalizedValues, nil
+	}
+
+	valuesJSON, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(valuesJSON, &normalizedValues); err != nil {
+		return nil, err
+	}
+
+	return normalizedValues, nil
+}
@@ -0,0 +1,155 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testValuesSchema = `{
+	"type": "object",
+	"additionalProperties": false,
+	"properties": {
+		"namespace": {"type": "string", "default": "cert-manager", "description": "The namespace in which to deploy cert-manager."},
+		"replicas": {"type": "integer", "default": 1, "minimum": 1},
+		"deployment": {
+			"type": "object",
+			"properties": {
+				"hostNetwork": {"type": "boolean", "default": false},
+				"labels": {"type": "object", "additionalProperties": {"type": "string"}}
+			}
+		},
+		"tolerations": {"type": "array", "items": {"type": "object", "x-kubernetes-preserve-unknown-fields": true}}
+	}
+}`
+
+func TestConstructInlineValues(t *testing.T) {
+	t.Parallel()
+
+	values, err := ConstructInlineValues(map[string]interface{}{})
+	require.NoError(t, err)
+	require.Nil(t, values)
+
+	values, err = ConstructInlineValues(map[string]interface{}{
+		InlineValuesKey: map[string]interface{}{
+			testNamespace: testCertManager,
+			testSome:      "91",
+			"ratio":       "0.5",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{testNamespace: testCertManager, testSome: 91, "ratio": 0.5}, values)
+
+	values, err = ConstructInlineValues(map[string]interface{}{
+		PathToInlineValuesKey: "test.yaml",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{testNamespace: testCertManager, testSome: float64(91)}, values)
+
+	_, err = ConstructInlineValues(map[string]interface{}{
+		PathToInlineValuesKey: "missing.yaml",
+	})
+	require.Error(t, err)
+}
+
+func TestValidateValues(t *testing.T) {
+	t.Parallel()
+
+	valuesSchema := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(testValuesSchema), &valuesSchema))
+
+	cases := []struct {
+		description string
+		schema      map[string]interface{}
+		values      map[string]interface{}
+		expected    map[string]interface{}
+		expectError bool
+	}{
+		{
+			description: "defaults are set for the values which aren't provided",
+			schema:      valuesSchema,
+			values:      map[string]interface{}{"replicas": 3},
+			expected: map[string]interface{}{
+				testNamespace: testCertManager,
+				"replicas":    float64(3),
+				"deployment":  map[string]interface{}{"hostNetwork": false},
+			},
+		},
+		{
+			description: "nested values are kept",
+			schema:      valuesSchema,
+			values: map[string]interface{}{
+				"deployment":  map[string]interface{}{"labels": map[string]interface{}{"team": "platform"}},
+				"tolerations": []interface{}{map[string]interface{}{"key": "dedicated", "effect": "NoSchedule"}},
+			},
+			expected: map[string]interface{}{
+				testNamespace: testCertManager,
+				"replicas":    float64(1),
+				"deployment":  map[string]interface{}{"hostNetwork": false, "labels": map[string]interface{}{"team": "platform"}},
+				"tolerations": []interface{}{map[string]interface{}{"key": "dedicated", "effect": "NoSchedule"}},
+			},
+		},
+		{
+			description: "misspelled key",
+			schema:      valuesSchema,
+			values:      map[string]interface{}{"namespce": testCertManager},
+			expectError: true,
+		},
+		{
+			description: "misspelled nested key",
+			schema:      valuesSchema,
+			values:      map[string]interface{}{"deployment": map[string]interface{}{"hostNetwrok": true}},
+			expectError: true,
+		},
+		{
+			description: "wrong type",
+			schema:      valuesSchema,
+			values:      map[string]interface{}{"replicas": "three"},
+			expectError: true,
+		},
+		{
+			description: "value lower than the minimum",
+			schema:      valuesSchema,
+			values:      map[string]interface{}{"replicas": 0},
+			expectError: true,
+		},
+		{
+			description: "missing required key",
+			schema: map[string]interface{}{
+				"type":       "object",
+				"required":   []interface{}{"domain"},
+				"properties": map[string]interface{}{"domain": map[string]interface{}{"type": "string"}},
+			},
+			values:      map[string]interface{}{},
+			expectError: true,
+		},
+		{
+			description: "package without values schema",
+			schema:      nil,
+			values:      map[string]interface{}{testSome: "value"},
+			expected:    map[string]interface{}{testSome: "value"},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := ValidateValues(test.schema, test.values)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+
+	_, err := ValidateValues(valuesSchema, map[string]interface{}{"namespce": testCertManager})
+	require.ErrorContains(t, err, "Key 'namespce' is not expected in the package values schema.")
+}
@@ -14,6 +14,12 @@ const (
 	serviceAccountNameKey  = "service_account_name"
 	roleBindingNameKey     = "role_binding_name"
 	StatusKey              = "status"
+	detailsKey             = "details"
+	availableTargetsKey    = "available_targets"
+	appliedKey             = "applied"
+	pendingKey             = "pending"
+	overriddenKey          = "overridden"
+	errorKey               = "error"
 
 	conditionReady = "Ready"
 )
@@ -14,7 +14,7 @@ var StatusSchema = &schema.Schema{
 		Schema: map[string]*schema.Schema{
 			packageInstallPhaseKey: {
 				Type:        schema.TypeString,
-				Description: "One-word reason for the condition's last transition.",
+				Description: "One-word reason for the condition's last transition. For cluster group scope, phase of the package install application on member clusters.",
 				Computed:    true,
 			},
 			resolvedVersionKey: {
@@ -34,6 +34,7 @@ var StatusSchema = &schema.Schema{
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			generatedResourcesKey: generatedResourcesStatus,
+			detailsKey:            detailsStatus,
 		},
 	},
 }
@@ -62,3 +63,38 @@ var generatedResourcesStatus = &schema.Schema{
 		},
 	},
 }
+
+var detailsStatus = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Aggregated status of the package install across the member clusters of the cluster group.",
+	Computed:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			availableTargetsKey: {
+				Type:        schema.TypeInt,
+				Description: "Number of member clusters the package install can be applied on.",
+				Computed:    true,
+			},
+			appliedKey: {
+				Type:        schema.TypeInt,
+				Description: "Number of member clusters the package install has been applied on.",
+				Computed:    true,
+			},
+			pendingKey: {
+				Type:        schema.TypeInt,
+				Description: "Number of member clusters the package install is pending on.",
+				Computed:    true,
+			},
+			overriddenKey: {
+				Type:        schema.TypeInt,
+				Description: "Number of member clusters where a cluster scoped package install with the same name overrides this one.",
+				Computed:    true,
+			},
+			errorKey: {
+				Type:        schema.TypeInt,
+				Description: "Number of member clusters the package install failed to apply on.",
+				Computed:    true,
+			},
+		},
+	},
+}
@@ -11,6 +11,7 @@ import (
 
 	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
 	pkginstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall"
+	pkginstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackageinstall/clustergroup"
 )
 
 func TestFlattenStatusForClusterScope(t *testing.T) {
@@ -73,3 +74,60 @@ func TestFlattenStatusForClusterScope(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenStatusForClusterGroupScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus
+		expected    interface{}
+	}{
+		{
+			description: "check for nil cluster group package install status",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "check for cluster group package install status without phase",
+			input: &pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus{
+				ObservedGeneration: "1",
+			},
+			expected: nil,
+		},
+		{
+			description: "normal scenario with complete cluster group package install status",
+			input: &pkginstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus{
+				Phase: statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhasePENDING.Pointer(),
+				Details: &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{
+					AvailableTargets: 3,
+					Applied:          1,
+					Pending:          1,
+					Overridden:       1,
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					packageInstallPhaseKey: "PENDING",
+					detailsKey: []interface{}{
+						map[string]interface{}{
+							availableTargetsKey: 3,
+							appliedKey:          1,
+							pendingKey:          1,
+							overriddenKey:       1,
+							errorKey:            0,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenStatusForClusterGroupScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
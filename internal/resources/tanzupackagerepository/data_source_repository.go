@@ -13,7 +13,9 @@ import (
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	pkgrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackagerepository/clustergroup"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackagerepository/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackagerepository/spec"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackagerepository/status"
@@ -42,9 +44,13 @@ func dataPackageRepositoryRead(ctx context.Context, d *schema.ResourceData, m in
 		return diag.Errorf("Unable to create Tanzu Mission Control package repository entry; Scope full name is empty")
 	}
 
-	_, err := GetGlobalNamespace(config, scopedFullnameData, d)
-	if err != nil {
-		return diag.Errorf("failed to get package repository global namespace for cluster: %v", err)
+	if scopedFullnameData.Scope == commonscope.ClusterScope {
+		_, err := GetGlobalNamespace(config, scopedFullnameData, d)
+		if err != nil {
+			return diag.Errorf("failed to get package repository global namespace for cluster: %v", err)
+		}
+	} else if namespace, ok := d.Get(NamespaceKey).(string); ok {
+		setFullnameNamespace(scopedFullnameData, namespace)
 	}
 
 	pkgRepoDataFromServer, err := retrievePackageRepositoryUIDMetaAndSpecFromServer(config, scopedFullnameData, d)
@@ -62,7 +68,29 @@ func dataPackageRepositoryRead(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control package repository entry, name : %s", packageRepositoryName))
 	}
 
-	if err := d.Set(disabledKey, pkgRepoDataFromServer.status.Disabled); err != nil {
+	var (
+		disabled        bool
+		flattenedSpec   []interface{}
+		flattenedStatus interface{}
+	)
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if pkgRepoDataFromServer.status != nil {
+			disabled = pkgRepoDataFromServer.status.Disabled
+		}
+
+		flattenedSpec = spec.FlattenSpecForClusterScope(pkgRepoDataFromServer.spec)
+		flattenedStatus = status.FlattenStatusForClusterScope(pkgRepoDataFromServer.status)
+	case commonscope.ClusterGroupScope:
+		clusterGroupScopeSpec := &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositorySpec{
+			AtomicSpec: pkgRepoDataFromServer.spec,
+		}
+		flattenedSpec = spec.FlattenSpecForClusterGroupScope(clusterGroupScopeSpec)
+		flattenedStatus = status.FlattenStatusForClusterGroupScope(pkgRepoDataFromServer.clusterGroupScopeStatus)
+	}
+
+	if err := d.Set(disabledKey, disabled); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -72,11 +100,11 @@ func dataPackageRepositoryRead(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set(status.StatusKey, status.FlattenStatusForClusterScope(pkgRepoDataFromServer.status)); err != nil {
+	if err := d.Set(status.StatusKey, flattenedStatus); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set(spec.SpecKey, spec.FlattenSpecForClusterScope(pkgRepoDataFromServer.spec)); err != nil {
+	if err := d.Set(spec.SpecKey, flattenedSpec); err != nil {
 		return diag.FromErr(err)
 	}
 
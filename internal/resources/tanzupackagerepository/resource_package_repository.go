@@ -7,6 +7,7 @@ package tanzupackagerepository
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -19,6 +20,7 @@ import (
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	tanzupakageclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackage"
 	pkgrepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackagerepository"
+	pkgrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackagerepository/clustergroup"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackagerepository/scope"
@@ -27,10 +29,11 @@ import (
 )
 
 type dataFromServer struct {
-	UID    string
-	meta   *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
-	spec   *pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositorySpec
-	status *pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositoryStatus
+	UID                     string
+	meta                    *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	spec                    *pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositorySpec
+	status                  *pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositoryStatus
+	clusterGroupScopeStatus *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryStatus
 }
 
 type contextMethodKey struct{}
@@ -43,7 +46,8 @@ func ResourcePackageRepository() *schema.Resource {
 		ReadContext:   dataPackageRepositoryRead,
 		Schema:        getResourceSchema(),
 		CustomizeDiff: customdiff.All(
-			schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey})),
+			schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+			validateDisabledForScope,
 		),
 	}
 }
@@ -71,7 +75,7 @@ func getPkgRepoSchema(isDataSource bool) map[string]*schema.Schema {
 		},
 		disabledKey: {
 			Type:        schema.TypeBool,
-			Description: "If true, Package Repository is disabled for cluster.",
+			Description: "If true, Package Repository is disabled for cluster. Only supported for the cluster scope.",
 			Optional:    true,
 			Default:     false,
 		},
@@ -109,30 +113,56 @@ func resourcePackageRepositoryCreate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("Unable to create Tanzu Mission Control package repository entry; Scope full name is empty")
 	}
 
-	_, err := GetGlobalNamespace(config, scopedFullnameData, d)
-	if err != nil {
-		return diag.Errorf("failed to get package repository global namespace for cluster: %v", err)
-	}
+	var UID string
 
-	packageRepositoryReq := &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositoryRequest{
-		Repository: &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepository{
-			FullName: scopedFullnameData.FullnameCluster,
-			Meta:     common.ConstructMeta(d),
-			Spec:     spec.ConstructSpecForClusterScope(d),
-		},
-	}
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			_, err := GetGlobalNamespace(config, scopedFullnameData, d)
+			if err != nil {
+				return diag.Errorf("failed to get package repository global namespace for cluster: %v", err)
+			}
 
-	packageRepositoryResponse, err := config.TMCConnection.ClusterPackageRepositoryService.RepositoryResourceServiceCreate(packageRepositoryReq)
-	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster package repository entry, name : %s", packageRepositoryName))
-	}
+			packageRepositoryReq := &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositoryRequest{
+				Repository: &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepository{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     common.ConstructMeta(d),
+					Spec:     spec.ConstructSpecForClusterScope(d),
+				},
+			}
+
+			packageRepositoryResponse, err := config.TMCConnection.ClusterPackageRepositoryService.RepositoryResourceServiceCreate(packageRepositoryReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster package repository entry, name : %s", packageRepositoryName))
+			}
 
-	UID := packageRepositoryResponse.Repository.Meta.UID
+			UID = packageRepositoryResponse.Repository.Meta.UID
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			packageRepositoryReq := &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryRequest{
+				Repository: &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepository{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     common.ConstructMeta(d),
+					Spec:     spec.ConstructSpecForClusterGroupScope(d),
+				},
+			}
+
+			packageRepositoryResponse, err := config.TMCConnection.ClusterGroupPackageRepositoryService.RepositoryResourceServiceCreate(packageRepositoryReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group package repository entry, name : %s", packageRepositoryName))
+			}
+
+			UID = packageRepositoryResponse.Repository.Meta.UID
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
 
 	// always run
 	d.SetId(UID)
 
-	if d.Get(disabledKey).(bool) {
+	if scopedFullnameData.Scope == commonscope.ClusterScope && d.Get(disabledKey).(bool) {
 		setAvailabilityRequest := &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositorySetRepositoryAvailabilityRequest{
 			Disabled: true,
 			FullName: scopedFullnameData.FullnameCluster,
@@ -171,11 +201,25 @@ func resourcePackageRepositoryDelete(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("Unable to read package repository name")
 	}
 
-	scopedFullnameData.FullnameCluster.NamespaceName = packageRepositoryNamespacename
+	setFullnameNamespace(scopedFullnameData, packageRepositoryNamespacename)
 
-	err := config.TMCConnection.ClusterPackageRepositoryService.RepositoryResourceServiceDelete(scopedFullnameData.FullnameCluster)
-	if err != nil && !clienterrors.IsNotFoundError(err) {
-		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster package repository entry, name : %s", packageRepositoryName))
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			err := config.TMCConnection.ClusterPackageRepositoryService.RepositoryResourceServiceDelete(scopedFullnameData.FullnameCluster)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster package repository entry, name : %s", packageRepositoryName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			err := config.TMCConnection.ClusterGroupPackageRepositoryService.RepositoryResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group package repository entry, name : %s", packageRepositoryName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
@@ -204,29 +248,53 @@ func resourcePackageRepositoryInPlaceUpdate(ctx context.Context, d *schema.Resou
 		return diag.Errorf("Unable to read package repository name")
 	}
 
-	scopedFullnameData.FullnameCluster.NamespaceName = packageRepositoryNamespacename
+	setFullnameNamespace(scopedFullnameData, packageRepositoryNamespacename)
 
 	pkgRepoDataFromServer, err := retrievePackageRepositoryUIDMetaAndSpecFromServer(config, scopedFullnameData, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if updateCheckForMeta(d, pkgRepoDataFromServer.meta) || updateCheckForSpec(d, pkgRepoDataFromServer.spec) {
-		pkgRepoReq := &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositoryRequest{
-			Repository: &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepository{
-				FullName: scopedFullnameData.FullnameCluster,
-				Meta:     pkgRepoDataFromServer.meta,
-				Spec:     pkgRepoDataFromServer.spec,
-			},
-		}
+	metaUpdated := updateCheckForMeta(d, pkgRepoDataFromServer.meta)
+	specUpdated := updateCheckForSpec(d, pkgRepoDataFromServer.spec)
+	availabilityUpdated := scopedFullnameData.Scope == commonscope.ClusterScope && d.HasChange(disabledKey)
 
-		_, err = config.TMCConnection.ClusterPackageRepositoryService.RepositoryResourceServiceUpdate(pkgRepoReq)
-		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster package repository entry, name : %s", packageRepositoryName))
+	if metaUpdated || specUpdated {
+		switch scopedFullnameData.Scope {
+		case commonscope.ClusterScope:
+			pkgRepoReq := &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositoryRequest{
+				Repository: &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepository{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     pkgRepoDataFromServer.meta,
+					Spec:     pkgRepoDataFromServer.spec,
+				},
+			}
+
+			_, err = config.TMCConnection.ClusterPackageRepositoryService.RepositoryResourceServiceUpdate(pkgRepoReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster package repository entry, name : %s", packageRepositoryName))
+			}
+		case commonscope.ClusterGroupScope:
+			pkgRepoReq := &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryRequest{
+				Repository: &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepository{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     pkgRepoDataFromServer.meta,
+					Spec: &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositorySpec{
+						AtomicSpec: pkgRepoDataFromServer.spec,
+					},
+				},
+			}
+
+			_, err = config.TMCConnection.ClusterGroupPackageRepositoryService.RepositoryResourceServiceUpdate(pkgRepoReq)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group package repository entry, name : %s", packageRepositoryName))
+			}
+		case commonscope.UnknownScope:
+			return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 		}
 	}
 
-	if d.HasChange(disabledKey) {
+	if availabilityUpdated {
 		pkgrepoavailabilityReq := &pkgrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositorySetRepositoryAvailabilityRequest{
 			Disabled: d.Get(disabledKey).(bool),
 			FullName: scopedFullnameData.FullnameCluster,
@@ -238,7 +306,7 @@ func resourcePackageRepositoryInPlaceUpdate(ctx context.Context, d *schema.Resou
 		}
 	}
 
-	if !updateCheckForMeta(d, pkgRepoDataFromServer.meta) && !updateCheckForSpec(d, pkgRepoDataFromServer.spec) && !d.HasChange(disabledKey) {
+	if !metaUpdated && !specUpdated && !availabilityUpdated {
 		return diags
 	}
 
@@ -249,25 +317,48 @@ func retrievePackageRepositoryUIDMetaAndSpecFromServer(config authctx.TanzuConte
 	*dataFromServer, error) {
 	var pkgRepoDataFromServer = &dataFromServer{}
 
-	resp, err := config.TMCConnection.ClusterPackageRepositoryService.RepositoryResourceServiceGet(scopedFullnameData.FullnameCluster)
-	if err != nil {
-		if clienterrors.IsNotFoundError(err) {
-			d.SetId("")
-			return pkgRepoDataFromServer, err
-		}
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			resp, err := config.TMCConnection.ClusterPackageRepositoryService.RepositoryResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return pkgRepoDataFromServer, err
+				}
+
+				return pkgRepoDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster package repository entry, name : %s", scopedFullnameData.FullnameCluster.Name)
+			}
 
-		return pkgRepoDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster package repository entry, name : %s", scopedFullnameData.FullnameCluster.Name)
-	}
+			scopedFullnameData.FullnameCluster = resp.Repository.FullName
+			pkgRepoDataFromServer.UID = resp.Repository.Meta.UID
+			pkgRepoDataFromServer.meta = resp.Repository.Meta
+			pkgRepoDataFromServer.spec = resp.Repository.Spec
+			pkgRepoDataFromServer.status = resp.Repository.Status
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			resp, err := config.TMCConnection.ClusterGroupPackageRepositoryService.RepositoryResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return pkgRepoDataFromServer, err
+				}
+
+				return pkgRepoDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster group package repository entry, name : %s", scopedFullnameData.FullnameClusterGroup.Name)
+			}
 
-	scopedFullnameData.FullnameCluster = resp.Repository.FullName
-	pkgRepoDataFromServer.UID = resp.Repository.Meta.UID
-	pkgRepoDataFromServer.meta = resp.Repository.Meta
-	pkgRepoDataFromServer.spec = resp.Repository.Spec
-	pkgRepoDataFromServer.status = resp.Repository.Status
+			scopedFullnameData.FullnameClusterGroup = resp.Repository.FullName
+			pkgRepoDataFromServer.UID = resp.Repository.Meta.UID
+			pkgRepoDataFromServer.meta = resp.Repository.Meta
+			pkgRepoDataFromServer.clusterGroupScopeStatus = resp.Repository.Status
 
-	scopedFullnameData = &scope.ScopedFullname{
-		Scope:           commonscope.ClusterScope,
-		FullnameCluster: resp.Repository.FullName,
+			if resp.Repository.Spec != nil {
+				pkgRepoDataFromServer.spec = resp.Repository.Spec.AtomicSpec
+			}
+		}
+	case commonscope.UnknownScope:
+		return pkgRepoDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
 	}
 
 	fullName, name, namespace := scope.FlattenScope(scopedFullnameData)
@@ -287,6 +378,33 @@ func retrievePackageRepositoryUIDMetaAndSpecFromServer(config authctx.TanzuConte
 	return pkgRepoDataFromServer, nil
 }
 
+func setFullnameNamespace(scopedFullnameData *scope.ScopedFullname, namespace string) {
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			scopedFullnameData.FullnameCluster.NamespaceName = namespace
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			scopedFullnameData.FullnameClusterGroup.NamespaceName = namespace
+		}
+	}
+}
+
+// validateDisabledForScope rejects disabling a package repository on a cluster group, as the
+// availability of a package repository can only be toggled on an individual cluster.
+func validateDisabledForScope(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.Get(disabledKey).(bool) {
+		return nil
+	}
+
+	if _, ok := diff.GetOk(helper.GetFirstElementOf(commonscope.ScopeKey, commonscope.ClusterGroupKey)); ok {
+		return errors.Errorf("%s is only supported for the %s scope", disabledKey, commonscope.ClusterKey)
+	}
+
+	return nil
+}
+
 func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) bool {
 	if !common.HasMetaChanged(d) {
 		return false
@@ -0,0 +1,41 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package scope
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	pkgrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackagerepository/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func ConstructClusterGroupPackageRepositoryFullname(data []interface{}, name string) (fullname *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName) {
+	if len(data) == 0 || data[0] == nil {
+		return fullname
+	}
+
+	fullNameData, _ := data[0].(map[string]interface{})
+
+	fullname = &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName{}
+
+	if nameValue, ok := fullNameData[commonscope.NameKey]; ok {
+		helper.SetPrimitiveValue(nameValue, &fullname.ClusterGroupName, commonscope.NameKey)
+	}
+
+	fullname.Name = name
+
+	return fullname
+}
+
+func FlattenClusterGroupPackageRepositoryFullname(fullname *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName) (data []interface{}) {
+	if fullname == nil {
+		return data
+	}
+
+	flattenFullname := make(map[string]interface{})
+
+	flattenFullname[commonscope.NameKey] = fullname.ClusterGroupName
+
+	return []interface{}{flattenFullname}
+}
@@ -0,0 +1,51 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pkgrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackagerepository/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func TestFlattenClusterGroupPackageRepositoryFullname(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster group package repository full name",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster group package repository full name",
+			input: &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName{
+				ClusterGroupName: "cg",
+				Name:             "n",
+				NamespaceName:    "nn",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					commonscope.NameKey: "cg",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenClusterGroupPackageRepositoryFullname(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
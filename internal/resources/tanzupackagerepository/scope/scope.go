@@ -12,17 +12,19 @@ import (
 	"golang.org/x/exp/slices"
 
 	repositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackagerepository"
+	repositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackagerepository/clustergroup"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
 )
 
 // ScopedFullname is a struct for all types of package repository full names.
 type ScopedFullname struct {
-	Scope           commonscope.Scope
-	FullnameCluster *repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositoryFullName
+	Scope                commonscope.Scope
+	FullnameCluster      *repositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageRepositoryFullName
+	FullnameClusterGroup *repositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryFullName
 }
 
 var (
-	ScopesAllowed = [...]string{commonscope.ClusterKey}
+	ScopesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey}
 	ScopeSchema   = commonscope.GetScopeSchema(
 		commonscope.WithDescription(fmt.Sprintf("Scope for the package repository, having one of the valid scopes: %v.", strings.Join(ScopesAllowed[:], `, `))),
 		commonscope.WithScopes(ScopesAllowed[:]))
@@ -52,6 +54,15 @@ func ConstructScope(d *schema.ResourceData, name string) (scopedFullnameData *Sc
 		}
 	}
 
+	if clusterGroupData, ok := scopeData[commonscope.ClusterGroupKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+		if clusterGroupValue, ok := clusterGroupData.([]interface{}); ok && len(clusterGroupValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:                commonscope.ClusterGroupScope,
+				FullnameClusterGroup: ConstructClusterGroupPackageRepositoryFullname(clusterGroupValue, name),
+			}
+		}
+	}
+
 	return scopedFullnameData
 }
 
@@ -69,6 +80,12 @@ func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}, name, nam
 			namespace = scopedFullname.FullnameCluster.NamespaceName
 			flattenScopeData[commonscope.ClusterKey] = FlattenClusterPackageRepositoryFullname(scopedFullname.FullnameCluster)
 		}
+	case commonscope.ClusterGroupScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+			name = scopedFullname.FullnameClusterGroup.Name
+			namespace = scopedFullname.FullnameClusterGroup.NamespaceName
+			flattenScopeData[commonscope.ClusterGroupKey] = FlattenClusterGroupPackageRepositoryFullname(scopedFullname.FullnameClusterGroup)
+		}
 	case commonscope.UnknownScope:
 		fmt.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(ScopesAllowed[:], `, `))
 	}
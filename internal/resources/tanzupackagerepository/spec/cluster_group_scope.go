@@ -0,0 +1,30 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pkgrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackagerepository/clustergroup"
+)
+
+func ConstructSpecForClusterGroupScope(d *schema.ResourceData) (spec *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositorySpec) {
+	atomicSpec := ConstructSpecForClusterScope(d)
+	if atomicSpec == nil {
+		return spec
+	}
+
+	return &pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositorySpec{
+		AtomicSpec: atomicSpec,
+	}
+}
+
+func FlattenSpecForClusterGroupScope(spec *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositorySpec) (data []interface{}) {
+	if spec == nil || spec.AtomicSpec == nil {
+		return data
+	}
+
+	return FlattenSpecForClusterScope(spec.AtomicSpec)
+}
@@ -0,0 +1,43 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package status
+
+import (
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+	pkgrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackagerepository/clustergroup"
+)
+
+func FlattenStatusForClusterGroupScope(status *pkgrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageRepositoryStatus) (data interface{}) {
+	if status == nil {
+		return data
+	}
+
+	if status.Phase == nil {
+		return data
+	}
+
+	flattenStatusData := make(map[string]interface{})
+
+	flattenStatusData[packageRepositoryPhaseKey] = string(*status.Phase)
+	flattenStatusData[detailsKey] = flattenBatchDetails(status.Details)
+
+	return []interface{}{flattenStatusData}
+}
+
+func flattenBatchDetails(details *statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails) (data []interface{}) {
+	if details == nil {
+		return data
+	}
+
+	flattenDetails := make(map[string]interface{})
+
+	flattenDetails[availableTargetsKey] = int(details.AvailableTargets)
+	flattenDetails[appliedKey] = int(details.Applied)
+	flattenDetails[pendingKey] = int(details.Pending)
+	flattenDetails[overriddenKey] = int(details.Overridden)
+	flattenDetails[errorKey] = int(details.Error)
+
+	return []interface{}{flattenDetails}
+}
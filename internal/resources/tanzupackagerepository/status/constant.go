@@ -9,6 +9,12 @@ const (
 	subscribedKey             = "subscribed"
 	disabledKey               = "disabled"
 	managedKey                = "managed"
+	detailsKey                = "details"
+	availableTargetsKey       = "available_targets"
+	appliedKey                = "applied"
+	pendingKey                = "pending"
+	overriddenKey             = "overridden"
+	errorKey                  = "error"
 	conditionReady            = "Ready"
 	StatusKey                 = "state"
 )
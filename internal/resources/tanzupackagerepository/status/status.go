@@ -15,7 +15,7 @@ var StatusSchema = &schema.Schema{
 			packageRepositoryPhaseKey: {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "One-word reason for the condition's last transition.",
+				Description: "One-word reason for the condition's last transition. For cluster group scope, phase of the package repository application on member clusters.",
 			},
 			subscribedKey: {
 				Type:        schema.TypeBool,
@@ -32,6 +32,42 @@ var StatusSchema = &schema.Schema{
 				Computed:    true,
 				Description: "If true, the Package Repository is managed by TMC.",
 			},
+			detailsKey: detailsStatus,
+		},
+	},
+}
+
+var detailsStatus = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Aggregated status of the package repository across the member clusters of the cluster group.",
+	Computed:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			availableTargetsKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of member clusters the package repository can be applied on.",
+			},
+			appliedKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of member clusters the package repository has been applied on.",
+			},
+			pendingKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of member clusters the package repository is pending on.",
+			},
+			overriddenKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of member clusters where a cluster scoped package repository with the same name overrides this one.",
+			},
+			errorKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of member clusters the package repository failed to apply on.",
+			},
 		},
 	},
 }